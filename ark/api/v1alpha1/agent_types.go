@@ -61,6 +61,77 @@ type AgentSpec struct {
 	// +kubebuilder:validation:Optional
 	// JSON schema for structured output format
 	OutputSchema *runtime.RawExtension `json:"outputSchema,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Controls the provider parallel_tool_calls flag. If not specified, uses the provider default
+	ParallelToolCalls *bool `json:"parallelToolCalls,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// Controls the provider tool_choice parameter: auto, none, required, or
+	// the name of a specific tool the model must call
+	ToolChoice string `json:"toolChoice,omitempty"`
+	// +kubebuilder:validation:Optional
+	// When true, tool schemas are sent with strict function calling enabled and
+	// tightened to meet provider strict mode requirements
+	StrictTools *bool `json:"strictTools,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Maximum number of tokens the model may generate per completion
+	MaxOutputTokens *int64 `json:"maxOutputTokens,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=4
+	// Sequences where the model stops generating further tokens
+	Stop []string `json:"stop,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Token ID to bias value (-100 to 100) applied during sampling
+	LogitBias map[string]int64 `json:"logitBias,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Transforms applied in order to conversation history after memory
+	// retrieval and before provider calls
+	MessageTransforms []MessageTransform `json:"messageTransforms,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Few-shot examples injected after the system prompt as proper
+	// conversation messages, counted against the context budget
+	Examples []AgentExample `json:"examples,omitempty"`
+}
+
+// AgentExample is a few-shot demonstration of a user turn and the expected
+// assistant behavior, optionally including tool calls
+type AgentExample struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	User string `json:"user"`
+	// +kubebuilder:validation:Optional
+	// Assistant reply; may be empty when the example only demonstrates tool
+	// calls
+	Assistant string `json:"assistant,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Tool calls the assistant makes in this example, each paired with the
+	// result the tool returns
+	ToolCalls []AgentExampleToolCall `json:"toolCalls,omitempty"`
+}
+
+type AgentExampleToolCall struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// JSON-encoded arguments passed to the tool
+	Arguments string `json:"arguments,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Result the tool returns in the demonstration
+	Result string `json:"result,omitempty"`
+}
+
+// MessageTransform names a built-in transform applied to conversation history
+type MessageTransform struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Number of most recent user turns preserved by transforms that operate
+	// on a turn window
+	Turns *int `json:"turns,omitempty"`
 }
 
 type AgentStatus struct {
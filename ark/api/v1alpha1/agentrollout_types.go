@@ -0,0 +1,98 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type AgentRolloutSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Agent whose updates roll out through a canary revision
+	Agent string `json:"agent"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +kubebuilder:default=10
+	// Percentage of queries targeting the agent routed to the canary
+	CanaryPercent *int `json:"canaryPercent,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="10m"
+	// Observation window before a promotion or rollback decision
+	Window *metav1.Duration `json:"window,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=10
+	// Minimum completed canary queries required before deciding
+	MinQueries *int `json:"minQueries,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Minimum average evaluator score the canary must reach, as a decimal
+	// string such as "0.7"; empty skips the score check
+	MinScore string `json:"minScore,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=10
+	// Maximum percentage of canary queries that may end in error
+	MaxErrorPercent *int `json:"maxErrorPercent,omitempty"`
+}
+
+type AgentRolloutStatus struct {
+	// +kubebuilder:default="pending"
+	// +kubebuilder:validation:Enum=pending;stable;progressing;error
+	Phase string `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Hash of the agent spec the rollout last accepted as stable
+	ObservedSpecHash string `json:"observedSpecHash,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Name of the agent serving the candidate revision
+	CanaryAgent string `json:"canaryAgent,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Agent spec serving stable traffic while the canary progresses
+	StableSpec *AgentSpec `json:"stableSpec,omitempty"`
+	// +kubebuilder:validation:Optional
+	// When the current canary started receiving traffic
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Completed queries served by the canary in the current window
+	CanaryQueries int `json:"canaryQueries,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Canary queries that ended in error in the current window
+	CanaryErrors int `json:"canaryErrors,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Average evaluator score of canary queries in the current window
+	CanaryScore string `json:"canaryScore,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Agent",type=string,JSONPath=`.spec.agent`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Canary",type=string,JSONPath=`.status.canaryAgent`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AgentRollout canaries updates to an agent: a spec change creates a canary
+// revision receiving a percentage of matching queries, and the change is
+// promoted or rolled back automatically from evaluator scores and error rates
+// observed over a window
+type AgentRollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AgentRolloutSpec   `json:"spec,omitempty"`
+	Status AgentRolloutStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type AgentRolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AgentRollout `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AgentRollout{}, &AgentRolloutList{})
+}
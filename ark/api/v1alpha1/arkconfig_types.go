@@ -0,0 +1,121 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ControllerSettings holds runtime settings previously only configurable via
+// Helm values or controller environment variables.
+type ControllerSettings struct {
+	// +kubebuilder:validation:Optional
+	// Timeout for HTTP calls to memory services; overrides
+	// ARK_MEMORY_HTTP_TIMEOUT_SECONDS
+	MemoryHTTPTimeout *metav1.Duration `json:"memoryHttpTimeout,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Timeout applied to query targets that do not set spec.timeout
+	DefaultQueryTimeout *metav1.Duration `json:"defaultQueryTimeout,omitempty"`
+	// +kubebuilder:validation:Optional
+	// When true, new query executions are held pending while in-flight ones
+	// finish, for safe upgrades of memory services and providers
+	Maintenance bool `json:"maintenance,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^\d+(\.\d+)?$`
+	// Price per million tokens used to compute usage report costs, as a
+	// decimal string
+	CostPerMillionTokens string `json:"costPerMillionTokens,omitempty"`
+}
+
+type ArkConfigSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// Agent used when a Query in this namespace specifies no targets
+	DefaultAgent string `json:"defaultAgent,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// Model used when a Query in this namespace specifies no targets and no
+	// default agent is configured
+	DefaultModel string `json:"defaultModel,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1h"
+	// Window within which queries sharing an idempotencyKey are deduplicated
+	IdempotencyWindow *metav1.Duration `json:"idempotencyWindow,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Controller runtime settings for workloads in this namespace
+	Controller *ControllerSettings `json:"controller,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Query label and annotation keys propagated to provider requests and
+	// token-usage metrics for chargeback
+	ChargebackLabels []string `json:"chargebackLabels,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Allowlist of provider base URL patterns Models in this namespace may
+	// point at. Patterns match the URL host, with a leading "*." matching any
+	// subdomain, or act as URL prefixes. Empty means no restriction
+	EgressAllowlist []string `json:"egressAllowlist,omitempty"`
+	// +kubebuilder:validation:Optional
+	// When set, completed query responses are signed with the referenced key
+	// and the signature stored on the query status
+	ResponseSigning *ResponseSigningConfig `json:"responseSigning,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Limits stored message volume for memory services in this namespace
+	MemoryQuota *MemoryQuotaConfig `json:"memoryQuota,omitempty"`
+}
+
+// MemoryQuotaConfig caps how many messages a namespace may hold in a memory
+// service, so one chatty tenant cannot fill a shared backend
+type MemoryQuotaConfig struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// Maximum stored messages across all sessions of a memory service
+	MaxMessages int64 `json:"maxMessages"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=reject;evict-oldest
+	// +kubebuilder:default="reject"
+	// Whether writes past the limit are rejected or make room by deleting
+	// the least recently active sessions
+	Policy string `json:"policy,omitempty"`
+}
+
+// ResponseSigningConfig holds the controller-held key used to sign query
+// responses for provenance verification
+type ResponseSigningConfig struct {
+	// +kubebuilder:validation:Required
+	// Ed25519 private key in PKCS#8 PEM form, typically a secret reference
+	PrivateKey ValueSource `json:"privateKey"`
+	// +kubebuilder:validation:Optional
+	// Recorded on signatures so verifiers can select the matching public key
+	KeyID string `json:"keyId,omitempty"`
+}
+
+type ArkConfigStatus struct {
+	// +kubebuilder:default="pending"
+	// +kubebuilder:validation:Enum=pending;ready;error
+	Phase   string `json:"phase,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Default Agent",type=string,JSONPath=`.spec.defaultAgent`
+// +kubebuilder:printcolumn:name="Default Model",type=string,JSONPath=`.spec.defaultModel`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+type ArkConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ArkConfigSpec   `json:"spec,omitempty"`
+	Status ArkConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type ArkConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ArkConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ArkConfig{}, &ArkConfigList{})
+}
@@ -0,0 +1,76 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ConversationSnapshotSpec struct {
+	// +kubebuilder:validation:Required
+	Memory MemoryRef `json:"memory"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	SessionId string `json:"sessionId"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=export;import;snapshot;restore
+	// Export reads messages from memory into the snapshot; import seeds memory
+	// from the snapshot's messages. Snapshot captures the conversation at a
+	// point in time inside the memory service; restore rewinds the conversation
+	// to the snapshot named by snapshotId.
+	Mode string `json:"mode"`
+	// +kubebuilder:validation:Optional
+	// SnapshotId names the point-in-time snapshot to restore. Required in
+	// restore mode; ignored otherwise.
+	SnapshotId string `json:"snapshotId,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Captured conversation messages in OpenAI chat format. Populated by the
+	// controller on export; provided by the user on import.
+	Messages []runtime.RawExtension `json:"messages,omitempty"`
+	// +kubebuilder:validation:Optional
+	// ImportSource references bulk conversation history to import when it is
+	// too large to inline in messages. The resolved value holds an OpenAI
+	// thread export, a JSON message array, or JSONL with one message per line.
+	ImportSource *ValueSource `json:"importSource,omitempty"`
+}
+
+type ConversationSnapshotStatus struct {
+	// +kubebuilder:default="pending"
+	// +kubebuilder:validation:Enum=pending;running;ready;error
+	Phase   string `json:"phase,omitempty"`
+	Message string `json:"message,omitempty"`
+	// +kubebuilder:validation:Optional
+	MessageCount int `json:"messageCount,omitempty"`
+	// +kubebuilder:validation:Optional
+	// SnapshotId identifies the snapshot captured in snapshot mode
+	SnapshotId string `json:"snapshotId,omitempty"`
+	// +kubebuilder:validation:Optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.mode`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Messages",type=integer,JSONPath=`.status.messageCount`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+type ConversationSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConversationSnapshotSpec   `json:"spec,omitempty"`
+	Status ConversationSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type ConversationSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConversationSnapshot `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ConversationSnapshot{}, &ConversationSnapshotList{})
+}
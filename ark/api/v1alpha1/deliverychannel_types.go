@@ -0,0 +1,96 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeliveryChannelRef names a DeliveryChannel in the query's namespace
+type DeliveryChannelRef struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+type SlackDeliveryConfig struct {
+	// +kubebuilder:validation:Required
+	WebhookURL ValueSource `json:"webhookUrl"`
+}
+
+type TeamsDeliveryConfig struct {
+	// +kubebuilder:validation:Required
+	WebhookURL ValueSource `json:"webhookUrl"`
+}
+
+type WebhookDeliveryConfig struct {
+	// +kubebuilder:validation:Required
+	URL ValueSource `json:"url"`
+	// +kubebuilder:validation:Optional
+	Headers []Header `json:"headers,omitempty"`
+}
+
+type EmailDeliveryConfig struct {
+	// +kubebuilder:validation:Required
+	// SMTP server host
+	Host ValueSource `json:"host"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=587
+	Port int `json:"port,omitempty"`
+	// +kubebuilder:validation:Optional
+	Username *ValueSource `json:"username,omitempty"`
+	// +kubebuilder:validation:Optional
+	Password *ValueSource `json:"password,omitempty"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	From string `json:"from"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	To []string `json:"to"`
+	// +kubebuilder:validation:Optional
+	// Subject line; defaults to the query name
+	Subject string `json:"subject,omitempty"`
+}
+
+type DeliveryChannelConfig struct {
+	Slack   *SlackDeliveryConfig   `json:"slack,omitempty"`
+	Teams   *TeamsDeliveryConfig   `json:"teams,omitempty"`
+	Webhook *WebhookDeliveryConfig `json:"webhook,omitempty"`
+	Email   *EmailDeliveryConfig   `json:"email,omitempty"`
+}
+
+// DeliveryChannelSpec posts completed query responses to an external channel,
+// so scheduled report-style agents need no custom consumer service
+type DeliveryChannelSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=slack;teams;webhook;email
+	Type string `json:"type"`
+	// +kubebuilder:validation:Required
+	Config DeliveryChannelConfig `json:"config"`
+	// +kubebuilder:validation:Optional
+	// Go template rendering the message body with .Query, .Namespace and
+	// .Responses; defaults to the response contents joined by blank lines
+	Template string `json:"template,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+type DeliveryChannel struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DeliveryChannelSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type DeliveryChannelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeliveryChannel `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DeliveryChannel{}, &DeliveryChannelList{})
+}
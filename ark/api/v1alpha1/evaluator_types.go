@@ -29,6 +29,25 @@ type EvaluatorSpec struct {
 	// Parameters to pass to evaluation requests
 	// +kubebuilder:validation:Optional
 	Parameters []Parameter `json:"parameters,omitempty"`
+
+	// Bearer token sent in the Authorization header of evaluation requests
+	// +kubebuilder:validation:Optional
+	TokenRef *ValueSource `json:"tokenRef,omitempty"`
+
+	// Rubric text included in evaluation requests to guide judge models
+	// +kubebuilder:validation:Optional
+	Rubric string `json:"rubric,omitempty"`
+
+	// Timeout for a single evaluation request
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="30s"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Retries after a failed evaluation request
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=5
+	MaxRetries *int `json:"maxRetries,omitempty"`
 }
 
 type EvaluatorStatus struct {
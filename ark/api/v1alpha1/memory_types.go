@@ -6,10 +6,105 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// ManagedMemorySettings configures the controller-owned reference memory
+// deployment
+type ManagedMemorySettings struct {
+	// +kubebuilder:validation:Optional
+	// Image for the managed memory service; defaults to the bundled
+	// ark-cluster-memory image
+	Image string `json:"image,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1Gi"
+	// Size of the persistent volume backing the service
+	StorageSize string `json:"storageSize,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Storage class for the persistent volume; empty uses the cluster default
+	StorageClass string `json:"storageClass,omitempty"`
+}
+
 // MemorySpec defines the desired state of Memory.
 type MemorySpec struct {
+	// +kubebuilder:validation:Optional
+	// Address of the memory service; leave empty when managed is true and
+	// the controller wires the address to the owned Service
+	Address ValueSource `json:"address,omitempty"`
+	// +kubebuilder:validation:Optional
+	// When true, the controller deploys and owns a reference memory service
+	// (Deployment, Service and persistent volume claim) for this Memory
+	Managed bool `json:"managed,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Settings for the managed deployment
+	ManagedSettings *ManagedMemorySettings `json:"managedSettings,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Sessions with no owning Query and no activity within this window are
+	// deleted by the controller sweep; unset disables session garbage
+	// collection. Requires a memory service with the sessions capability
+	SessionRetention *metav1.Duration `json:"sessionRetention,omitempty"`
+	// +kubebuilder:validation:Optional
+	// When true, stored messages with unknown roles fail retrieval instead of
+	// being converted to user messages, protecting conversations from services
+	// that store newer message formats
+	StrictParsing bool `json:"strictParsing,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Compacts sessions idle beyond a threshold by replacing older messages
+	// with a model-written summary, keeping resumption cheap for long-lived
+	// assistants. Requires a memory service with the sessions capability
+	Compaction *MemoryCompaction `json:"compaction,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Returns the most relevant past messages for the current input in
+	// addition to the recent window, ranked by embedding similarity
+	SemanticRecall *MemorySemanticRecall `json:"semanticRecall,omitempty"`
+}
+
+// MemorySemanticRecall configures embedding-based retrieval of relevant past
+// messages
+type MemorySemanticRecall struct {
 	// +kubebuilder:validation:Required
-	Address ValueSource `json:"address"`
+	// Model used to embed messages; must be served by an OpenAI-compatible
+	// embeddings endpoint
+	ModelRef AgentModelRef `json:"modelRef"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Number of relevant older messages returned in addition to the recent
+	// window; defaults to 5
+	TopK *int `json:"topK,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Number of most recent messages always returned; defaults to 20
+	RecentWindow *int `json:"recentWindow,omitempty"`
+}
+
+// MemoryCompaction configures background summarization of idle sessions
+type MemoryCompaction struct {
+	// +kubebuilder:validation:Required
+	// Model used to write conversation summaries; typically a cheap one
+	ModelRef AgentModelRef `json:"modelRef"`
+	// +kubebuilder:validation:Optional
+	// Sessions with no activity within this window are compacted; defaults
+	// to 24h
+	IdleThreshold *metav1.Duration `json:"idleThreshold,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Number of most recent messages kept verbatim; defaults to 10
+	KeepRecent *int `json:"keepRecent,omitempty"`
+}
+
+// MemoryCapabilities describes the features a memory service advertised
+// through its GET /capabilities handshake.
+type MemoryCapabilities struct {
+	// +kubebuilder:validation:Optional
+	// Protocol version reported by the service
+	ProtocolVersion string `json:"protocolVersion,omitempty"`
+	// +kubebuilder:validation:Optional
+	Streaming bool `json:"streaming,omitempty"`
+	// +kubebuilder:validation:Optional
+	Pagination bool `json:"pagination,omitempty"`
+	// +kubebuilder:validation:Optional
+	Deletion bool `json:"deletion,omitempty"`
+	// +kubebuilder:validation:Optional
+	Conversations bool `json:"conversations,omitempty"`
+	// +kubebuilder:validation:Optional
+	Sessions bool `json:"sessions,omitempty"`
 }
 
 // MemoryStatus defines the observed state of Memory.
@@ -18,6 +113,11 @@ type MemoryStatus struct {
 	// LastResolvedAddress contains the last resolved address value for reference
 	LastResolvedAddress *string `json:"lastResolvedAddress,omitempty"`
 
+	// Capabilities discovered from the service handshake; nil when the service
+	// predates the capabilities endpoint
+	// +kubebuilder:validation:Optional
+	Capabilities *MemoryCapabilities `json:"capabilities,omitempty"`
+
 	// Phase represents the current state of the memory
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=running;ready;error
@@ -26,6 +126,10 @@ type MemoryStatus struct {
 	// Message provides additional information about the current status
 	// +kubebuilder:validation:Optional
 	Message string `json:"message,omitempty"`
+
+	// Conditions represent the latest health observations from readiness probes
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -14,6 +14,10 @@ type ModelConfig struct {
 	Azure *AzureModelConfig `json:"azure,omitempty"`
 	// +kubebuilder:validation:Optional
 	Bedrock *BedrockModelConfig `json:"bedrock,omitempty"`
+	// +kubebuilder:validation:Optional
+	Anthropic *AnthropicModelConfig `json:"anthropic,omitempty"`
+	// +kubebuilder:validation:Optional
+	Ollama *OllamaModelConfig `json:"ollama,omitempty"`
 }
 
 // AzureModelConfig contains Azure OpenAI specific parameters
@@ -36,6 +40,68 @@ type OpenAIModelConfig struct {
 	APIKey ValueSource `json:"apiKey"`
 	// +kubebuilder:validation:Optional
 	Properties map[string]ValueSource `json:"properties,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Overrides for OpenAI-compatible gateways with non-standard conventions
+	Endpoint *OpenAIEndpointOverrides `json:"endpoint,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=chat;text
+	// +kubebuilder:default="chat"
+	// text renders messages into a single prompt and calls the legacy
+	// /completions endpoint, for text-completion-only models such as base
+	// models served by vLLM
+	CompletionMode string `json:"completionMode,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Go template rendering messages into the prompt when completionMode is
+	// text; executed with .Messages, each carrying Role and Content. Defaults
+	// to a role-prefixed transcript ending with an assistant cue
+	PromptTemplate string `json:"promptTemplate,omitempty"`
+}
+
+// OpenAIEndpointOverrides adapts requests for OpenAI-compatible gateways with
+// non-standard paths or auth schemes
+type OpenAIEndpointOverrides struct {
+	// +kubebuilder:validation:Optional
+	// Request path used instead of the default /chat/completions; supports a
+	// {model} placeholder
+	PathTemplate string `json:"pathTemplate,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Header carrying the API key when the gateway does not accept the
+	// standard Authorization header
+	AuthHeaderName string `json:"authHeaderName,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Printf-style format applied to the key before sending, e.g. "Bearer %s"
+	AuthHeaderFormat string `json:"authHeaderFormat,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Extra query parameters appended to every request
+	QueryParams map[string]ValueSource `json:"queryParams,omitempty"`
+}
+
+// AnthropicModelConfig contains Anthropic API specific parameters
+type AnthropicModelConfig struct {
+	// +kubebuilder:validation:Optional
+	// Defaults to https://api.anthropic.com
+	BaseURL *ValueSource `json:"baseUrl,omitempty"`
+	// +kubebuilder:validation:Required
+	APIKey ValueSource `json:"apiKey"`
+	// +kubebuilder:validation:Optional
+	// Value for the anthropic-version header; defaults to 2023-06-01
+	APIVersion *ValueSource `json:"apiVersion,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100000
+	MaxTokens *int `json:"maxTokens,omitempty"`
+	// +kubebuilder:validation:Optional
+	Properties map[string]ValueSource `json:"properties,omitempty"`
+}
+
+// OllamaModelConfig points a Model at an Ollama server, typically an
+// in-cluster deployment; Ollama requires no API key
+type OllamaModelConfig struct {
+	// +kubebuilder:validation:Required
+	// Server address, e.g. http://ollama.ollama.svc:11434
+	BaseURL ValueSource `json:"baseUrl"`
+	// +kubebuilder:validation:Optional
+	Properties map[string]ValueSource `json:"properties,omitempty"`
 }
 
 // BedrockModelConfig contains AWS Bedrock specific parameters
@@ -63,17 +129,153 @@ type BedrockModelConfig struct {
 	Properties map[string]ValueSource `json:"properties,omitempty"`
 }
 
+// ModelFairShare divides the provider quota of a shared model across the
+// namespaces using it, so one team's batch load cannot starve another
+// team's interactive agents
+type ModelFairShare struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// Maximum in-flight provider calls across all namespaces
+	MaxConcurrent int `json:"maxConcurrent"`
+	// +kubebuilder:validation:Optional
+	// Per-namespace weights and burst limits; unlisted namespaces get
+	// weight 1 and no burst limit
+	Namespaces []FairShareNamespace `json:"namespaces,omitempty"`
+}
+
+// FairShareNamespace sets one namespace's share of a model's provider quota
+type FairShareNamespace struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// Relative share of the quota under contention
+	Weight *int `json:"weight,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Hard cap on this namespace's in-flight calls, even when the rest of
+	// the quota is idle
+	Burst *int `json:"burst,omitempty"`
+}
+
+// ModelCapabilities declares what the underlying model supports, so requests
+// using undeclared features fail with a clear CapabilityMismatch error
+// instead of provider 400s. Unset fields mean the capability is unknown and
+// no check is applied
+type ModelCapabilities struct {
+	// +kubebuilder:validation:Optional
+	Tools *bool `json:"tools,omitempty"`
+	// +kubebuilder:validation:Optional
+	Vision *bool `json:"vision,omitempty"`
+	// +kubebuilder:validation:Optional
+	JSONMode *bool `json:"jsonMode,omitempty"`
+	// +kubebuilder:validation:Optional
+	Streaming *bool `json:"streaming,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Maximum context size in tokens; used as the context window when
+	// spec.contextWindow is not set
+	MaxContext *int64 `json:"maxContext,omitempty"`
+}
+
+// ContentFilterPolicy configures how provider content-filter rejections are
+// handled for a model
+type ContentFilterPolicy struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="fail"
+	// +kubebuilder:validation:Enum=fail;sanitize;fallback
+	// fail surfaces the rejection, sanitize retries once with hidden markup
+	// stripped from the input, fallback retries once on fallbackModelRef
+	Strategy string `json:"strategy,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Model used for the retry when strategy is fallback
+	FallbackModelRef *AgentModelRef `json:"fallbackModelRef,omitempty"`
+}
+
 type ModelSpec struct {
 	// +kubebuilder:validation:Required
 	Model ValueSource `json:"model"`
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=openai;azure;bedrock
+	// +kubebuilder:validation:Enum=openai;azure;bedrock;anthropic;ollama
 	Type string `json:"type,omitempty"`
 	// +kubebuilder:validation:Required
 	Config ModelConfig `json:"config"`
 	// +kubebuilder:validation:Optional
+	Capabilities *ModelCapabilities `json:"capabilities,omitempty"`
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:default="1m"
 	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Declared context window in tokens; requests estimated to exceed it are
+	// rejected before the provider call, or routed to overflowModelRef
+	ContextWindow *int64 `json:"contextWindow,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Larger model used when a request exceeds this model's context window
+	OverflowModelRef *AgentModelRef `json:"overflowModelRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Handling for provider content-filter rejections
+	ContentFilterPolicy *ContentFilterPolicy `json:"contentFilterPolicy,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Fair-share scheduling of the provider quota across the namespaces
+	// sharing this model
+	FairShare *ModelFairShare `json:"fairShare,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Retry of transient provider failures; without it a single 429 fails
+	// the whole query
+	Retries *ModelRetries `json:"retries,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Token-bucket rate limits applied before each provider call, keeping
+	// fan-out queries inside the provider deployment quota
+	RateLimits *ModelRateLimits `json:"rateLimits,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinItems=1
+	// Alternate backends for the same model, e.g. Azure deployments in other
+	// regions. Calls are weight-balanced across healthy endpoints, with
+	// unhealthy ones skipped until their circuit breaker closes
+	Endpoints []ModelEndpoint `json:"endpoints,omitempty"`
+}
+
+// ModelEndpoint is one weighted backend address for a model
+type ModelEndpoint struct {
+	// +kubebuilder:validation:Required
+	BaseURL ValueSource `json:"baseUrl"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// Relative share of calls this endpoint receives
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// ModelRateLimits throttles provider calls for this model; at least one
+// limit must be set
+type ModelRateLimits struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerMinute *int64 `json:"requestsPerMinute,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Budget for estimated prompt tokens per minute
+	TokensPerMinute *int64 `json:"tokensPerMinute,omitempty"`
+}
+
+// ModelRetries retries provider calls that fail with transient HTTP errors,
+// backing off exponentially with jitter between attempts
+type ModelRetries struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// Total attempts including the first call
+	MaxAttempts int `json:"maxAttempts"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="1s"
+	// Delay before the first retry, doubled for each subsequent one
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// +kubebuilder:validation:Optional
+	// HTTP status codes that trigger a retry; defaults to 429 and 5xx
+	RetryableStatusCodes []int `json:"retryableStatusCodes,omitempty"`
 }
 
 type ModelStatus struct {
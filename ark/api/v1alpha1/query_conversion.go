@@ -0,0 +1,7 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1alpha1
+
+// Hub marks v1alpha1 as the storage version that other Query API versions
+// convert through.
+func (*Query) Hub() {}
@@ -4,15 +4,20 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 type QueryTarget struct {
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=agent;team;model;tool
+	// +kubebuilder:validation:Enum=agent;team;model;tool;router
 	Type string `json:"type"`
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// Overrides query-level streaming for this target: false keeps its
+	// chunks off the stream, for targets whose output is noise to UIs
+	Stream *bool `json:"stream,omitempty"`
 }
 
 type MemoryRef struct {
@@ -31,10 +36,74 @@ type EvaluatorRef struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
-type QuerySpec struct {
+// ExecutionWindow constrains when a query may start executing
+type ExecutionWindow struct {
+	// +kubebuilder:validation:Optional
+	// Earliest time execution may start
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Queries not started by this time fail instead of running late
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^([01]?\d|2[0-3])-([01]?\d|2[0-3])$`
+	// Allowed UTC start hours as "from-to" inclusive, e.g. "22-06" for
+	// overnight; the range may wrap midnight
+	AllowedHours string `json:"allowedHours,omitempty"`
+}
+
+// QueryHedging races equivalent targets for tail-latency reduction: the
+// first acceptable response wins and the remaining executions are canceled
+type QueryHedging struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=2
+	// Equivalent targets raced against each other
+	Targets []QueryTarget `json:"targets"`
+	// +kubebuilder:validation:Optional
+	// Head start between launches, so later hedges only spend tokens when
+	// earlier ones are slow; zero launches all targets at once
+	Delay *metav1.Duration `json:"delay,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Quick evaluator gating acceptance; a response that does not pass lets
+	// the race continue
+	Evaluator *EvaluatorRef `json:"evaluator,omitempty"`
+}
+
+// ToolOverride replaces a named tool's executor for one query only, leaving
+// the shared Tool and MCPServer resources untouched; used for reproducible
+// agent tests and incident reproduction
+type ToolOverride struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
-	Input string `json:"input"`
+	// Name of the tool to override
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// Canned response returned instead of executing the tool
+	Response string `json:"response,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Alternate endpoint the tool call is POSTed to instead of its normal
+	// executor; the request body carries the tool name and arguments
+	URL string `json:"url,omitempty"`
+}
+
+const (
+	ToolResponseFormatAssistant = "assistant"
+	ToolResponseFormatTool      = "tool"
+	ToolResponseFormatJSON      = "json"
+)
+
+const (
+	ThreadScopeShared    = "shared"
+	ThreadScopePerTarget = "per-target"
+)
+
+type QuerySpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	Input string `json:"input,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Messages in OpenAI chat format, including multimodal content parts, as
+	// an alternative to input for passing an entire prior conversation
+	Messages []runtime.RawExtension `json:"messages,omitempty"`
 	// +kubebuilder:validation:Optional
 	// Parameters for template processing in the input field
 	Parameters []Parameter `json:"parameters,omitempty"`
@@ -43,37 +112,161 @@ type QuerySpec struct {
 	// +kubebuilder:validation:Optional
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 	// +kubebuilder:validation:Optional
+	// Races equivalent targets and keeps only the first acceptable response,
+	// used instead of targets for latency-sensitive queries
+	Hedging *QueryHedging `json:"hedging,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Constrains when execution may start, holding the query pending until
+	// the window opens; used to defer batch workloads to off-peak hours
+	ExecutionWindow *ExecutionWindow `json:"executionWindow,omitempty"`
+	// +kubebuilder:validation:Optional
 	Memory *MemoryRef `json:"memory,omitempty"`
 	// +kubebuilder:validation:Optional
+	// Channels the completed responses are posted to
+	DeliverTo []DeliveryChannelRef `json:"deliverTo,omitempty"`
+	// +kubebuilder:validation:Optional
 	Evaluators []EvaluatorRef `json:"evaluators,omitempty"`
 	// +kubebuilder:validation:Optional
 	EvaluatorSelector *metav1.LabelSelector `json:"evaluatorSelector,omitempty"`
 	// +kubebuilder:validation:Optional
+	EvaluationPolicy *EvaluationPolicy `json:"evaluationPolicy,omitempty"`
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MinLength=1
 	ServiceAccount string `json:"serviceAccount,omitempty"`
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MinLength=1
 	SessionId string `json:"sessionId,omitempty"`
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// Client-supplied key for deduplication; a second query with the same key
+	// in the same namespace is rejected while the first is within the
+	// idempotency window
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:default="720h"
 	TTL *metav1.Duration `json:"ttl,omitempty"`
 	// +kubebuilder:default="5m"
 	// Timeout for query execution (e.g., "30s", "5m", "1h")
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=shared;per-target
+	// Scopes conversation history when the query fans out to several targets:
+	// shared (the default) keeps one thread per session, per-target keys
+	// history by conversation and target so agents do not see each other's
+	// in-progress outputs
+	ThreadScope string `json:"threadScope,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=assistant;tool;json
+	// Shape of response messages for tool targets: assistant wraps the output
+	// in an assistant message (the default), tool returns the assistant
+	// tool-call message followed by a tool message carrying the tool_call_id,
+	// and json returns a structured object with the tool name, parsed
+	// arguments and output
+	ToolResponseFormat string `json:"toolResponseFormat,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// Overrides the agent toolChoice for this query: auto, none, required, or
+	// the name of a specific tool the model must call
+	ToolChoice string `json:"toolChoice,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Per-query tool replacements mapping tool names to canned responses or
+	// an alternate endpoint
+	ToolOverrides []ToolOverride `json:"toolOverrides,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Caps response size for this query: passed to providers as the output
+	// token limit (lowered to the agent's own limit when that is smaller) and
+	// enforced post-hoc by truncating oversized responses with a
+	// ResponseTruncated event
+	MaxOutputTokens *int64 `json:"maxOutputTokens,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Retries failed target executions before the query is marked errored
+	Retry *QueryRetryPolicy `json:"retry,omitempty"`
+	// +kubebuilder:validation:Optional
 	// When true, indicates intent to cancel the query
 	Cancel bool `json:"cancel,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Webhook called with the query result when execution reaches a terminal
+	// phase
+	CompletionWebhook *CompletionWebhookSpec `json:"completionWebhook,omitempty"`
+}
+
+// CompletionWebhookSpec configures delivery of the query result to an
+// external HTTP endpoint
+type CompletionWebhookSpec struct {
+	// +kubebuilder:validation:Required
+	URL ValueSource `json:"url"`
+	// +kubebuilder:validation:Optional
+	// Secret used to sign the payload with HMAC-SHA256. The signature is sent
+	// as X-Ark-Signature: t=<unix>,v1=<hex> where v1 covers "<t>.<body>";
+	// receivers should recompute it and reject stale timestamps to prevent
+	// replay
+	SignatureSecretRef *ValueSource `json:"signatureSecretRef,omitempty"`
+}
+
+// EvaluationPolicy controls which queries get evaluated, so high-volume
+// traffic does not incur LLM-judge cost on every query. Sampling is
+// deterministic per query, so repeated reconciles agree on the decision
+type EvaluationPolicy struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=^(0(\.\d+)?|1(\.0+)?)$
+	// Fraction of queries that are evaluated; defaults to 1.0
+	SampleRate *string `json:"sampleRate,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Per-evaluator sample rates overriding sampleRate, keyed by evaluator name
+	EvaluatorOverrides map[string]string `json:"evaluatorOverrides,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Rules applying a sample rate to queries matching a label selector; the
+	// first matching rule takes precedence over sampleRate
+	Rules []EvaluationSamplingRule `json:"rules,omitempty"`
+}
+
+type EvaluationSamplingRule struct {
+	// +kubebuilder:validation:Required
+	Selector metav1.LabelSelector `json:"selector"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=^(0(\.\d+)?|1(\.0+)?)$
+	SampleRate string `json:"sampleRate"`
+}
+
+// Citation identifies a source that contributed to a response, such as a tool
+// result or a provider URL annotation.
+type Citation struct {
+	// +kubebuilder:validation:Enum=tool;url
+	Type string `json:"type,omitempty"`
+	// Identifier of the source, e.g. the tool call id
+	SourceID string `json:"sourceId,omitempty"`
+	// Name of the tool that produced the source
+	Tool  string `json:"tool,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Title string `json:"title,omitempty"`
 }
 
 // Response defines a response from a query target.
 type Response struct {
-	Target  QueryTarget `json:"target,omitempty"`
-	Content string      `json:"content,omitempty"`
-	Raw     string      `json:"raw,omitempty"`
+	Target    QueryTarget `json:"target,omitempty"`
+	Content   string      `json:"content,omitempty"`
+	Raw       string      `json:"raw,omitempty"`
+	Citations []Citation  `json:"citations,omitempty"`
+	// Completion choices beyond the first when the model was called with n>1,
+	// bounded and truncated to keep status small
+	Alternatives []string `json:"alternatives,omitempty"`
+	// Model that actually answered, which differs from the configured model
+	// when content-filter fallback or context-overflow routing kicked in
+	ServedBy string `json:"servedBy,omitempty"`
+	// Duration of this target's execution
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// Time until the first streamed token arrived, set for streaming queries
+	TimeToFirstToken *metav1.Duration `json:"timeToFirstToken,omitempty"`
+	// Cumulative time spent in model calls
+	ModelDuration *metav1.Duration `json:"modelDuration,omitempty"`
+	// Cumulative time spent in tool calls
+	ToolDuration *metav1.Duration `json:"toolDuration,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Duration",type=string,JSONPath=`.status.duration`
 // +kubebuilder:printcolumn:name="Evaluations",type=integer,JSONPath=`.status.evaluations.length`
@@ -94,6 +287,18 @@ type EvaluationResult struct {
 	Metadata      map[string]string `json:"metadata,omitempty"`
 }
 
+// QueryRetryPolicy bounds re-execution of failed targets
+type QueryRetryPolicy struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// Total attempts per target, including the first
+	MaxAttempts int `json:"maxAttempts"`
+	// +kubebuilder:validation:Optional
+	// Wait between attempts; unset retries immediately
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+}
+
 type TokenUsage struct {
 	PromptTokens     int64 `json:"promptTokens,omitempty"`
 	CompletionTokens int64 `json:"completionTokens,omitempty"`
@@ -102,13 +307,61 @@ type TokenUsage struct {
 
 type QueryStatus struct {
 	// +kubebuilder:default="pending"
-	// +kubebuilder:validation:Enum=pending;running;evaluating;error;done;canceled
+	// +kubebuilder:validation:Enum=pending;running;evaluating;waiting-on-tool;error;done;canceled
 	Phase       string             `json:"phase,omitempty"`
 	Responses   []Response         `json:"responses,omitempty"`
 	Evaluations []EvaluationResult `json:"evaluations,omitempty"`
 	TokenUsage  TokenUsage         `json:"tokenUsage,omitempty"`
 	// +kubebuilder:validation:Optional
 	Duration *metav1.Duration `json:"duration,omitempty"`
+	// +kubebuilder:validation:Optional
+	// OpenTelemetry trace ID of the query execution, for correlation with
+	// external tracing backends
+	TraceID string `json:"traceId,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Records the evaluation sampling decision when an evaluationPolicy is set
+	EvaluationSampling string `json:"evaluationSampling,omitempty"`
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Name of the Job the query is waiting on while an async tool runs
+	WaitingOnJob string `json:"waitingOnJob,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Records how router targets were resolved for this query
+	Routing []RoutingDecision `json:"routing,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Signature over the responses and key status fields, present when the
+	// namespace ArkConfig configures responseSigning
+	Signature *ResponseSignature `json:"signature,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Echoes the override annotations applied during execution
+	AppliedOverrides map[string]string `json:"appliedOverrides,omitempty"`
+}
+
+// ResponseSignature proves the responses were produced by this controller,
+// for verification by downstream systems in multi-hop pipelines
+type ResponseSignature struct {
+	// +kubebuilder:validation:Required
+	Algorithm string `json:"algorithm"`
+	// +kubebuilder:validation:Optional
+	// Identifies the signing key when namespaces rotate between keys
+	KeyID string `json:"keyId,omitempty"`
+	// +kubebuilder:validation:Required
+	// Base64-encoded signature over the canonical payload
+	Signature string `json:"signature"`
+	// +kubebuilder:validation:Optional
+	SignedAt metav1.Time `json:"signedAt,omitempty"`
+}
+
+// RoutingDecision records how a router target resolved to its final target
+type RoutingDecision struct {
+	// +kubebuilder:validation:Required
+	Router string `json:"router"`
+	// +kubebuilder:validation:Optional
+	// Detected ISO 639-1 language code; empty when detection was inconclusive
+	Language string `json:"language,omitempty"`
+	// +kubebuilder:validation:Required
+	Target QueryTarget `json:"target"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,49 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RouterRule maps detected input languages to a target
+type RouterRule struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// ISO 639-1 language codes this rule matches, e.g. "en", "es"
+	Languages []string `json:"languages"`
+	// +kubebuilder:validation:Required
+	Target QueryTarget `json:"target"`
+}
+
+// RouterSpec routes queries to per-language agents or models based on the
+// detected language of the input, replacing hand-built dispatcher agents
+type RouterSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Rules []RouterRule `json:"rules"`
+	// +kubebuilder:validation:Optional
+	// Target used when no rule matches the detected language
+	DefaultTarget *QueryTarget `json:"defaultTarget,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+type Router struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec RouterSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type RouterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Router `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Router{}, &RouterList{})
+}
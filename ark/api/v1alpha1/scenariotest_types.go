@@ -0,0 +1,127 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScenarioTurn is one scripted user turn with assertions on the agent's
+// response
+type ScenarioTurn struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// User input for this turn
+	Input string `json:"input"`
+	// +kubebuilder:validation:Optional
+	// Tool names the agent must call during this turn
+	ExpectedToolCalls []string `json:"expectedToolCalls,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Substrings the turn's final response must contain
+	Contains []string `json:"contains,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Substrings the turn's final response must not contain
+	NotContains []string `json:"notContains,omitempty"`
+}
+
+type ScenarioTestSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Agent under test, in the test's namespace
+	Agent string `json:"agent"`
+	// +kubebuilder:validation:Optional
+	// Scripted turns executed in order against a shared conversation;
+	// exactly one of turns or simulatedUser must be set
+	Turns []ScenarioTurn `json:"turns,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Persona model that drives the conversation instead of scripted turns
+	SimulatedUser *SimulatedUserSpec `json:"simulatedUser,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="2m"
+	// Timeout for the whole scenario
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// SimulatedUserSpec configures a persona model that plays the user in a
+// multi-turn conversation against the agent under test
+type SimulatedUserSpec struct {
+	// +kubebuilder:validation:Required
+	// Model that generates the user's turns
+	ModelRef AgentModelRef `json:"modelRef"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Who the simulated user is and how they communicate
+	Persona string `json:"persona"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// What the simulated user is trying to achieve; the conversation ends
+	// early when the persona model reports the goal met
+	Goal string `json:"goal"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=5
+	// Upper bound on user turns
+	MaxTurns *int `json:"maxTurns,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Evaluators scored against the transcript once the conversation ends
+	Evaluators []EvaluatorRef `json:"evaluators,omitempty"`
+}
+
+// ScenarioTurnResult records the outcome of one turn
+type ScenarioTurnResult struct {
+	Turn   int  `json:"turn"`
+	Passed bool `json:"passed"`
+	// +kubebuilder:validation:Optional
+	// Final response of the turn, truncated to keep status small
+	Response string `json:"response,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Assertion failures; empty when the turn passed
+	Failures []string `json:"failures,omitempty"`
+}
+
+type ScenarioTestStatus struct {
+	// +kubebuilder:default="pending"
+	// +kubebuilder:validation:Enum=pending;running;passed;failed;error
+	Phase string `json:"phase,omitempty"`
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+	// +kubebuilder:validation:Optional
+	Turns []ScenarioTurnResult `json:"turns,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Transcript of a simulated-user conversation, truncated to keep status
+	// small
+	Transcript string `json:"transcript,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Evaluator scores for the simulated-user transcript
+	Evaluations []EvaluationResult `json:"evaluations,omitempty"`
+	// +kubebuilder:validation:Optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Agent",type=string,JSONPath=`.spec.agent`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Duration",type=string,JSONPath=`.status.duration`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ScenarioTest is a declarative multi-turn test the controller executes
+// against an agent, recording pass/fail results in status
+type ScenarioTest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScenarioTestSpec   `json:"spec,omitempty"`
+	Status ScenarioTestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type ScenarioTestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScenarioTest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScenarioTest{}, &ScenarioTestList{})
+}
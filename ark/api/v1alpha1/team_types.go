@@ -25,6 +25,13 @@ type TeamGraphSpec struct {
 	Edges []TeamGraphEdge `json:"edges"`
 }
 
+type TeamParallelSpec struct {
+	// Maximum number of members executing at once; defaults to all members
+	MaxConcurrency *int `json:"maxConcurrency,omitempty"`
+	// Member that merges the fan-out responses after all members complete
+	Coordinator string `json:"coordinator,omitempty"`
+}
+
 type TeamSpec struct {
 	Members     []TeamMember      `json:"members"`
 	Strategy    string            `json:"strategy"`
@@ -32,6 +39,7 @@ type TeamSpec struct {
 	MaxTurns    *int              `json:"maxTurns,omitempty"`
 	Selector    *TeamSelectorSpec `json:"selector,omitempty"`
 	Graph       *TeamGraphSpec    `json:"graph,omitempty"`
+	Parallel    *TeamParallelSpec `json:"parallel,omitempty"`
 }
 
 type TeamStatus struct{}
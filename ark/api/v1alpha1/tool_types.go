@@ -65,7 +65,7 @@ type ToolAnnotations struct {
 
 type ToolSpec struct {
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Enum=http;mcp;agent
+	// +kubebuilder:validation:Enum=http;mcp;agent;job
 	Type string `json:"type"`
 	// Tool description
 	Description string `json:"description,omitempty"`
@@ -82,6 +82,63 @@ type ToolSpec struct {
 	// This field is required only if Type = "agent".
 	// +kubebuilder:validation:Optional
 	Agent *AgentToolRef `json:"agent,omitempty"`
+	// Sanitization applied to tool results before they re-enter the
+	// conversation
+	// +kubebuilder:validation:Optional
+	Sanitization *ToolSanitization `json:"sanitization,omitempty"`
+	// Job-specific configuration for async tools that run as Kubernetes Jobs.
+	// This field is required only if Type = "job".
+	// +kubebuilder:validation:Optional
+	Job *JobToolSpec `json:"job,omitempty"`
+	// Parameters resolved from Secrets or ConfigMaps and merged into the tool
+	// call arguments at execution time. They are stripped from the schema
+	// advertised to the model and override any model-supplied value, so
+	// credentials never pass through the conversation
+	// +kubebuilder:validation:Optional
+	InjectedParameters []Parameter `json:"injectedParameters,omitempty"`
+	// Compensation invoked for already-executed calls of this tool when the
+	// query fails or is canceled, undoing side effects saga-style
+	// +kubebuilder:validation:Optional
+	Compensation *ToolCompensation `json:"compensation,omitempty"`
+}
+
+// ToolCompensation names another tool that undoes this tool's side effects
+type ToolCompensation struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Tool invoked with the original call's arguments
+	ToolName string `json:"toolName"`
+}
+
+// JobToolSpec configures a tool that runs as a Kubernetes Job. The query
+// pauses in a waiting-on-tool phase while the job runs and resumes with the
+// container's termination message as the tool output
+type JobToolSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+	// Command run in the job container; arguments may reference tool call
+	// parameters as {param}
+	Command []string `json:"command,omitempty"`
+	// +kubebuilder:validation:Optional
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=600
+	// Seconds the job may run before it is marked failed
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// ToolSanitization guards against prompt injection carried in tool results
+type ToolSanitization struct {
+	// +kubebuilder:validation:Optional
+	// Strip HTML comments, script/style blocks and zero-width characters that
+	// can smuggle instructions invisible to the user
+	StripMarkup bool `json:"stripMarkup,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Flag "ignore previous instructions"-style content; detections are
+	// emitted as warning events and marked in the tool message so evaluators
+	// can see them
+	FlagInjectionPatterns bool `json:"flagInjectionPatterns,omitempty"`
 }
 
 type HTTPSpec struct {
@@ -107,6 +164,7 @@ const (
 	ToolTypeHTTP  = "http"
 	ToolTypeMCP   = "mcp"
 	ToolTypeAgent = "agent"
+	ToolTypeJob   = "job"
 )
 
 // Tool state constants
@@ -162,6 +220,37 @@ func (in *ToolSpec) DeepCopyInto(out *ToolSpec) {
 		*out = new(MCPToolRef)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Sanitization != nil {
+		in, out := &in.Sanitization, &out.Sanitization
+		*out = new(ToolSanitization)
+		**out = **in
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobToolSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InjectedParameters != nil {
+		in, out := &in.InjectedParameters, &out.InjectedParameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+func (in *JobToolSpec) DeepCopyInto(out *JobToolSpec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 func (in *MCPServerRef) DeepCopyInto(out *MCPServerRef) {
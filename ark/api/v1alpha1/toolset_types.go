@@ -0,0 +1,61 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ToolSetSpec struct {
+	// +kubebuilder:validation:Optional
+	// URL of the OpenAPI document to generate tools from
+	URL string `json:"url,omitempty"`
+	// +kubebuilder:validation:Optional
+	// OpenAPI document from a direct value, ConfigMap or Secret; takes
+	// precedence over url
+	SpecRef *ValueSource `json:"specRef,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Operation IDs to generate tools for; empty selects all operations
+	Operations []string `json:"operations,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Headers added to every generated tool's HTTP calls, for auth
+	Headers []Header `json:"headers,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Interval at which the OpenAPI document is re-fetched to keep generated
+	// tools in sync
+	// +kubebuilder:default="10m"
+	SyncInterval *metav1.Duration `json:"syncInterval,omitempty"`
+}
+
+type ToolSetStatus struct {
+	// +kubebuilder:default="pending"
+	// +kubebuilder:validation:Enum=pending;ready;error
+	Phase   string `json:"phase,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Names of the Tools generated from the OpenAPI document
+	Tools []string `json:"tools,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+type ToolSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ToolSetSpec   `json:"spec,omitempty"`
+	Status ToolSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type ToolSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ToolSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ToolSet{}, &ToolSetList{})
+}
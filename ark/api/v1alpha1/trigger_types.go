@@ -0,0 +1,95 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EventSelector matches Kubernetes events that should fire the trigger.
+type EventSelector struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Normal;Warning
+	Type string `json:"type,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Event reason to match (e.g. "BackOff", "Failed"). Empty matches any reason.
+	Reason string `json:"reason,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Kind of the involved object (e.g. "Pod"). Empty matches any kind.
+	InvolvedObjectKind string `json:"involvedObjectKind,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Name of the involved object. Empty matches any name.
+	InvolvedObjectName string `json:"involvedObjectName,omitempty"`
+}
+
+// QueryTemplate defines the Query created when the trigger fires. The input
+// field is processed as a template with event details available as parameters.
+type QueryTemplate struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Input string `json:"input"`
+	// +kubebuilder:validation:Optional
+	Parameters []Parameter `json:"parameters,omitempty"`
+	// +kubebuilder:validation:Optional
+	Targets []QueryTarget `json:"targets,omitempty"`
+	// +kubebuilder:validation:Optional
+	Memory *MemoryRef `json:"memory,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+}
+
+type TriggerSpec struct {
+	// +kubebuilder:validation:Required
+	Events EventSelector `json:"events"`
+	// +kubebuilder:validation:Required
+	Query QueryTemplate `json:"query"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="5m"
+	// Minimum interval between fired queries for the same involved object
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
+}
+
+type TriggerStatus struct {
+	// +kubebuilder:default="pending"
+	// +kubebuilder:validation:Enum=pending;ready;error
+	Phase   string `json:"phase,omitempty"`
+	Message string `json:"message,omitempty"`
+	// +kubebuilder:validation:Optional
+	LastFiredTime *metav1.Time `json:"lastFiredTime,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Last fired time per involved object ("Kind/name"), so the cooldown is
+	// enforced independently for each object. Stale entries are pruned once
+	// they can no longer suppress a firing
+	LastFiredByObject map[string]metav1.Time `json:"lastFiredByObject,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Name of the most recently created Query
+	LastQueryName string `json:"lastQueryName,omitempty"`
+	// +kubebuilder:validation:Optional
+	FiredCount int64 `json:"firedCount,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Fired",type=integer,JSONPath=`.status.firedCount`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+type Trigger struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TriggerSpec   `json:"spec,omitempty"`
+	Status TriggerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type TriggerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Trigger `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Trigger{}, &TriggerList{})
+}
@@ -0,0 +1,78 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TargetUsage aggregates activity for one query target within a report
+// window. TotalTokens sums the usage of every query the target participated
+// in
+type TargetUsage struct {
+	Target      string `json:"target"`
+	Queries     int64  `json:"queries"`
+	TotalTokens int64  `json:"totalTokens"`
+}
+
+type UsageReportSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="24h"
+	// Window of query activity the report covers
+	Window *metav1.Duration `json:"window,omitempty"`
+}
+
+type UsageReportStatus struct {
+	// +kubebuilder:validation:Optional
+	WindowStart metav1.Time `json:"windowStart,omitempty"`
+	// +kubebuilder:validation:Optional
+	WindowEnd metav1.Time `json:"windowEnd,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Queries that reached a terminal phase within the window
+	Queries int64 `json:"queries,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Queries that ended in the error phase
+	Errors int64 `json:"errors,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Errors as a percentage of queries, e.g. "2.5%"
+	ErrorRate string `json:"errorRate,omitempty"`
+	// +kubebuilder:validation:Optional
+	TokenUsage TokenUsage `json:"tokenUsage,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Cost of the window's token usage, set when the namespace configures
+	// controller.costPerMillionTokens
+	Cost string `json:"cost,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Most queried targets within the window, busiest first
+	TopTargets []TargetUsage `json:"topTargets,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Queries",type=integer,JSONPath=`.status.queries`
+// +kubebuilder:printcolumn:name="Tokens",type=integer,JSONPath=`.status.tokenUsage.totalTokens`
+// +kubebuilder:printcolumn:name="Error Rate",type=string,JSONPath=`.status.errorRate`
+// +kubebuilder:printcolumn:name="Cost",type=string,JSONPath=`.status.cost`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// UsageReport aggregates query activity for a namespace over a window. The
+// controller maintains one per active namespace for chargeback and capacity
+// review
+type UsageReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UsageReportSpec   `json:"spec,omitempty"`
+	Status UsageReportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type UsageReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UsageReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&UsageReport{}, &UsageReportList{})
+}
@@ -39,6 +39,41 @@ func (in *Agent) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentExample) DeepCopyInto(out *AgentExample) {
+	*out = *in
+	if in.ToolCalls != nil {
+		in, out := &in.ToolCalls, &out.ToolCalls
+		*out = make([]AgentExampleToolCall, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentExample.
+func (in *AgentExample) DeepCopy() *AgentExample {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentExample)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentExampleToolCall) DeepCopyInto(out *AgentExampleToolCall) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentExampleToolCall.
+func (in *AgentExampleToolCall) DeepCopy() *AgentExampleToolCall {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentExampleToolCall)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentList) DeepCopyInto(out *AgentList) {
 	*out = *in
@@ -86,6 +121,124 @@ func (in *AgentModelRef) DeepCopy() *AgentModelRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentRollout) DeepCopyInto(out *AgentRollout) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentRollout.
+func (in *AgentRollout) DeepCopy() *AgentRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentRollout) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentRolloutList) DeepCopyInto(out *AgentRolloutList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AgentRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentRolloutList.
+func (in *AgentRolloutList) DeepCopy() *AgentRolloutList {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentRolloutList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgentRolloutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentRolloutSpec) DeepCopyInto(out *AgentRolloutSpec) {
+	*out = *in
+	if in.CanaryPercent != nil {
+		in, out := &in.CanaryPercent, &out.CanaryPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinQueries != nil {
+		in, out := &in.MinQueries, &out.MinQueries
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxErrorPercent != nil {
+		in, out := &in.MaxErrorPercent, &out.MaxErrorPercent
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentRolloutSpec.
+func (in *AgentRolloutSpec) DeepCopy() *AgentRolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentRolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentRolloutStatus) DeepCopyInto(out *AgentRolloutStatus) {
+	*out = *in
+	if in.StableSpec != nil {
+		in, out := &in.StableSpec, &out.StableSpec
+		*out = new(AgentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentRolloutStatus.
+func (in *AgentRolloutStatus) DeepCopy() *AgentRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentSpec) DeepCopyInto(out *AgentSpec) {
 	*out = *in
@@ -118,6 +271,47 @@ func (in *AgentSpec) DeepCopyInto(out *AgentSpec) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ParallelToolCalls != nil {
+		in, out := &in.ParallelToolCalls, &out.ParallelToolCalls
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StrictTools != nil {
+		in, out := &in.StrictTools, &out.StrictTools
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxOutputTokens != nil {
+		in, out := &in.MaxOutputTokens, &out.MaxOutputTokens
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Stop != nil {
+		in, out := &in.Stop, &out.Stop
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LogitBias != nil {
+		in, out := &in.LogitBias, &out.LogitBias
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MessageTransforms != nil {
+		in, out := &in.MessageTransforms, &out.MessageTransforms
+		*out = make([]MessageTransform, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Examples != nil {
+		in, out := &in.Examples, &out.Examples
+		*out = make([]AgentExample, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentSpec.
@@ -187,6 +381,163 @@ func (in *AgentToolRef) DeepCopy() *AgentToolRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnthropicModelConfig) DeepCopyInto(out *AnthropicModelConfig) {
+	*out = *in
+	if in.BaseURL != nil {
+		in, out := &in.BaseURL, &out.BaseURL
+		*out = new(ValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	in.APIKey.DeepCopyInto(&out.APIKey)
+	if in.APIVersion != nil {
+		in, out := &in.APIVersion, &out.APIVersion
+		*out = new(ValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxTokens != nil {
+		in, out := &in.MaxTokens, &out.MaxTokens
+		*out = new(int)
+		**out = **in
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]ValueSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnthropicModelConfig.
+func (in *AnthropicModelConfig) DeepCopy() *AnthropicModelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AnthropicModelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArkConfig) DeepCopyInto(out *ArkConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArkConfig.
+func (in *ArkConfig) DeepCopy() *ArkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ArkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArkConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArkConfigList) DeepCopyInto(out *ArkConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArkConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArkConfigList.
+func (in *ArkConfigList) DeepCopy() *ArkConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArkConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArkConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArkConfigSpec) DeepCopyInto(out *ArkConfigSpec) {
+	*out = *in
+	if in.IdempotencyWindow != nil {
+		in, out := &in.IdempotencyWindow, &out.IdempotencyWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Controller != nil {
+		in, out := &in.Controller, &out.Controller
+		*out = new(ControllerSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChargebackLabels != nil {
+		in, out := &in.ChargebackLabels, &out.ChargebackLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressAllowlist != nil {
+		in, out := &in.EgressAllowlist, &out.EgressAllowlist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseSigning != nil {
+		in, out := &in.ResponseSigning, &out.ResponseSigning
+		*out = new(ResponseSigningConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MemoryQuota != nil {
+		in, out := &in.MemoryQuota, &out.MemoryQuota
+		*out = new(MemoryQuotaConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArkConfigSpec.
+func (in *ArkConfigSpec) DeepCopy() *ArkConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArkConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArkConfigStatus) DeepCopyInto(out *ArkConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArkConfigStatus.
+func (in *ArkConfigStatus) DeepCopy() *ArkConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArkConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureModelConfig) DeepCopyInto(out *AzureModelConfig) {
 	*out = *in
@@ -417,135 +768,139 @@ func (in *ChildEvaluationStatus) DeepCopy() *ChildEvaluationStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DirectEvaluationConfig) DeepCopyInto(out *DirectEvaluationConfig) {
+func (in *Citation) DeepCopyInto(out *Citation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectEvaluationConfig.
-func (in *DirectEvaluationConfig) DeepCopy() *DirectEvaluationConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Citation.
+func (in *Citation) DeepCopy() *Citation {
 	if in == nil {
 		return nil
 	}
-	out := new(DirectEvaluationConfig)
+	out := new(Citation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Evaluation) DeepCopyInto(out *Evaluation) {
+func (in *CompletionWebhookSpec) DeepCopyInto(out *CompletionWebhookSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.URL.DeepCopyInto(&out.URL)
+	if in.SignatureSecretRef != nil {
+		in, out := &in.SignatureSecretRef, &out.SignatureSecretRef
+		*out = new(ValueSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Evaluation.
-func (in *Evaluation) DeepCopy() *Evaluation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompletionWebhookSpec.
+func (in *CompletionWebhookSpec) DeepCopy() *CompletionWebhookSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Evaluation)
+	out := new(CompletionWebhookSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Evaluation) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentFilterPolicy) DeepCopyInto(out *ContentFilterPolicy) {
+	*out = *in
+	if in.FallbackModelRef != nil {
+		in, out := &in.FallbackModelRef, &out.FallbackModelRef
+		*out = new(AgentModelRef)
+		**out = **in
 	}
-	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentFilterPolicy.
+func (in *ContentFilterPolicy) DeepCopy() *ContentFilterPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentFilterPolicy)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluationConfig) DeepCopyInto(out *EvaluationConfig) {
+func (in *ControllerSettings) DeepCopyInto(out *ControllerSettings) {
 	*out = *in
-	if in.DirectEvaluationConfig != nil {
-		in, out := &in.DirectEvaluationConfig, &out.DirectEvaluationConfig
-		*out = new(DirectEvaluationConfig)
+	if in.MemoryHTTPTimeout != nil {
+		in, out := &in.MemoryHTTPTimeout, &out.MemoryHTTPTimeout
+		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.QueryBasedEvaluationConfig != nil {
-		in, out := &in.QueryBasedEvaluationConfig, &out.QueryBasedEvaluationConfig
-		*out = new(QueryBasedEvaluationConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.BatchEvaluationConfig != nil {
-		in, out := &in.BatchEvaluationConfig, &out.BatchEvaluationConfig
-		*out = new(BatchEvaluationConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.BaselineEvaluationConfig != nil {
-		in, out := &in.BaselineEvaluationConfig, &out.BaselineEvaluationConfig
-		*out = new(BaselineEvaluationConfig)
+	if in.DefaultQueryTimeout != nil {
+		in, out := &in.DefaultQueryTimeout, &out.DefaultQueryTimeout
+		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.EventEvaluationConfig != nil {
-		in, out := &in.EventEvaluationConfig, &out.EventEvaluationConfig
-		*out = new(EventEvaluationConfig)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationConfig.
-func (in *EvaluationConfig) DeepCopy() *EvaluationConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerSettings.
+func (in *ControllerSettings) DeepCopy() *ControllerSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(EvaluationConfig)
+	out := new(ControllerSettings)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluationEvaluatorRef) DeepCopyInto(out *EvaluationEvaluatorRef) {
+func (in *ConversationSnapshot) DeepCopyInto(out *ConversationSnapshot) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make([]Parameter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationEvaluatorRef.
-func (in *EvaluationEvaluatorRef) DeepCopy() *EvaluationEvaluatorRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConversationSnapshot.
+func (in *ConversationSnapshot) DeepCopy() *ConversationSnapshot {
 	if in == nil {
 		return nil
 	}
-	out := new(EvaluationEvaluatorRef)
+	out := new(ConversationSnapshot)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConversationSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluationList) DeepCopyInto(out *EvaluationList) {
+func (in *ConversationSnapshotList) DeepCopyInto(out *ConversationSnapshotList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Evaluation, len(*in))
+		*out = make([]ConversationSnapshot, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationList.
-func (in *EvaluationList) DeepCopy() *EvaluationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConversationSnapshotList.
+func (in *ConversationSnapshotList) DeepCopy() *ConversationSnapshotList {
 	if in == nil {
 		return nil
 	}
-	out := new(EvaluationList)
+	out := new(ConversationSnapshotList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EvaluationList) DeepCopyObject() runtime.Object {
+func (in *ConversationSnapshotList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -553,152 +908,139 @@ func (in *EvaluationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluationRef) DeepCopyInto(out *EvaluationRef) {
+func (in *ConversationSnapshotSpec) DeepCopyInto(out *ConversationSnapshotSpec) {
 	*out = *in
+	out.Memory = in.Memory
+	if in.Messages != nil {
+		in, out := &in.Messages, &out.Messages
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImportSource != nil {
+		in, out := &in.ImportSource, &out.ImportSource
+		*out = new(ValueSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationRef.
-func (in *EvaluationRef) DeepCopy() *EvaluationRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConversationSnapshotSpec.
+func (in *ConversationSnapshotSpec) DeepCopy() *ConversationSnapshotSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EvaluationRef)
+	out := new(ConversationSnapshotSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluationResult) DeepCopyInto(out *EvaluationResult) {
+func (in *ConversationSnapshotStatus) DeepCopyInto(out *ConversationSnapshotStatus) {
 	*out = *in
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationResult.
-func (in *EvaluationResult) DeepCopy() *EvaluationResult {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConversationSnapshotStatus.
+func (in *ConversationSnapshotStatus) DeepCopy() *ConversationSnapshotStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EvaluationResult)
+	out := new(ConversationSnapshotStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluationSpec) DeepCopyInto(out *EvaluationSpec) {
+func (in *DeliveryChannel) DeepCopyInto(out *DeliveryChannel) {
 	*out = *in
-	in.Config.DeepCopyInto(&out.Config)
-	in.Evaluator.DeepCopyInto(&out.Evaluator)
-	if in.TTL != nil {
-		in, out := &in.TTL, &out.TTL
-		*out = new(v1.Duration)
-		**out = **in
-	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(v1.Duration)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationSpec.
-func (in *EvaluationSpec) DeepCopy() *EvaluationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeliveryChannel.
+func (in *DeliveryChannel) DeepCopy() *DeliveryChannel {
 	if in == nil {
 		return nil
 	}
-	out := new(EvaluationSpec)
+	out := new(DeliveryChannel)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeliveryChannel) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluationStatus) DeepCopyInto(out *EvaluationStatus) {
+func (in *DeliveryChannelConfig) DeepCopyInto(out *DeliveryChannelConfig) {
 	*out = *in
-	if in.TokenUsage != nil {
-		in, out := &in.TokenUsage, &out.TokenUsage
-		*out = new(TokenUsage)
-		**out = **in
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(SlackDeliveryConfig)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.Duration != nil {
-		in, out := &in.Duration, &out.Duration
-		*out = new(v1.Duration)
-		**out = **in
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = new(TeamsDeliveryConfig)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.BatchProgress != nil {
-		in, out := &in.BatchProgress, &out.BatchProgress
-		*out = new(BatchEvaluationProgress)
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookDeliveryConfig)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationStatus.
-func (in *EvaluationStatus) DeepCopy() *EvaluationStatus {
-	if in == nil {
-		return nil
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(EmailDeliveryConfig)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(EvaluationStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Evaluator) DeepCopyInto(out *Evaluator) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Evaluator.
-func (in *Evaluator) DeepCopy() *Evaluator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeliveryChannelConfig.
+func (in *DeliveryChannelConfig) DeepCopy() *DeliveryChannelConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(Evaluator)
+	out := new(DeliveryChannelConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Evaluator) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluatorList) DeepCopyInto(out *EvaluatorList) {
+func (in *DeliveryChannelList) DeepCopyInto(out *DeliveryChannelList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Evaluator, len(*in))
+		*out = make([]DeliveryChannel, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluatorList.
-func (in *EvaluatorList) DeepCopy() *EvaluatorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeliveryChannelList.
+func (in *DeliveryChannelList) DeepCopy() *DeliveryChannelList {
 	if in == nil {
 		return nil
 	}
-	out := new(EvaluatorList)
+	out := new(DeliveryChannelList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EvaluatorList) DeepCopyObject() runtime.Object {
+func (in *DeliveryChannelList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -706,200 +1048,2003 @@ func (in *EvaluatorList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluatorRef) DeepCopyInto(out *EvaluatorRef) {
+func (in *DeliveryChannelRef) DeepCopyInto(out *DeliveryChannelRef) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluatorRef.
-func (in *EvaluatorRef) DeepCopy() *EvaluatorRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeliveryChannelRef.
+func (in *DeliveryChannelRef) DeepCopy() *DeliveryChannelRef {
 	if in == nil {
 		return nil
 	}
-	out := new(EvaluatorRef)
+	out := new(DeliveryChannelRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluatorSpec) DeepCopyInto(out *EvaluatorSpec) {
+func (in *DeliveryChannelSpec) DeepCopyInto(out *DeliveryChannelSpec) {
 	*out = *in
-	in.Address.DeepCopyInto(&out.Address)
-	if in.Selector != nil {
-		in, out := &in.Selector, &out.Selector
-		*out = new(ResourceSelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make([]Parameter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.Config.DeepCopyInto(&out.Config)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluatorSpec.
-func (in *EvaluatorSpec) DeepCopy() *EvaluatorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeliveryChannelSpec.
+func (in *DeliveryChannelSpec) DeepCopy() *DeliveryChannelSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EvaluatorSpec)
+	out := new(DeliveryChannelSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EvaluatorStatus) DeepCopyInto(out *EvaluatorStatus) {
+func (in *DirectEvaluationConfig) DeepCopyInto(out *DirectEvaluationConfig) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluatorStatus.
-func (in *EvaluatorStatus) DeepCopy() *EvaluatorStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectEvaluationConfig.
+func (in *DirectEvaluationConfig) DeepCopy() *DirectEvaluationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(EvaluatorStatus)
+	out := new(DirectEvaluationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventEvaluationConfig) DeepCopyInto(out *EventEvaluationConfig) {
+func (in *EmailDeliveryConfig) DeepCopyInto(out *EmailDeliveryConfig) {
 	*out = *in
-	if in.Rules != nil {
-		in, out := &in.Rules, &out.Rules
-		*out = make([]ExpressionRule, len(*in))
+	in.Host.DeepCopyInto(&out.Host)
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(ValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(ValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventEvaluationConfig.
-func (in *EventEvaluationConfig) DeepCopy() *EventEvaluationConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailDeliveryConfig.
+func (in *EmailDeliveryConfig) DeepCopy() *EmailDeliveryConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(EventEvaluationConfig)
+	out := new(EmailDeliveryConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecutionEngineRef) DeepCopyInto(out *ExecutionEngineRef) {
+func (in *Evaluation) DeepCopyInto(out *Evaluation) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionEngineRef.
-func (in *ExecutionEngineRef) DeepCopy() *ExecutionEngineRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Evaluation.
+func (in *Evaluation) DeepCopy() *Evaluation {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecutionEngineRef)
+	out := new(Evaluation)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExpressionRule) DeepCopyInto(out *ExpressionRule) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpressionRule.
-func (in *ExpressionRule) DeepCopy() *ExpressionRule {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Evaluation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(ExpressionRule)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSpec.
-func (in *HTTPSpec) DeepCopy() *HTTPSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(HTTPSpec)
-	in.DeepCopyInto(out)
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluationConfig) DeepCopyInto(out *EvaluationConfig) {
+	*out = *in
+	if in.DirectEvaluationConfig != nil {
+		in, out := &in.DirectEvaluationConfig, &out.DirectEvaluationConfig
+		*out = new(DirectEvaluationConfig)
+		**out = **in
+	}
+	if in.QueryBasedEvaluationConfig != nil {
+		in, out := &in.QueryBasedEvaluationConfig, &out.QueryBasedEvaluationConfig
+		*out = new(QueryBasedEvaluationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BatchEvaluationConfig != nil {
+		in, out := &in.BatchEvaluationConfig, &out.BatchEvaluationConfig
+		*out = new(BatchEvaluationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BaselineEvaluationConfig != nil {
+		in, out := &in.BaselineEvaluationConfig, &out.BaselineEvaluationConfig
+		*out = new(BaselineEvaluationConfig)
+		**out = **in
+	}
+	if in.EventEvaluationConfig != nil {
+		in, out := &in.EventEvaluationConfig, &out.EventEvaluationConfig
+		*out = new(EventEvaluationConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationConfig.
+func (in *EvaluationConfig) DeepCopy() *EvaluationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluationEvaluatorRef) DeepCopyInto(out *EvaluationEvaluatorRef) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationEvaluatorRef.
+func (in *EvaluationEvaluatorRef) DeepCopy() *EvaluationEvaluatorRef {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluationEvaluatorRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluationList) DeepCopyInto(out *EvaluationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Evaluation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationList.
+func (in *EvaluationList) DeepCopy() *EvaluationList {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EvaluationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluationPolicy) DeepCopyInto(out *EvaluationPolicy) {
+	*out = *in
+	if in.SampleRate != nil {
+		in, out := &in.SampleRate, &out.SampleRate
+		*out = new(string)
+		**out = **in
+	}
+	if in.EvaluatorOverrides != nil {
+		in, out := &in.EvaluatorOverrides, &out.EvaluatorOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]EvaluationSamplingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationPolicy.
+func (in *EvaluationPolicy) DeepCopy() *EvaluationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluationRef) DeepCopyInto(out *EvaluationRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationRef.
+func (in *EvaluationRef) DeepCopy() *EvaluationRef {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluationRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluationResult) DeepCopyInto(out *EvaluationResult) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationResult.
+func (in *EvaluationResult) DeepCopy() *EvaluationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluationSamplingRule) DeepCopyInto(out *EvaluationSamplingRule) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationSamplingRule.
+func (in *EvaluationSamplingRule) DeepCopy() *EvaluationSamplingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluationSamplingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluationSpec) DeepCopyInto(out *EvaluationSpec) {
+	*out = *in
+	in.Config.DeepCopyInto(&out.Config)
+	in.Evaluator.DeepCopyInto(&out.Evaluator)
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationSpec.
+func (in *EvaluationSpec) DeepCopy() *EvaluationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluationStatus) DeepCopyInto(out *EvaluationStatus) {
+	*out = *in
+	if in.TokenUsage != nil {
+		in, out := &in.TokenUsage, &out.TokenUsage
+		*out = new(TokenUsage)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BatchProgress != nil {
+		in, out := &in.BatchProgress, &out.BatchProgress
+		*out = new(BatchEvaluationProgress)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluationStatus.
+func (in *EvaluationStatus) DeepCopy() *EvaluationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Evaluator) DeepCopyInto(out *Evaluator) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Evaluator.
+func (in *Evaluator) DeepCopy() *Evaluator {
+	if in == nil {
+		return nil
+	}
+	out := new(Evaluator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Evaluator) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluatorList) DeepCopyInto(out *EvaluatorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Evaluator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluatorList.
+func (in *EvaluatorList) DeepCopy() *EvaluatorList {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluatorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EvaluatorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluatorRef) DeepCopyInto(out *EvaluatorRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluatorRef.
+func (in *EvaluatorRef) DeepCopy() *EvaluatorRef {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluatorRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluatorSpec) DeepCopyInto(out *EvaluatorSpec) {
+	*out = *in
+	in.Address.DeepCopyInto(&out.Address)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(ResourceSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TokenRef != nil {
+		in, out := &in.TokenRef, &out.TokenRef
+		*out = new(ValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluatorSpec.
+func (in *EvaluatorSpec) DeepCopy() *EvaluatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvaluatorStatus) DeepCopyInto(out *EvaluatorStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvaluatorStatus.
+func (in *EvaluatorStatus) DeepCopy() *EvaluatorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EvaluatorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventEvaluationConfig) DeepCopyInto(out *EventEvaluationConfig) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ExpressionRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventEvaluationConfig.
+func (in *EventEvaluationConfig) DeepCopy() *EventEvaluationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EventEvaluationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSelector) DeepCopyInto(out *EventSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSelector.
+func (in *EventSelector) DeepCopy() *EventSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionEngineRef) DeepCopyInto(out *ExecutionEngineRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionEngineRef.
+func (in *ExecutionEngineRef) DeepCopy() *ExecutionEngineRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionEngineRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionWindow) DeepCopyInto(out *ExecutionWindow) {
+	*out = *in
+	if in.NotBefore != nil {
+		in, out := &in.NotBefore, &out.NotBefore
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionWindow.
+func (in *ExecutionWindow) DeepCopy() *ExecutionWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpressionRule) DeepCopyInto(out *ExpressionRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpressionRule.
+func (in *ExpressionRule) DeepCopy() *ExpressionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpressionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FairShareNamespace) DeepCopyInto(out *FairShareNamespace) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FairShareNamespace.
+func (in *FairShareNamespace) DeepCopy() *FairShareNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(FairShareNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSpec.
+func (in *HTTPSpec) DeepCopy() *HTTPSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Header) DeepCopyInto(out *Header) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Header.
+func (in *Header) DeepCopy() *Header {
+	if in == nil {
+		return nil
+	}
+	out := new(Header)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderValue) DeepCopyInto(out *HeaderValue) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(HeaderValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderValue.
+func (in *HeaderValue) DeepCopy() *HeaderValue {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderValueSource) DeepCopyInto(out *HeaderValueSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderValueSource.
+func (in *HeaderValueSource) DeepCopy() *HeaderValueSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderValueSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobToolSpec.
+func (in *JobToolSpec) DeepCopy() *JobToolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JobToolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServer) DeepCopyInto(out *MCPServer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
+func (in *MCPServer) DeepCopy() *MCPServer {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerList) DeepCopyInto(out *MCPServerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MCPServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerList.
+func (in *MCPServerList) DeepCopy() *MCPServerList {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MCPServerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerRef.
+func (in *MCPServerRef) DeepCopy() *MCPServerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerSpec) DeepCopyInto(out *MCPServerSpec) {
+	*out = *in
+	in.Address.DeepCopyInto(&out.Address)
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]Header, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerSpec.
+func (in *MCPServerSpec) DeepCopy() *MCPServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerStatus) DeepCopyInto(out *MCPServerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerStatus.
+func (in *MCPServerStatus) DeepCopy() *MCPServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolRef.
+func (in *MCPToolRef) DeepCopy() *MCPToolRef {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPToolRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedMemorySettings) DeepCopyInto(out *ManagedMemorySettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedMemorySettings.
+func (in *ManagedMemorySettings) DeepCopy() *ManagedMemorySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedMemorySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Memory) DeepCopyInto(out *Memory) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Memory.
+func (in *Memory) DeepCopy() *Memory {
+	if in == nil {
+		return nil
+	}
+	out := new(Memory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Memory) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryCapabilities) DeepCopyInto(out *MemoryCapabilities) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryCapabilities.
+func (in *MemoryCapabilities) DeepCopy() *MemoryCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryCompaction) DeepCopyInto(out *MemoryCompaction) {
+	*out = *in
+	out.ModelRef = in.ModelRef
+	if in.IdleThreshold != nil {
+		in, out := &in.IdleThreshold, &out.IdleThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.KeepRecent != nil {
+		in, out := &in.KeepRecent, &out.KeepRecent
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryCompaction.
+func (in *MemoryCompaction) DeepCopy() *MemoryCompaction {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryCompaction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryList) DeepCopyInto(out *MemoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Memory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryList.
+func (in *MemoryList) DeepCopy() *MemoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MemoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryQuotaConfig) DeepCopyInto(out *MemoryQuotaConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryQuotaConfig.
+func (in *MemoryQuotaConfig) DeepCopy() *MemoryQuotaConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryQuotaConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryRef) DeepCopyInto(out *MemoryRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryRef.
+func (in *MemoryRef) DeepCopy() *MemoryRef {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemorySemanticRecall) DeepCopyInto(out *MemorySemanticRecall) {
+	*out = *in
+	out.ModelRef = in.ModelRef
+	if in.TopK != nil {
+		in, out := &in.TopK, &out.TopK
+		*out = new(int)
+		**out = **in
+	}
+	if in.RecentWindow != nil {
+		in, out := &in.RecentWindow, &out.RecentWindow
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemorySemanticRecall.
+func (in *MemorySemanticRecall) DeepCopy() *MemorySemanticRecall {
+	if in == nil {
+		return nil
+	}
+	out := new(MemorySemanticRecall)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemorySpec) DeepCopyInto(out *MemorySpec) {
+	*out = *in
+	in.Address.DeepCopyInto(&out.Address)
+	if in.ManagedSettings != nil {
+		in, out := &in.ManagedSettings, &out.ManagedSettings
+		*out = new(ManagedMemorySettings)
+		**out = **in
+	}
+	if in.SessionRetention != nil {
+		in, out := &in.SessionRetention, &out.SessionRetention
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Compaction != nil {
+		in, out := &in.Compaction, &out.Compaction
+		*out = new(MemoryCompaction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SemanticRecall != nil {
+		in, out := &in.SemanticRecall, &out.SemanticRecall
+		*out = new(MemorySemanticRecall)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemorySpec.
+func (in *MemorySpec) DeepCopy() *MemorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MemorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryStatus) DeepCopyInto(out *MemoryStatus) {
+	*out = *in
+	if in.LastResolvedAddress != nil {
+		in, out := &in.LastResolvedAddress, &out.LastResolvedAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(MemoryCapabilities)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStatus.
+func (in *MemoryStatus) DeepCopy() *MemoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageTransform) DeepCopyInto(out *MessageTransform) {
+	*out = *in
+	if in.Turns != nil {
+		in, out := &in.Turns, &out.Turns
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageTransform.
+func (in *MessageTransform) DeepCopy() *MessageTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Model) DeepCopyInto(out *Model) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Model.
+func (in *Model) DeepCopy() *Model {
+	if in == nil {
+		return nil
+	}
+	out := new(Model)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Model) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelCapabilities) DeepCopyInto(out *ModelCapabilities) {
+	*out = *in
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Vision != nil {
+		in, out := &in.Vision, &out.Vision
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JSONMode != nil {
+		in, out := &in.JSONMode, &out.JSONMode
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxContext != nil {
+		in, out := &in.MaxContext, &out.MaxContext
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelCapabilities.
+func (in *ModelCapabilities) DeepCopy() *ModelCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelConfig) DeepCopyInto(out *ModelConfig) {
+	*out = *in
+	if in.OpenAI != nil {
+		in, out := &in.OpenAI, &out.OpenAI
+		*out = new(OpenAIModelConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureModelConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bedrock != nil {
+		in, out := &in.Bedrock, &out.Bedrock
+		*out = new(BedrockModelConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Anthropic != nil {
+		in, out := &in.Anthropic, &out.Anthropic
+		*out = new(AnthropicModelConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ollama != nil {
+		in, out := &in.Ollama, &out.Ollama
+		*out = new(OllamaModelConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelConfig.
+func (in *ModelConfig) DeepCopy() *ModelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelEndpoint) DeepCopyInto(out *ModelEndpoint) {
+	*out = *in
+	in.BaseURL.DeepCopyInto(&out.BaseURL)
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelEndpoint.
+func (in *ModelEndpoint) DeepCopy() *ModelEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelFairShare) DeepCopyInto(out *ModelFairShare) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]FairShareNamespace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelFairShare.
+func (in *ModelFairShare) DeepCopy() *ModelFairShare {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelFairShare)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelList) DeepCopyInto(out *ModelList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Model, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelList.
+func (in *ModelList) DeepCopy() *ModelList {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelRateLimits) DeepCopyInto(out *ModelRateLimits) {
+	*out = *in
+	if in.RequestsPerMinute != nil {
+		in, out := &in.RequestsPerMinute, &out.RequestsPerMinute
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TokensPerMinute != nil {
+		in, out := &in.TokensPerMinute, &out.TokensPerMinute
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRateLimits.
+func (in *ModelRateLimits) DeepCopy() *ModelRateLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelRateLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelRetries) DeepCopyInto(out *ModelRetries) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RetryableStatusCodes != nil {
+		in, out := &in.RetryableStatusCodes, &out.RetryableStatusCodes
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRetries.
+func (in *ModelRetries) DeepCopy() *ModelRetries {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelRetries)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
+	*out = *in
+	in.Model.DeepCopyInto(&out.Model)
+	in.Config.DeepCopyInto(&out.Config)
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(ModelCapabilities)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ContextWindow != nil {
+		in, out := &in.ContextWindow, &out.ContextWindow
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OverflowModelRef != nil {
+		in, out := &in.OverflowModelRef, &out.OverflowModelRef
+		*out = new(AgentModelRef)
+		**out = **in
+	}
+	if in.ContentFilterPolicy != nil {
+		in, out := &in.ContentFilterPolicy, &out.ContentFilterPolicy
+		*out = new(ContentFilterPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FairShare != nil {
+		in, out := &in.FairShare, &out.FairShare
+		*out = new(ModelFairShare)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(ModelRetries)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = new(ModelRateLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]ModelEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
+func (in *ModelSpec) DeepCopy() *ModelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStatus.
+func (in *ModelStatus) DeepCopy() *ModelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OllamaModelConfig) DeepCopyInto(out *OllamaModelConfig) {
+	*out = *in
+	in.BaseURL.DeepCopyInto(&out.BaseURL)
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]ValueSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OllamaModelConfig.
+func (in *OllamaModelConfig) DeepCopy() *OllamaModelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OllamaModelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenAIEndpointOverrides) DeepCopyInto(out *OpenAIEndpointOverrides) {
+	*out = *in
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make(map[string]ValueSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenAIEndpointOverrides.
+func (in *OpenAIEndpointOverrides) DeepCopy() *OpenAIEndpointOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenAIEndpointOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenAIModelConfig) DeepCopyInto(out *OpenAIModelConfig) {
+	*out = *in
+	in.BaseURL.DeepCopyInto(&out.BaseURL)
+	in.APIKey.DeepCopyInto(&out.APIKey)
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]ValueSource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(OpenAIEndpointOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenAIModelConfig.
+func (in *OpenAIModelConfig) DeepCopy() *OpenAIModelConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenAIModelConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Parameter) DeepCopyInto(out *Parameter) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(ValueFromSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Parameter.
+func (in *Parameter) DeepCopy() *Parameter {
+	if in == nil {
+		return nil
+	}
+	out := new(Parameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Query) DeepCopyInto(out *Query) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Query.
+func (in *Query) DeepCopy() *Query {
+	if in == nil {
+		return nil
+	}
+	out := new(Query)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Query) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryBasedEvaluationConfig) DeepCopyInto(out *QueryBasedEvaluationConfig) {
+	*out = *in
+	if in.QueryRef != nil {
+		in, out := &in.QueryRef, &out.QueryRef
+		*out = new(QueryRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryBasedEvaluationConfig.
+func (in *QueryBasedEvaluationConfig) DeepCopy() *QueryBasedEvaluationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryBasedEvaluationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryHedging) DeepCopyInto(out *QueryHedging) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]QueryTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Evaluator != nil {
+		in, out := &in.Evaluator, &out.Evaluator
+		*out = new(EvaluatorRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryHedging.
+func (in *QueryHedging) DeepCopy() *QueryHedging {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryHedging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryList) DeepCopyInto(out *QueryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Query, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryList.
+func (in *QueryList) DeepCopy() *QueryList {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QueryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryParameterReference) DeepCopyInto(out *QueryParameterReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryParameterReference.
+func (in *QueryParameterReference) DeepCopy() *QueryParameterReference {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryParameterReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryRef) DeepCopyInto(out *QueryRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryRef.
+func (in *QueryRef) DeepCopy() *QueryRef {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryRetryPolicy) DeepCopyInto(out *QueryRetryPolicy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryRetryPolicy.
+func (in *QueryRetryPolicy) DeepCopy() *QueryRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuerySelector) DeepCopyInto(out *QuerySelector) {
+	*out = *in
+	if in.MatchLabels != nil {
+		in, out := &in.MatchLabels, &out.MatchLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MatchExpressions != nil {
+		in, out := &in.MatchExpressions, &out.MatchExpressions
+		*out = make([]v1.LabelSelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuerySelector.
+func (in *QuerySelector) DeepCopy() *QuerySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(QuerySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuerySpec) DeepCopyInto(out *QuerySpec) {
+	*out = *in
+	if in.Messages != nil {
+		in, out := &in.Messages, &out.Messages
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]QueryTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hedging != nil {
+		in, out := &in.Hedging, &out.Hedging
+		*out = new(QueryHedging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExecutionWindow != nil {
+		in, out := &in.ExecutionWindow, &out.ExecutionWindow
+		*out = new(ExecutionWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(MemoryRef)
+		**out = **in
+	}
+	if in.DeliverTo != nil {
+		in, out := &in.DeliverTo, &out.DeliverTo
+		*out = make([]DeliveryChannelRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.Evaluators != nil {
+		in, out := &in.Evaluators, &out.Evaluators
+		*out = make([]EvaluatorRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.EvaluatorSelector != nil {
+		in, out := &in.EvaluatorSelector, &out.EvaluatorSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EvaluationPolicy != nil {
+		in, out := &in.EvaluationPolicy, &out.EvaluationPolicy
+		*out = new(EvaluationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ToolOverrides != nil {
+		in, out := &in.ToolOverrides, &out.ToolOverrides
+		*out = make([]ToolOverride, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxOutputTokens != nil {
+		in, out := &in.MaxOutputTokens, &out.MaxOutputTokens
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(QueryRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CompletionWebhook != nil {
+		in, out := &in.CompletionWebhook, &out.CompletionWebhook
+		*out = new(CompletionWebhookSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuerySpec.
+func (in *QuerySpec) DeepCopy() *QuerySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuerySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryStatus) DeepCopyInto(out *QueryStatus) {
+	*out = *in
+	if in.Responses != nil {
+		in, out := &in.Responses, &out.Responses
+		*out = make([]Response, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Evaluations != nil {
+		in, out := &in.Evaluations, &out.Evaluations
+		*out = make([]EvaluationResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.TokenUsage = in.TokenUsage
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Routing != nil {
+		in, out := &in.Routing, &out.Routing
+		*out = make([]RoutingDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(ResponseSignature)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppliedOverrides != nil {
+		in, out := &in.AppliedOverrides, &out.AppliedOverrides
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryStatus.
+func (in *QueryStatus) DeepCopy() *QueryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryTarget) DeepCopyInto(out *QueryTarget) {
+	*out = *in
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryTarget.
+func (in *QueryTarget) DeepCopy() *QueryTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryTemplate) DeepCopyInto(out *QueryTemplate) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]QueryTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(MemoryRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryTemplate.
+func (in *QueryTemplate) DeepCopy() *QueryTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSelector) DeepCopyInto(out *ResourceSelector) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSelector.
+func (in *ResourceSelector) DeepCopy() *ResourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSelector)
+	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Header) DeepCopyInto(out *Header) {
+func (in *Response) DeepCopyInto(out *Response) {
 	*out = *in
-	in.Value.DeepCopyInto(&out.Value)
+	in.Target.DeepCopyInto(&out.Target)
+	if in.Citations != nil {
+		in, out := &in.Citations, &out.Citations
+		*out = make([]Citation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Alternatives != nil {
+		in, out := &in.Alternatives, &out.Alternatives
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TimeToFirstToken != nil {
+		in, out := &in.TimeToFirstToken, &out.TimeToFirstToken
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ModelDuration != nil {
+		in, out := &in.ModelDuration, &out.ModelDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ToolDuration != nil {
+		in, out := &in.ToolDuration, &out.ToolDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Header.
-func (in *Header) DeepCopy() *Header {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Response.
+func (in *Response) DeepCopy() *Response {
 	if in == nil {
 		return nil
 	}
-	out := new(Header)
+	out := new(Response)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HeaderValue) DeepCopyInto(out *HeaderValue) {
+func (in *ResponseSignature) DeepCopyInto(out *ResponseSignature) {
 	*out = *in
-	if in.ValueFrom != nil {
-		in, out := &in.ValueFrom, &out.ValueFrom
-		*out = new(HeaderValueSource)
-		(*in).DeepCopyInto(*out)
-	}
+	in.SignedAt.DeepCopyInto(&out.SignedAt)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderValue.
-func (in *HeaderValue) DeepCopy() *HeaderValue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseSignature.
+func (in *ResponseSignature) DeepCopy() *ResponseSignature {
 	if in == nil {
 		return nil
 	}
-	out := new(HeaderValue)
+	out := new(ResponseSignature)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HeaderValueSource) DeepCopyInto(out *HeaderValueSource) {
+func (in *ResponseSigningConfig) DeepCopyInto(out *ResponseSigningConfig) {
 	*out = *in
-	if in.SecretKeyRef != nil {
-		in, out := &in.SecretKeyRef, &out.SecretKeyRef
-		*out = new(corev1.SecretKeySelector)
-		(*in).DeepCopyInto(*out)
-	}
+	in.PrivateKey.DeepCopyInto(&out.PrivateKey)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderValueSource.
-func (in *HeaderValueSource) DeepCopy() *HeaderValueSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseSigningConfig.
+func (in *ResponseSigningConfig) DeepCopy() *ResponseSigningConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(HeaderValueSource)
+	out := new(ResponseSigningConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServer) DeepCopyInto(out *MCPServer) {
+func (in *Router) DeepCopyInto(out *Router) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
-func (in *MCPServer) DeepCopy() *MCPServer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Router.
+func (in *Router) DeepCopy() *Router {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServer)
+	out := new(Router)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPServer) DeepCopyObject() runtime.Object {
+func (in *Router) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -907,109 +3052,103 @@ func (in *MCPServer) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerList) DeepCopyInto(out *MCPServerList) {
+func (in *RouterList) DeepCopyInto(out *RouterList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MCPServer, len(*in))
+		*out = make([]Router, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerList.
-func (in *MCPServerList) DeepCopy() *MCPServerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterList.
+func (in *RouterList) DeepCopy() *RouterList {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerList)
+	out := new(RouterList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MCPServerList) DeepCopyObject() runtime.Object {
+func (in *RouterList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
 	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerRef.
-func (in *MCPServerRef) DeepCopy() *MCPServerRef {
-	if in == nil {
-		return nil
-	}
-	out := new(MCPServerRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerSpec) DeepCopyInto(out *MCPServerSpec) {
+func (in *RouterRule) DeepCopyInto(out *RouterRule) {
 	*out = *in
-	in.Address.DeepCopyInto(&out.Address)
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = make([]Header, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.PollInterval != nil {
-		in, out := &in.PollInterval, &out.PollInterval
-		*out = new(v1.Duration)
-		**out = **in
+	if in.Languages != nil {
+		in, out := &in.Languages, &out.Languages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
+	in.Target.DeepCopyInto(&out.Target)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerSpec.
-func (in *MCPServerSpec) DeepCopy() *MCPServerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterRule.
+func (in *RouterRule) DeepCopy() *RouterRule {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerSpec)
+	out := new(RouterRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerStatus) DeepCopyInto(out *MCPServerStatus) {
+func (in *RouterSpec) DeepCopyInto(out *RouterSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RouterRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DefaultTarget != nil {
+		in, out := &in.DefaultTarget, &out.DefaultTarget
+		*out = new(QueryTarget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerStatus.
-func (in *MCPServerStatus) DeepCopy() *MCPServerStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterSpec.
+func (in *RouterSpec) DeepCopy() *RouterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerStatus)
+	out := new(RouterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPToolRef.
-func (in *MCPToolRef) DeepCopy() *MCPToolRef {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingDecision) DeepCopyInto(out *RoutingDecision) {
+	*out = *in
+	in.Target.DeepCopyInto(&out.Target)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingDecision.
+func (in *RoutingDecision) DeepCopy() *RoutingDecision {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPToolRef)
+	out := new(RoutingDecision)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Memory) DeepCopyInto(out *Memory) {
+func (in *ScenarioTest) DeepCopyInto(out *ScenarioTest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1017,18 +3156,18 @@ func (in *Memory) DeepCopyInto(out *Memory) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Memory.
-func (in *Memory) DeepCopy() *Memory {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScenarioTest.
+func (in *ScenarioTest) DeepCopy() *ScenarioTest {
 	if in == nil {
 		return nil
 	}
-	out := new(Memory)
+	out := new(ScenarioTest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Memory) DeepCopyObject() runtime.Object {
+func (in *ScenarioTest) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1036,31 +3175,31 @@ func (in *Memory) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemoryList) DeepCopyInto(out *MemoryList) {
+func (in *ScenarioTestList) DeepCopyInto(out *ScenarioTestList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Memory, len(*in))
+		*out = make([]ScenarioTest, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryList.
-func (in *MemoryList) DeepCopy() *MemoryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScenarioTestList.
+func (in *ScenarioTestList) DeepCopy() *ScenarioTestList {
 	if in == nil {
 		return nil
 	}
-	out := new(MemoryList)
+	out := new(ScenarioTestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MemoryList) DeepCopyObject() runtime.Object {
+func (in *ScenarioTestList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1068,254 +3207,214 @@ func (in *MemoryList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemoryRef) DeepCopyInto(out *MemoryRef) {
+func (in *ScenarioTestSpec) DeepCopyInto(out *ScenarioTestSpec) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryRef.
-func (in *MemoryRef) DeepCopy() *MemoryRef {
-	if in == nil {
-		return nil
+	if in.Turns != nil {
+		in, out := &in.Turns, &out.Turns
+		*out = make([]ScenarioTurn, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(MemoryRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemorySpec) DeepCopyInto(out *MemorySpec) {
-	*out = *in
-	in.Address.DeepCopyInto(&out.Address)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemorySpec.
-func (in *MemorySpec) DeepCopy() *MemorySpec {
-	if in == nil {
-		return nil
+	if in.SimulatedUser != nil {
+		in, out := &in.SimulatedUser, &out.SimulatedUser
+		*out = new(SimulatedUserSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(MemorySpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MemoryStatus) DeepCopyInto(out *MemoryStatus) {
-	*out = *in
-	if in.LastResolvedAddress != nil {
-		in, out := &in.LastResolvedAddress, &out.LastResolvedAddress
-		*out = new(string)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStatus.
-func (in *MemoryStatus) DeepCopy() *MemoryStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScenarioTestSpec.
+func (in *ScenarioTestSpec) DeepCopy() *ScenarioTestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MemoryStatus)
+	out := new(ScenarioTestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Model) DeepCopyInto(out *Model) {
+func (in *ScenarioTestStatus) DeepCopyInto(out *ScenarioTestStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Turns != nil {
+		in, out := &in.Turns, &out.Turns
+		*out = make([]ScenarioTurnResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Evaluations != nil {
+		in, out := &in.Evaluations, &out.Evaluations
+		*out = make([]EvaluationResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Model.
-func (in *Model) DeepCopy() *Model {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScenarioTestStatus.
+func (in *ScenarioTestStatus) DeepCopy() *ScenarioTestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Model)
+	out := new(ScenarioTestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Model) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelConfig) DeepCopyInto(out *ModelConfig) {
+func (in *ScenarioTurn) DeepCopyInto(out *ScenarioTurn) {
 	*out = *in
-	if in.OpenAI != nil {
-		in, out := &in.OpenAI, &out.OpenAI
-		*out = new(OpenAIModelConfig)
-		(*in).DeepCopyInto(*out)
+	if in.ExpectedToolCalls != nil {
+		in, out := &in.ExpectedToolCalls, &out.ExpectedToolCalls
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Azure != nil {
-		in, out := &in.Azure, &out.Azure
-		*out = new(AzureModelConfig)
-		(*in).DeepCopyInto(*out)
+	if in.Contains != nil {
+		in, out := &in.Contains, &out.Contains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Bedrock != nil {
-		in, out := &in.Bedrock, &out.Bedrock
-		*out = new(BedrockModelConfig)
-		(*in).DeepCopyInto(*out)
+	if in.NotContains != nil {
+		in, out := &in.NotContains, &out.NotContains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelConfig.
-func (in *ModelConfig) DeepCopy() *ModelConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScenarioTurn.
+func (in *ScenarioTurn) DeepCopy() *ScenarioTurn {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelConfig)
+	out := new(ScenarioTurn)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelList) DeepCopyInto(out *ModelList) {
+func (in *ScenarioTurnResult) DeepCopyInto(out *ScenarioTurnResult) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Model, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelList.
-func (in *ModelList) DeepCopy() *ModelList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScenarioTurnResult.
+func (in *ScenarioTurnResult) DeepCopy() *ScenarioTurnResult {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelList)
+	out := new(ScenarioTurnResult)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ModelList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
+func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
 	*out = *in
-	in.Model.DeepCopyInto(&out.Model)
-	in.Config.DeepCopyInto(&out.Config)
-	if in.PollInterval != nil {
-		in, out := &in.PollInterval, &out.PollInterval
-		*out = new(v1.Duration)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
-func (in *ModelSpec) DeepCopy() *ModelSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
+func (in *ServiceReference) DeepCopy() *ServiceReference {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelSpec)
+	out := new(ServiceReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
+func (in *SimulatedUserSpec) DeepCopyInto(out *SimulatedUserSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out.ModelRef = in.ModelRef
+	if in.MaxTurns != nil {
+		in, out := &in.MaxTurns, &out.MaxTurns
+		*out = new(int)
+		**out = **in
+	}
+	if in.Evaluators != nil {
+		in, out := &in.Evaluators, &out.Evaluators
+		*out = make([]EvaluatorRef, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStatus.
-func (in *ModelStatus) DeepCopy() *ModelStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SimulatedUserSpec.
+func (in *SimulatedUserSpec) DeepCopy() *SimulatedUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelStatus)
+	out := new(SimulatedUserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenAIModelConfig) DeepCopyInto(out *OpenAIModelConfig) {
+func (in *SlackDeliveryConfig) DeepCopyInto(out *SlackDeliveryConfig) {
 	*out = *in
-	in.BaseURL.DeepCopyInto(&out.BaseURL)
-	in.APIKey.DeepCopyInto(&out.APIKey)
-	if in.Properties != nil {
-		in, out := &in.Properties, &out.Properties
-		*out = make(map[string]ValueSource, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
-		}
-	}
+	in.WebhookURL.DeepCopyInto(&out.WebhookURL)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenAIModelConfig.
-func (in *OpenAIModelConfig) DeepCopy() *OpenAIModelConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackDeliveryConfig.
+func (in *SlackDeliveryConfig) DeepCopy() *SlackDeliveryConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenAIModelConfig)
+	out := new(SlackDeliveryConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Parameter) DeepCopyInto(out *Parameter) {
+func (in *TargetUsage) DeepCopyInto(out *TargetUsage) {
 	*out = *in
-	if in.ValueFrom != nil {
-		in, out := &in.ValueFrom, &out.ValueFrom
-		*out = new(ValueFromSource)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Parameter.
-func (in *Parameter) DeepCopy() *Parameter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetUsage.
+func (in *TargetUsage) DeepCopy() *TargetUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(Parameter)
+	out := new(TargetUsage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Query) DeepCopyInto(out *Query) {
+func (in *Team) DeepCopyInto(out *Team) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Query.
-func (in *Query) DeepCopy() *Query {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Team.
+func (in *Team) DeepCopy() *Team {
 	if in == nil {
 		return nil
 	}
-	out := new(Query)
+	out := new(Team)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Query) DeepCopyObject() runtime.Object {
+func (in *Team) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1323,51 +3422,66 @@ func (in *Query) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueryBasedEvaluationConfig) DeepCopyInto(out *QueryBasedEvaluationConfig) {
+func (in *TeamGraphEdge) DeepCopyInto(out *TeamGraphEdge) {
 	*out = *in
-	if in.QueryRef != nil {
-		in, out := &in.QueryRef, &out.QueryRef
-		*out = new(QueryRef)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamGraphEdge.
+func (in *TeamGraphEdge) DeepCopy() *TeamGraphEdge {
+	if in == nil {
+		return nil
 	}
+	out := new(TeamGraphEdge)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryBasedEvaluationConfig.
-func (in *QueryBasedEvaluationConfig) DeepCopy() *QueryBasedEvaluationConfig {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamGraphSpec) DeepCopyInto(out *TeamGraphSpec) {
+	*out = *in
+	if in.Edges != nil {
+		in, out := &in.Edges, &out.Edges
+		*out = make([]TeamGraphEdge, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamGraphSpec.
+func (in *TeamGraphSpec) DeepCopy() *TeamGraphSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QueryBasedEvaluationConfig)
+	out := new(TeamGraphSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueryList) DeepCopyInto(out *QueryList) {
+func (in *TeamList) DeepCopyInto(out *TeamList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Query, len(*in))
+		*out = make([]Team, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryList.
-func (in *QueryList) DeepCopy() *QueryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamList.
+func (in *TeamList) DeepCopy() *TeamList {
 	if in == nil {
 		return nil
 	}
-	out := new(QueryList)
+	out := new(TeamList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QueryList) DeepCopyObject() runtime.Object {
+func (in *TeamList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1375,247 +3489,234 @@ func (in *QueryList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueryParameterReference) DeepCopyInto(out *QueryParameterReference) {
+func (in *TeamMember) DeepCopyInto(out *TeamMember) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryParameterReference.
-func (in *QueryParameterReference) DeepCopy() *QueryParameterReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMember.
+func (in *TeamMember) DeepCopy() *TeamMember {
 	if in == nil {
 		return nil
 	}
-	out := new(QueryParameterReference)
+	out := new(TeamMember)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueryRef) DeepCopyInto(out *QueryRef) {
+func (in *TeamParallelSpec) DeepCopyInto(out *TeamParallelSpec) {
 	*out = *in
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryRef.
-func (in *QueryRef) DeepCopy() *QueryRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamParallelSpec.
+func (in *TeamParallelSpec) DeepCopy() *TeamParallelSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QueryRef)
+	out := new(TeamParallelSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuerySelector) DeepCopyInto(out *QuerySelector) {
+func (in *TeamSelectorSpec) DeepCopyInto(out *TeamSelectorSpec) {
 	*out = *in
-	if in.MatchLabels != nil {
-		in, out := &in.MatchLabels, &out.MatchLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.MatchExpressions != nil {
-		in, out := &in.MatchExpressions, &out.MatchExpressions
-		*out = make([]v1.LabelSelectorRequirement, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuerySelector.
-func (in *QuerySelector) DeepCopy() *QuerySelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSelectorSpec.
+func (in *TeamSelectorSpec) DeepCopy() *TeamSelectorSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QuerySelector)
+	out := new(TeamSelectorSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuerySpec) DeepCopyInto(out *QuerySpec) {
+func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make([]Parameter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Targets != nil {
-		in, out := &in.Targets, &out.Targets
-		*out = make([]QueryTarget, len(*in))
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]TeamMember, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaxTurns != nil {
+		in, out := &in.MaxTurns, &out.MaxTurns
+		*out = new(int)
+		**out = **in
+	}
 	if in.Selector != nil {
 		in, out := &in.Selector, &out.Selector
-		*out = new(v1.LabelSelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Memory != nil {
-		in, out := &in.Memory, &out.Memory
-		*out = new(MemoryRef)
+		*out = new(TeamSelectorSpec)
 		**out = **in
 	}
-	if in.Evaluators != nil {
-		in, out := &in.Evaluators, &out.Evaluators
-		*out = make([]EvaluatorRef, len(*in))
-		copy(*out, *in)
-	}
-	if in.EvaluatorSelector != nil {
-		in, out := &in.EvaluatorSelector, &out.EvaluatorSelector
-		*out = new(v1.LabelSelector)
+	if in.Graph != nil {
+		in, out := &in.Graph, &out.Graph
+		*out = new(TeamGraphSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.TTL != nil {
-		in, out := &in.TTL, &out.TTL
-		*out = new(v1.Duration)
-		**out = **in
-	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(v1.Duration)
-		**out = **in
+	if in.Parallel != nil {
+		in, out := &in.Parallel, &out.Parallel
+		*out = new(TeamParallelSpec)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuerySpec.
-func (in *QuerySpec) DeepCopy() *QuerySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
+func (in *TeamSpec) DeepCopy() *TeamSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QuerySpec)
+	out := new(TeamSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueryStatus) DeepCopyInto(out *QueryStatus) {
+func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
 	*out = *in
-	if in.Responses != nil {
-		in, out := &in.Responses, &out.Responses
-		*out = make([]Response, len(*in))
-		copy(*out, *in)
-	}
-	if in.Evaluations != nil {
-		in, out := &in.Evaluations, &out.Evaluations
-		*out = make([]EvaluationResult, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	out.TokenUsage = in.TokenUsage
-	if in.Duration != nil {
-		in, out := &in.Duration, &out.Duration
-		*out = new(v1.Duration)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamStatus.
+func (in *TeamStatus) DeepCopy() *TeamStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(TeamStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryStatus.
-func (in *QueryStatus) DeepCopy() *QueryStatus {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsDeliveryConfig) DeepCopyInto(out *TeamsDeliveryConfig) {
+	*out = *in
+	in.WebhookURL.DeepCopyInto(&out.WebhookURL)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsDeliveryConfig.
+func (in *TeamsDeliveryConfig) DeepCopy() *TeamsDeliveryConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(QueryStatus)
+	out := new(TeamsDeliveryConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QueryTarget) DeepCopyInto(out *QueryTarget) {
+func (in *TokenUsage) DeepCopyInto(out *TokenUsage) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryTarget.
-func (in *QueryTarget) DeepCopy() *QueryTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenUsage.
+func (in *TokenUsage) DeepCopy() *TokenUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(QueryTarget)
+	out := new(TokenUsage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceSelector) DeepCopyInto(out *ResourceSelector) {
+func (in *Tool) DeepCopyInto(out *Tool) {
 	*out = *in
-	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
-	if in.Namespaces != nil {
-		in, out := &in.Namespaces, &out.Namespaces
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tool.
+func (in *Tool) DeepCopy() *Tool {
+	if in == nil {
+		return nil
 	}
-	if in.NamespaceSelector != nil {
-		in, out := &in.NamespaceSelector, &out.NamespaceSelector
-		*out = new(v1.LabelSelector)
-		(*in).DeepCopyInto(*out)
+	out := new(Tool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Tool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSelector.
-func (in *ResourceSelector) DeepCopy() *ResourceSelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolAnnotations.
+func (in *ToolAnnotations) DeepCopy() *ToolAnnotations {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceSelector)
+	out := new(ToolAnnotations)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Response) DeepCopyInto(out *Response) {
+func (in *ToolCompensation) DeepCopyInto(out *ToolCompensation) {
 	*out = *in
-	out.Target = in.Target
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Response.
-func (in *Response) DeepCopy() *Response {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolCompensation.
+func (in *ToolCompensation) DeepCopy() *ToolCompensation {
 	if in == nil {
 		return nil
 	}
-	out := new(Response)
+	out := new(ToolCompensation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceReference) DeepCopyInto(out *ServiceReference) {
+func (in *ToolFunction) DeepCopyInto(out *ToolFunction) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceReference.
-func (in *ServiceReference) DeepCopy() *ServiceReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolFunction.
+func (in *ToolFunction) DeepCopy() *ToolFunction {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceReference)
+	out := new(ToolFunction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Team) DeepCopyInto(out *Team) {
+func (in *ToolList) DeepCopyInto(out *ToolList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Tool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Team.
-func (in *Team) DeepCopy() *Team {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolList.
+func (in *ToolList) DeepCopy() *ToolList {
 	if in == nil {
 		return nil
 	}
-	out := new(Team)
+	out := new(ToolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Team) DeepCopyObject() runtime.Object {
+func (in *ToolList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1623,66 +3724,88 @@ func (in *Team) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamGraphEdge) DeepCopyInto(out *TeamGraphEdge) {
+func (in *ToolOverride) DeepCopyInto(out *ToolOverride) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamGraphEdge.
-func (in *TeamGraphEdge) DeepCopy() *TeamGraphEdge {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolOverride.
+func (in *ToolOverride) DeepCopy() *ToolOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamGraphEdge)
+	out := new(ToolOverride)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamGraphSpec) DeepCopyInto(out *TeamGraphSpec) {
+func (in *ToolSanitization) DeepCopyInto(out *ToolSanitization) {
 	*out = *in
-	if in.Edges != nil {
-		in, out := &in.Edges, &out.Edges
-		*out = make([]TeamGraphEdge, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolSanitization.
+func (in *ToolSanitization) DeepCopy() *ToolSanitization {
+	if in == nil {
+		return nil
 	}
+	out := new(ToolSanitization)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamGraphSpec.
-func (in *TeamGraphSpec) DeepCopy() *TeamGraphSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolSet) DeepCopyInto(out *ToolSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolSet.
+func (in *ToolSet) DeepCopy() *ToolSet {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamGraphSpec)
+	out := new(ToolSet)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ToolSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamList) DeepCopyInto(out *TeamList) {
+func (in *ToolSetList) DeepCopyInto(out *ToolSetList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Team, len(*in))
+		*out = make([]ToolSet, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamList.
-func (in *TeamList) DeepCopy() *TeamList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolSetList.
+func (in *ToolSetList) DeepCopy() *ToolSetList {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamList)
+	out := new(ToolSetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TeamList) DeepCopyObject() runtime.Object {
+func (in *ToolSetList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1690,205 +3813,292 @@ func (in *TeamList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamMember) DeepCopyInto(out *TeamMember) {
+func (in *ToolSetSpec) DeepCopyInto(out *ToolSetSpec) {
 	*out = *in
+	if in.SpecRef != nil {
+		in, out := &in.SpecRef, &out.SpecRef
+		*out = new(ValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]Header, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SyncInterval != nil {
+		in, out := &in.SyncInterval, &out.SyncInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMember.
-func (in *TeamMember) DeepCopy() *TeamMember {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolSetSpec.
+func (in *ToolSetSpec) DeepCopy() *ToolSetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamMember)
+	out := new(ToolSetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamSelectorSpec) DeepCopyInto(out *TeamSelectorSpec) {
+func (in *ToolSetStatus) DeepCopyInto(out *ToolSetStatus) {
 	*out = *in
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSelectorSpec.
-func (in *TeamSelectorSpec) DeepCopy() *TeamSelectorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolSetStatus.
+func (in *ToolSetStatus) DeepCopy() *ToolSetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamSelectorSpec)
+	out := new(ToolSetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
-	*out = *in
-	if in.Members != nil {
-		in, out := &in.Members, &out.Members
-		*out = make([]TeamMember, len(*in))
-		copy(*out, *in)
-	}
-	if in.MaxTurns != nil {
-		in, out := &in.MaxTurns, &out.MaxTurns
-		*out = new(int)
-		**out = **in
-	}
-	if in.Selector != nil {
-		in, out := &in.Selector, &out.Selector
-		*out = new(TeamSelectorSpec)
-		**out = **in
-	}
-	if in.Graph != nil {
-		in, out := &in.Graph, &out.Graph
-		*out = new(TeamGraphSpec)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
-func (in *TeamSpec) DeepCopy() *TeamSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolSpec.
+func (in *ToolSpec) DeepCopy() *ToolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamSpec)
+	out := new(ToolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
+func (in *ToolStatus) DeepCopyInto(out *ToolStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamStatus.
-func (in *TeamStatus) DeepCopy() *TeamStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolStatus.
+func (in *ToolStatus) DeepCopy() *ToolStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamStatus)
+	out := new(ToolStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TokenUsage) DeepCopyInto(out *TokenUsage) {
+func (in *Trigger) DeepCopyInto(out *Trigger) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenUsage.
-func (in *TokenUsage) DeepCopy() *TokenUsage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Trigger.
+func (in *Trigger) DeepCopy() *Trigger {
 	if in == nil {
 		return nil
 	}
-	out := new(TokenUsage)
+	out := new(Trigger)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Trigger) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Tool) DeepCopyInto(out *Tool) {
+func (in *TriggerList) DeepCopyInto(out *TriggerList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Trigger, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tool.
-func (in *Tool) DeepCopy() *Tool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerList.
+func (in *TriggerList) DeepCopy() *TriggerList {
 	if in == nil {
 		return nil
 	}
-	out := new(Tool)
+	out := new(TriggerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Tool) DeepCopyObject() runtime.Object {
+func (in *TriggerList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
 	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolAnnotations.
-func (in *ToolAnnotations) DeepCopy() *ToolAnnotations {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerSpec) DeepCopyInto(out *TriggerSpec) {
+	*out = *in
+	out.Events = in.Events
+	in.Query.DeepCopyInto(&out.Query)
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerSpec.
+func (in *TriggerSpec) DeepCopy() *TriggerSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ToolAnnotations)
+	out := new(TriggerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ToolFunction) DeepCopyInto(out *ToolFunction) {
+func (in *TriggerStatus) DeepCopyInto(out *TriggerStatus) {
 	*out = *in
+	if in.LastFiredTime != nil {
+		in, out := &in.LastFiredTime, &out.LastFiredTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFiredByObject != nil {
+		in, out := &in.LastFiredByObject, &out.LastFiredByObject
+		*out = make(map[string]v1.Time, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolFunction.
-func (in *ToolFunction) DeepCopy() *ToolFunction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerStatus.
+func (in *TriggerStatus) DeepCopy() *TriggerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ToolFunction)
+	out := new(TriggerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ToolList) DeepCopyInto(out *ToolList) {
+func (in *UsageReport) DeepCopyInto(out *UsageReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageReport.
+func (in *UsageReport) DeepCopy() *UsageReport {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UsageReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageReportList) DeepCopyInto(out *UsageReportList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Tool, len(*in))
+		*out = make([]UsageReport, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolList.
-func (in *ToolList) DeepCopy() *ToolList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageReportList.
+func (in *UsageReportList) DeepCopy() *UsageReportList {
 	if in == nil {
 		return nil
 	}
-	out := new(ToolList)
+	out := new(UsageReportList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ToolList) DeepCopyObject() runtime.Object {
+func (in *UsageReportList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
 	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolSpec.
-func (in *ToolSpec) DeepCopy() *ToolSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageReportSpec) DeepCopyInto(out *UsageReportSpec) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageReportSpec.
+func (in *UsageReportSpec) DeepCopy() *UsageReportSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ToolSpec)
+	out := new(UsageReportSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ToolStatus) DeepCopyInto(out *ToolStatus) {
+func (in *UsageReportStatus) DeepCopyInto(out *UsageReportStatus) {
 	*out = *in
+	in.WindowStart.DeepCopyInto(&out.WindowStart)
+	in.WindowEnd.DeepCopyInto(&out.WindowEnd)
+	out.TokenUsage = in.TokenUsage
+	if in.TopTargets != nil {
+		in, out := &in.TopTargets, &out.TopTargets
+		*out = make([]TargetUsage, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolStatus.
-func (in *ToolStatus) DeepCopy() *ToolStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageReportStatus.
+func (in *UsageReportStatus) DeepCopy() *UsageReportStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ToolStatus)
+	out := new(UsageReportStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1947,3 +4157,26 @@ func (in *ValueSource) DeepCopy() *ValueSource {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDeliveryConfig) DeepCopyInto(out *WebhookDeliveryConfig) {
+	*out = *in
+	in.URL.DeepCopyInto(&out.URL)
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]Header, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDeliveryConfig.
+func (in *WebhookDeliveryConfig) DeepCopy() *WebhookDeliveryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDeliveryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
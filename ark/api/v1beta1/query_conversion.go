@@ -0,0 +1,114 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+// ConvertTo converts this Query to the v1alpha1 hub version. The race
+// aggregation strategy maps onto hedging, and conversationId is stored as
+// sessionId
+func (src *Query) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*arkv1alpha1.Query)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.Input = src.Spec.Input
+	dst.Spec.SessionId = src.Spec.ConversationID
+	dst.Spec.ServiceAccount = src.Spec.ServiceAccount
+	dst.Spec.Timeout = src.Spec.Timeout
+	dst.Spec.TTL = src.Spec.TTL
+	dst.Spec.Cancel = src.Spec.Cancel
+
+	if src.Spec.Memory != nil {
+		dst.Spec.Memory = &arkv1alpha1.MemoryRef{Name: src.Spec.Memory.Name, Namespace: src.Spec.Memory.Namespace}
+	}
+
+	if src.Spec.Retry != nil {
+		dst.Spec.Retry = &arkv1alpha1.QueryRetryPolicy{MaxAttempts: src.Spec.Retry.MaxAttempts, Backoff: src.Spec.Retry.Backoff}
+	}
+
+	targets := make([]arkv1alpha1.QueryTarget, 0, len(src.Spec.Targets))
+	for _, target := range src.Spec.Targets {
+		targets = append(targets, arkv1alpha1.QueryTarget{Type: target.Type, Name: target.Name})
+	}
+	if src.Spec.Aggregation != nil && src.Spec.Aggregation.Strategy == AggregationStrategyRace {
+		dst.Spec.Hedging = &arkv1alpha1.QueryHedging{Targets: targets, Delay: src.Spec.Aggregation.RaceDelay}
+	} else if len(targets) > 0 {
+		dst.Spec.Targets = targets
+	}
+
+	dst.Status.Phase = src.Status.Phase
+	dst.Status.Duration = src.Status.Duration
+	dst.Status.Conditions = src.Status.Conditions
+	dst.Status.TokenUsage = arkv1alpha1.TokenUsage{
+		PromptTokens:     src.Status.TokenUsage.PromptTokens,
+		CompletionTokens: src.Status.TokenUsage.CompletionTokens,
+		TotalTokens:      src.Status.TokenUsage.TotalTokens,
+	}
+	for _, response := range src.Status.Responses {
+		dst.Status.Responses = append(dst.Status.Responses, arkv1alpha1.Response{
+			Target:   arkv1alpha1.QueryTarget{Type: response.Target.Type, Name: response.Target.Name},
+			Content:  response.Content,
+			Raw:      response.Raw,
+			ServedBy: response.ServedBy,
+			Duration: response.Duration,
+		})
+	}
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to this Query. Fields without
+// a v1beta1 representation (parameters, evaluators, execution windows and
+// similar) are dropped from the read view; the stored object is unaffected
+func (dst *Query) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*arkv1alpha1.Query)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec.Input = src.Spec.Input
+	dst.Spec.ConversationID = src.Spec.SessionId
+	dst.Spec.ServiceAccount = src.Spec.ServiceAccount
+	dst.Spec.Timeout = src.Spec.Timeout
+	dst.Spec.TTL = src.Spec.TTL
+	dst.Spec.Cancel = src.Spec.Cancel
+
+	if src.Spec.Memory != nil {
+		dst.Spec.Memory = &MemoryRef{Name: src.Spec.Memory.Name, Namespace: src.Spec.Memory.Namespace}
+	}
+
+	if src.Spec.Retry != nil {
+		dst.Spec.Retry = &QueryRetryPolicy{MaxAttempts: src.Spec.Retry.MaxAttempts, Backoff: src.Spec.Retry.Backoff}
+	}
+
+	if src.Spec.Hedging != nil {
+		dst.Spec.Aggregation = &QueryAggregation{Strategy: AggregationStrategyRace, RaceDelay: src.Spec.Hedging.Delay}
+		for _, target := range src.Spec.Hedging.Targets {
+			dst.Spec.Targets = append(dst.Spec.Targets, QueryTarget{Type: target.Type, Name: target.Name})
+		}
+	} else {
+		for _, target := range src.Spec.Targets {
+			dst.Spec.Targets = append(dst.Spec.Targets, QueryTarget{Type: target.Type, Name: target.Name})
+		}
+	}
+
+	dst.Status.Phase = src.Status.Phase
+	dst.Status.Duration = src.Status.Duration
+	dst.Status.Conditions = src.Status.Conditions
+	dst.Status.TokenUsage = TokenUsage{
+		PromptTokens:     src.Status.TokenUsage.PromptTokens,
+		CompletionTokens: src.Status.TokenUsage.CompletionTokens,
+		TotalTokens:      src.Status.TokenUsage.TotalTokens,
+	}
+	for _, response := range src.Status.Responses {
+		dst.Status.Responses = append(dst.Status.Responses, Response{
+			Target:   QueryTarget{Type: response.Target.Type, Name: response.Target.Name},
+			Content:  response.Content,
+			Raw:      response.Raw,
+			ServedBy: response.ServedBy,
+			Duration: response.Duration,
+		})
+	}
+	return nil
+}
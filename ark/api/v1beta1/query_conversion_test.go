@@ -0,0 +1,111 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1beta1
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func TestQueryConversionRoundTrip(t *testing.T) {
+	original := &Query{
+		ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "default"},
+		Spec: QuerySpec{
+			Input:          "what is the weather",
+			Targets:        []QueryTarget{{Type: "agent", Name: "forecaster"}},
+			ConversationID: "session-1",
+			Memory:         &MemoryRef{Name: "default"},
+			ServiceAccount: "query-runner",
+			Timeout:        &metav1.Duration{Duration: time.Minute},
+			Retry:          &QueryRetryPolicy{MaxAttempts: 3, Backoff: &metav1.Duration{Duration: 5 * time.Second}},
+		},
+		Status: QueryStatus{
+			Phase:      "done",
+			Responses:  []Response{{Target: QueryTarget{Type: "agent", Name: "forecaster"}, Content: "sunny", ServedBy: "gpt-4"}},
+			TokenUsage: TokenUsage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+		},
+	}
+
+	hub := &arkv1alpha1.Query{}
+	if err := original.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo failed: %v", err)
+	}
+	if hub.Spec.SessionId != "session-1" {
+		t.Errorf("expected conversationId stored as sessionId, got %q", hub.Spec.SessionId)
+	}
+	if hub.Spec.Retry == nil || hub.Spec.Retry.MaxAttempts != 3 {
+		t.Errorf("expected retry policy converted, got %+v", hub.Spec.Retry)
+	}
+
+	restored := &Query{}
+	if err := restored.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom failed: %v", err)
+	}
+	if !reflect.DeepEqual(original, restored) {
+		t.Errorf("round trip mismatch:\noriginal: %+v\nrestored: %+v", original, restored)
+	}
+}
+
+func TestQueryConversionRaceAggregationMapsToHedging(t *testing.T) {
+	original := &Query{
+		ObjectMeta: metav1.ObjectMeta{Name: "raced"},
+		Spec: QuerySpec{
+			Input: "hello",
+			Targets: []QueryTarget{
+				{Type: "agent", Name: "fast"},
+				{Type: "agent", Name: "slow"},
+			},
+			Aggregation: &QueryAggregation{Strategy: AggregationStrategyRace, RaceDelay: &metav1.Duration{Duration: 2 * time.Second}},
+		},
+	}
+
+	hub := &arkv1alpha1.Query{}
+	if err := original.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo failed: %v", err)
+	}
+	if hub.Spec.Hedging == nil {
+		t.Fatal("expected race aggregation to map onto hedging")
+	}
+	if len(hub.Spec.Hedging.Targets) != 2 || hub.Spec.Hedging.Delay.Duration != 2*time.Second {
+		t.Errorf("unexpected hedging conversion: %+v", hub.Spec.Hedging)
+	}
+	if len(hub.Spec.Targets) != 0 {
+		t.Errorf("expected plain targets empty when racing, got %v", hub.Spec.Targets)
+	}
+
+	restored := &Query{}
+	if err := restored.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom failed: %v", err)
+	}
+	if !reflect.DeepEqual(original, restored) {
+		t.Errorf("round trip mismatch:\noriginal: %+v\nrestored: %+v", original, restored)
+	}
+}
+
+func TestQueryConversionFromHubDropsUnrepresentedFields(t *testing.T) {
+	hub := &arkv1alpha1.Query{
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy"},
+		Spec: arkv1alpha1.QuerySpec{
+			Input:      "hello",
+			Targets:    []arkv1alpha1.QueryTarget{{Type: "agent", Name: "helper"}},
+			SessionId:  "session-9",
+			Parameters: []arkv1alpha1.Parameter{{Name: "region", Value: "eu"}},
+		},
+	}
+
+	converted := &Query{}
+	if err := converted.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom failed: %v", err)
+	}
+	if converted.Spec.ConversationID != "session-9" {
+		t.Errorf("expected sessionId surfaced as conversationId, got %q", converted.Spec.ConversationID)
+	}
+	if len(converted.Spec.Targets) != 1 || converted.Spec.Targets[0].Name != "helper" {
+		t.Errorf("unexpected targets: %v", converted.Spec.Targets)
+	}
+}
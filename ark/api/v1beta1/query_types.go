@@ -0,0 +1,139 @@
+/* Copyright 2025. McKinsey & Company */
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	AggregationStrategyAll  = "all"
+	AggregationStrategyRace = "race"
+)
+
+type QueryTarget struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=agent;team;model;tool
+	Type string `json:"type"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+type MemoryRef struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// QueryRetryPolicy bounds re-execution of failed targets
+type QueryRetryPolicy struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// Total attempts per target, including the first
+	MaxAttempts int `json:"maxAttempts"`
+	// +kubebuilder:validation:Optional
+	// Wait between attempts; unset retries immediately
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+}
+
+// QueryAggregation controls how responses from several targets are combined:
+// all (the default) runs every target and keeps every response, race runs
+// the targets as hedges and keeps only the first acceptable response
+type QueryAggregation struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=all;race
+	Strategy string `json:"strategy"`
+	// +kubebuilder:validation:Optional
+	// Head start between launches when racing, so later targets only spend
+	// tokens when earlier ones are slow
+	RaceDelay *metav1.Duration `json:"raceDelay,omitempty"`
+}
+
+type QuerySpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	Input string `json:"input,omitempty"`
+	// +kubebuilder:validation:Optional
+	Targets []QueryTarget `json:"targets,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// Conversation this query belongs to, keying memory reads and writes;
+	// stored as sessionId in v1alpha1
+	ConversationID string `json:"conversationId,omitempty"`
+	// +kubebuilder:validation:Optional
+	Memory *MemoryRef `json:"memory,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// +kubebuilder:validation:Optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// +kubebuilder:validation:Optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Retries failed target executions before the query is marked errored
+	Retry *QueryRetryPolicy `json:"retry,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Combines responses across targets; race maps onto v1alpha1 hedging
+	Aggregation *QueryAggregation `json:"aggregation,omitempty"`
+	// +kubebuilder:validation:Optional
+	// When true, indicates intent to cancel the query
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+type TokenUsage struct {
+	PromptTokens     int64 `json:"promptTokens,omitempty"`
+	CompletionTokens int64 `json:"completionTokens,omitempty"`
+	TotalTokens      int64 `json:"totalTokens,omitempty"`
+}
+
+type Response struct {
+	Target  QueryTarget `json:"target,omitempty"`
+	Content string      `json:"content,omitempty"`
+	Raw     string      `json:"raw,omitempty"`
+	// Model that actually answered, which differs from the configured model
+	// when content-filter fallback or context-overflow routing kicked in
+	ServedBy string `json:"servedBy,omitempty"`
+	// Duration of this target's execution
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+type QueryStatus struct {
+	// +kubebuilder:default="pending"
+	// +kubebuilder:validation:Enum=pending;running;evaluating;waiting-on-tool;error;done;canceled
+	Phase      string     `json:"phase,omitempty"`
+	Responses  []Response `json:"responses,omitempty"`
+	TokenUsage TokenUsage `json:"tokenUsage,omitempty"`
+	// +kubebuilder:validation:Optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Duration",type=string,JSONPath=`.status.duration`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+type Query struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QuerySpec   `json:"spec,omitempty"`
+	Status QueryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type QueryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Query `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Query{}, &QueryList{})
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -26,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	arkv1beta1 "mckinsey.com/ark/api/v1beta1"
 	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
 	"mckinsey.com/ark/internal/controller"
 	"mckinsey.com/ark/internal/telemetry"
@@ -48,6 +50,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(arkv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(arkv1beta1.AddToScheme(scheme))
 	utilruntime.Must(arkv1prealpha1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
@@ -58,6 +61,13 @@ type config struct {
 	webhookCertPath, webhookCertName, webhookCertKey string
 	enableLeaderElection                             bool
 	probeAddr                                        string
+	discoveryAddr                                    string
+	queryArchiveAddr                                 string
+	queryExecutionAddr                               string
+	managementAddr                                   string
+	managementOIDCIssuer                             string
+	managementOIDCAudience                           string
+	usageReportInterval                              time.Duration
 	secureMetrics                                    bool
 	enableHTTP2                                      bool
 }
@@ -77,8 +87,14 @@ func main() {
 	defer telemetryShutdown()
 
 	mgr, metricsCertWatcher, webhookCertWatcher := setupManager(result.config)
-	setupControllers(mgr)
+	queryArchive := controller.NewQueryArchive(0)
+	setupControllers(mgr, queryArchive)
 	setupWebhooks(mgr)
+	setupDiscovery(mgr, result.config)
+	setupQueryArchive(mgr, result.config, queryArchive)
+	setupQueryExecution(mgr, result.config)
+	setupManagement(mgr, result.config)
+	setupUsageReports(mgr, result.config)
 	startManager(mgr, metricsCertWatcher, webhookCertWatcher)
 }
 
@@ -92,6 +108,20 @@ func parseFlags() struct {
 	flag.StringVar(&cfg.metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&cfg.probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.StringVar(&cfg.discoveryAddr, "discovery-bind-address", "0", "The address the agent discovery endpoint binds to. "+
+		"Use :8082 to serve the agent catalog, or leave as 0 to disable it.")
+	flag.StringVar(&cfg.queryArchiveAddr, "query-archive-bind-address", "0", "The address the query archive endpoint binds to. "+
+		"Use :8083 to serve historical query listings, or leave as 0 to disable it.")
+	flag.StringVar(&cfg.queryExecutionAddr, "query-execution-bind-address", "0", "The address the synchronous query execution endpoint binds to. "+
+		"Use :8084 to accept blocking query requests, or leave as 0 to disable it.")
+	flag.StringVar(&cfg.managementAddr, "management-bind-address", "0", "The address the management API binds to. "+
+		"Use :8085 to expose query and resource operations to non-Kubernetes clients, or leave as 0 to disable it.")
+	flag.StringVar(&cfg.managementOIDCIssuer, "management-oidc-issuer", "", "OIDC issuer URL used to validate bearer tokens "+
+		"on the management API. Leave empty to serve unauthenticated.")
+	flag.StringVar(&cfg.managementOIDCAudience, "management-oidc-audience", "", "Expected audience claim for management API tokens. "+
+		"Leave empty to skip audience validation.")
+	flag.DurationVar(&cfg.usageReportInterval, "usage-report-interval", 0, "How often to aggregate per-namespace usage reports, "+
+		"e.g. 1h. Leave as 0 to disable usage reporting.")
 	flag.BoolVar(&cfg.enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
@@ -220,14 +250,15 @@ func setupMetricsServer(cfg config, baseTLSOpts []func(*tls.Config)) (metricsser
 	return metricsServerOptions, metricsCertWatcher
 }
 
-func setupControllers(mgr ctrl.Manager) {
+func setupControllers(mgr ctrl.Manager, queryArchive *controller.QueryArchive) {
 	controllers := []struct {
 		name       string
 		reconciler interface{ SetupWithManager(ctrl.Manager) error }
 	}{
 		{"Agent", &controller.AgentReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: mgr.GetEventRecorderFor("agent-controller")}},
-		{"Query", &controller.QueryReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: mgr.GetEventRecorderFor("query-controller")}},
+		{"Query", &controller.QueryReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: mgr.GetEventRecorderFor("query-controller"), Archive: queryArchive}},
 		{"Tool", &controller.ToolReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
+		{"ToolSet", &controller.ToolSetReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: mgr.GetEventRecorderFor("toolset-controller")}},
 		{"Team", &controller.TeamReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
 		{"A2AServer", &controller.A2AServerReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: mgr.GetEventRecorderFor("a2aserver-controller")}},
 		{"MCPServer", &controller.MCPServerReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: mgr.GetEventRecorderFor("mcpserver-controller")}},
@@ -236,6 +267,10 @@ func setupControllers(mgr ctrl.Manager) {
 		{"ExecutionEngine", &controller.ExecutionEngineReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: mgr.GetEventRecorderFor("executionengine-controller")}},
 		{"Evaluator", &controller.EvaluatorReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme()}},
 		{"Evaluation", &controller.EvaluationReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: mgr.GetEventRecorderFor("evaluation-controller")}},
+		{"Trigger", &controller.TriggerReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: mgr.GetEventRecorderFor("trigger-controller")}},
+		{"ConversationSnapshot", &controller.ConversationSnapshotReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: mgr.GetEventRecorderFor("conversationsnapshot-controller")}},
+		{"ScenarioTest", &controller.ScenarioTestReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: mgr.GetEventRecorderFor("scenariotest-controller")}},
+		{"AgentRollout", &controller.AgentRolloutReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Recorder: mgr.GetEventRecorderFor("agentrollout-controller")}},
 	}
 
 	for _, reconciler := range controllers {
@@ -246,6 +281,69 @@ func setupControllers(mgr ctrl.Manager) {
 	}
 }
 
+func setupDiscovery(mgr ctrl.Manager, cfg config) {
+	if cfg.discoveryAddr == "" || cfg.discoveryAddr == "0" {
+		return
+	}
+
+	discoveryServer := &controller.AgentDiscoveryServer{Client: mgr.GetClient(), Addr: cfg.discoveryAddr}
+	if err := mgr.Add(discoveryServer); err != nil {
+		setupLog.Error(err, "unable to add agent discovery server to manager")
+		os.Exit(1)
+	}
+}
+
+func setupQueryArchive(mgr ctrl.Manager, cfg config, queryArchive *controller.QueryArchive) {
+	if cfg.queryArchiveAddr == "" || cfg.queryArchiveAddr == "0" {
+		return
+	}
+
+	archiveServer := &controller.QueryArchiveServer{Archive: queryArchive, Client: mgr.GetClient(), Addr: cfg.queryArchiveAddr}
+	if err := mgr.Add(archiveServer); err != nil {
+		setupLog.Error(err, "unable to add query archive server to manager")
+		os.Exit(1)
+	}
+}
+
+func setupQueryExecution(mgr ctrl.Manager, cfg config) {
+	if cfg.queryExecutionAddr == "" || cfg.queryExecutionAddr == "0" {
+		return
+	}
+
+	executionServer := &controller.QueryExecutionServer{Client: mgr.GetClient(), Addr: cfg.queryExecutionAddr}
+	if err := mgr.Add(executionServer); err != nil {
+		setupLog.Error(err, "unable to add query execution server to manager")
+		os.Exit(1)
+	}
+}
+
+func setupManagement(mgr ctrl.Manager, cfg config) {
+	if cfg.managementAddr == "" || cfg.managementAddr == "0" {
+		return
+	}
+
+	managementServer := &controller.ManagementServer{Client: mgr.GetClient(), Addr: cfg.managementAddr}
+	if cfg.managementOIDCIssuer != "" {
+		managementServer.Auth = controller.NewOIDCAuthenticator(cfg.managementOIDCIssuer, cfg.managementOIDCAudience)
+	}
+	if err := mgr.Add(managementServer); err != nil {
+		setupLog.Error(err, "unable to add management server to manager")
+		os.Exit(1)
+	}
+}
+
+func setupUsageReports(mgr ctrl.Manager, cfg config) {
+	if cfg.usageReportInterval <= 0 {
+		return
+	}
+
+	reporter := &controller.UsageReporter{Client: mgr.GetClient(), Interval: cfg.usageReportInterval}
+	if err := mgr.Add(reporter); err != nil {
+		setupLog.Error(err, "unable to add usage reporter to manager")
+		os.Exit(1)
+	}
+}
+
 func setupWebhooks(mgr ctrl.Manager) {
 	if os.Getenv("ENABLE_WEBHOOKS") == "false" {
 		return
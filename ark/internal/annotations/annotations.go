@@ -7,6 +7,11 @@ const (
 	ARKPrefix = "ark.mckinsey.com/"
 )
 
+// Per-query parameter override annotations, e.g. override.temperature
+const (
+	OverridePrefix = ARKPrefix + "override."
+)
+
 // Dashboard annotations
 const (
 	DashboardIcon = ARKPrefix + "dashboard-icon"
@@ -24,6 +29,11 @@ const (
 	MCPServerName = ARKPrefix + "mcp-server-name"
 )
 
+// Discovery annotations
+const (
+	ExamplePrompts = ARKPrefix + "example-prompts"
+)
+
 // ARK service annotations
 const (
 	Service   = ARKPrefix + "service"
@@ -39,6 +49,16 @@ const (
 	QueryPhase      = ARKPrefix + "query-phase"
 )
 
+// Identity annotations
+const (
+	User = ARKPrefix + "user"
+)
+
+// Rollout annotations
+const (
+	CanaryAgent = ARKPrefix + "canary-agent"
+)
+
 // General annotations
 const (
 	Finalizer            = ARKPrefix + "finalizer"
@@ -51,3 +71,10 @@ const (
 	StreamingEnabled = ARKPrefix + "streaming-enabled"
 	StreamingURL     = ARKPrefix + "streaming-url"
 )
+
+// Model debug logging annotations
+const (
+	ModelDebugLog           = ARKPrefix + "debug-log"
+	ModelDebugLogSampleRate = ARKPrefix + "debug-log-sample-rate"
+	ModelDebugLogPath       = ARKPrefix + "debug-log-path"
+)
@@ -31,12 +31,14 @@ func NewLoggingTransport(ctx context.Context, transport http.RoundTripper) *Logg
 
 // RoundTrip implements the http.RoundTripper interface with optional logging
 // Logging is enabled when ENABLE_HTTP_LOGGING environment variable is set to "true"
+// The logger comes from the request's context so transports shared across
+// callers still log with per-request values
 func (lt *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	if os.Getenv("ENABLE_HTTP_LOGGING") != "true" {
 		return lt.Transport.RoundTrip(req)
 	}
 
-	logger := logf.FromContext(lt.Context)
+	logger := logf.FromContext(req.Context())
 
 	var requestBody []byte
 	if req.Body != nil {
@@ -0,0 +1,163 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/annotations"
+	"mckinsey.com/ark/internal/genai"
+)
+
+const (
+	discoveryReadHeaderTimeout = 10 * time.Second
+	discoveryShutdownTimeout   = 5 * time.Second
+)
+
+// AgentDiscoveryServer serves a catalog of the agents available in the
+// cluster so portals and other agent platforms can discover them. Each entry
+// includes the agent's description, expected input parameters, example
+// prompts and an A2A-compatible agent card
+type AgentDiscoveryServer struct {
+	Client client.Client
+	Addr   string
+}
+
+type AgentCatalogEntry struct {
+	Name           string             `json:"name"`
+	Namespace      string             `json:"namespace"`
+	Description    string             `json:"description,omitempty"`
+	Parameters     []string           `json:"parameters,omitempty"`
+	OutputSchema   json.RawMessage    `json:"outputSchema,omitempty"`
+	ExamplePrompts []string           `json:"examplePrompts,omitempty"`
+	AgentCard      genai.A2AAgentCard `json:"agentCard"`
+}
+
+func (s *AgentDiscoveryServer) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *AgentDiscoveryServer) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/agents", s.handleAgents)
+
+	server := &http.Server{
+		Addr:              s.Addr,
+		Handler:           mux,
+		ReadHeaderTimeout: discoveryReadHeaderTimeout,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), discoveryShutdownTimeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	logf.Log.Info("starting agent discovery server", "addr", s.Addr)
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *AgentDiscoveryServer) handleAgents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var listOpts []client.ListOption
+	if namespace := r.URL.Query().Get("namespace"); namespace != "" {
+		listOpts = append(listOpts, client.InNamespace(namespace))
+	}
+
+	var agents arkv1alpha1.AgentList
+	if err := s.Client.List(r.Context(), &agents, listOpts...); err != nil {
+		http.Error(w, "failed to list agents", http.StatusInternalServerError)
+		return
+	}
+
+	entries := make([]AgentCatalogEntry, 0, len(agents.Items))
+	for i := range agents.Items {
+		entries = append(entries, buildAgentCatalogEntry(&agents.Items[i]))
+	}
+
+	w.Header().Set("Content-Type", genai.ContentTypeJSON)
+	if err := json.NewEncoder(w).Encode(map[string]any{"agents": entries}); err != nil {
+		logf.FromContext(r.Context()).Error(err, "failed to encode agent catalog")
+	}
+}
+
+func buildAgentCatalogEntry(agent *arkv1alpha1.Agent) AgentCatalogEntry {
+	entry := AgentCatalogEntry{
+		Name:           agent.Name,
+		Namespace:      agent.Namespace,
+		Description:    agent.Spec.Description,
+		ExamplePrompts: agentExamplePrompts(agent),
+	}
+
+	for _, parameter := range agent.Spec.Parameters {
+		entry.Parameters = append(entry.Parameters, parameter.Name)
+	}
+	if agent.Spec.OutputSchema != nil {
+		entry.OutputSchema = agent.Spec.OutputSchema.Raw
+	}
+
+	entry.AgentCard = buildAgentCatalogCard(agent, entry.ExamplePrompts)
+	return entry
+}
+
+// buildAgentCatalogCard returns an A2A-compatible card for the agent. Agents
+// materialized from an A2AServer reuse the skills advertised by the upstream
+// server; native agents expose a single skill built from their description
+func buildAgentCatalogCard(agent *arkv1alpha1.Agent, examplePrompts []string) genai.A2AAgentCard {
+	card := genai.A2AAgentCard{
+		Name:               agent.Name,
+		Description:        agent.Spec.Description,
+		URL:                agent.Annotations[annotations.A2AServerAddress],
+		Version:            agent.ResourceVersion,
+		DefaultInputModes:  []string{"text"},
+		DefaultOutputModes: []string{"text"},
+	}
+
+	if skillsJSON := agent.Annotations[annotations.A2AServerSkills]; skillsJSON != "" {
+		var skills []genai.A2AAgentSkill
+		if err := json.Unmarshal([]byte(skillsJSON), &skills); err == nil {
+			card.Skills = skills
+			return card
+		}
+	}
+
+	skill := genai.A2AAgentSkill{
+		ID:       agent.Name,
+		Name:     agent.Name,
+		Tags:     []string{},
+		Examples: examplePrompts,
+	}
+	if agent.Spec.Description != "" {
+		skill.Description = &agent.Spec.Description
+	}
+	card.Skills = []genai.A2AAgentSkill{skill}
+	return card
+}
+
+func agentExamplePrompts(agent *arkv1alpha1.Agent) []string {
+	promptsJSON := agent.Annotations[annotations.ExamplePrompts]
+	if promptsJSON == "" {
+		return nil
+	}
+	var prompts []string
+	if err := json.Unmarshal([]byte(promptsJSON), &prompts); err != nil {
+		return nil
+	}
+	return prompts
+}
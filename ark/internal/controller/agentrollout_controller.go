@@ -0,0 +1,339 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/annotations"
+)
+
+const (
+	rolloutPhaseStable      = "stable"
+	rolloutPhaseProgressing = "progressing"
+
+	rolloutCanarySuffix    = "-canary"
+	rolloutRequeueInterval = 30 * time.Second
+
+	rolloutDefaultCanaryPercent   = 10
+	rolloutDefaultWindow          = 10 * time.Minute
+	rolloutDefaultMinQueries      = 10
+	rolloutDefaultMaxErrorPercent = 10
+)
+
+// AgentRolloutReconciler reconciles an AgentRollout object
+type AgentRolloutReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=agentrollouts,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=agentrollouts/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=agents,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=queries,verbs=get;list;watch
+
+func (r *AgentRolloutReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	var rollout arkv1alpha1.AgentRollout
+	if err := r.Get(ctx, req.NamespacedName, &rollout); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to fetch AgentRollout")
+		return ctrl.Result{}, err
+	}
+
+	var agent arkv1alpha1.Agent
+	if err := r.Get(ctx, types.NamespacedName{Name: rollout.Spec.Agent, Namespace: rollout.Namespace}, &agent); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{RequeueAfter: rolloutRequeueInterval}, r.updateRolloutStatus(ctx, &rollout, statusError, fmt.Sprintf("agent %q not found", rollout.Spec.Agent))
+		}
+		return ctrl.Result{}, err
+	}
+
+	switch rollout.Status.Phase {
+	case rolloutPhaseProgressing:
+		return r.observeCanary(ctx, &rollout, &agent)
+	case rolloutPhaseStable, statusError:
+		return r.watchForChange(ctx, &rollout, &agent)
+	default:
+		rollout.Status.ObservedSpecHash = agentSpecHash(agent.Spec)
+		rollout.Status.StableSpec = agent.Spec.DeepCopy()
+		return ctrl.Result{}, r.updateRolloutStatus(ctx, &rollout, rolloutPhaseStable, "watching agent for spec changes")
+	}
+}
+
+// watchForChange starts a canary when the agent spec no longer matches the
+// accepted stable revision
+func (r *AgentRolloutReconciler) watchForChange(ctx context.Context, rollout *arkv1alpha1.AgentRollout, agent *arkv1alpha1.Agent) (ctrl.Result, error) {
+	if agentSpecHash(agent.Spec) == rollout.Status.ObservedSpecHash && rollout.Status.StableSpec != nil {
+		return ctrl.Result{RequeueAfter: rolloutRequeueInterval}, nil
+	}
+	if rollout.Status.StableSpec == nil {
+		rollout.Status.ObservedSpecHash = agentSpecHash(agent.Spec)
+		rollout.Status.StableSpec = agent.Spec.DeepCopy()
+		return ctrl.Result{}, r.updateRolloutStatus(ctx, rollout, rolloutPhaseStable, "watching agent for spec changes")
+	}
+	return r.startCanary(ctx, rollout, agent)
+}
+
+// startCanary snapshots the changed spec into a canary agent, reverts the
+// stable agent to the accepted revision and opens the observation window
+func (r *AgentRolloutReconciler) startCanary(ctx context.Context, rollout *arkv1alpha1.AgentRollout, agent *arkv1alpha1.Agent) (ctrl.Result, error) {
+	candidate := agent.Spec.DeepCopy()
+
+	canary := &arkv1alpha1.Agent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rollout.Spec.Agent + rolloutCanarySuffix,
+			Namespace: rollout.Namespace,
+		},
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, canary, func() error {
+		canary.Spec = *candidate
+		return ctrl.SetControllerReference(rollout, canary, r.Scheme)
+	}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create canary agent: %w", err)
+	}
+
+	agent.Spec = *rollout.Status.StableSpec.DeepCopy()
+	if err := r.Update(ctx, agent); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to revert stable agent: %w", err)
+	}
+
+	now := metav1.Now()
+	rollout.Status.CanaryAgent = canary.Name
+	rollout.Status.StartTime = &now
+	rollout.Status.CanaryQueries = 0
+	rollout.Status.CanaryErrors = 0
+	rollout.Status.CanaryScore = ""
+	r.Recorder.Event(rollout, corev1.EventTypeNormal, "CanaryStarted", fmt.Sprintf("canary %s receiving %d%% of queries", canary.Name, canaryPercent(rollout)))
+	return ctrl.Result{RequeueAfter: rolloutRequeueInterval}, r.updateRolloutStatus(ctx, rollout, rolloutPhaseProgressing, "canary observing traffic")
+}
+
+// observeCanary accumulates canary outcomes and promotes or rolls back once
+// the window has elapsed with enough traffic
+func (r *AgentRolloutReconciler) observeCanary(ctx context.Context, rollout *arkv1alpha1.AgentRollout, agent *arkv1alpha1.Agent) (ctrl.Result, error) {
+	if agentSpecHash(agent.Spec) != rollout.Status.ObservedSpecHash {
+		return r.startCanary(ctx, rollout, agent)
+	}
+
+	total, errored, avgScore, err := r.collectCanaryStats(ctx, rollout)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	rollout.Status.CanaryQueries = total
+	rollout.Status.CanaryErrors = errored
+	rollout.Status.CanaryScore = avgScore
+
+	window := rolloutDefaultWindow
+	if rollout.Spec.Window != nil && rollout.Spec.Window.Duration > 0 {
+		window = rollout.Spec.Window.Duration
+	}
+	elapsed := rollout.Status.StartTime != nil && time.Since(rollout.Status.StartTime.Time) >= window
+
+	minQueries := rolloutDefaultMinQueries
+	if rollout.Spec.MinQueries != nil {
+		minQueries = *rollout.Spec.MinQueries
+	}
+
+	if !elapsed || total < minQueries {
+		return ctrl.Result{RequeueAfter: rolloutRequeueInterval}, r.updateRolloutStatus(ctx, rollout, rolloutPhaseProgressing, fmt.Sprintf("canary observing traffic: %d/%d queries", total, minQueries))
+	}
+
+	if reason := r.failureReason(rollout, total, errored, avgScore); reason != "" {
+		return r.rollbackCanary(ctx, rollout, reason)
+	}
+	return r.promoteCanary(ctx, rollout, agent)
+}
+
+// failureReason returns why the canary must be rolled back, or empty when it
+// met the thresholds
+func (r *AgentRolloutReconciler) failureReason(rollout *arkv1alpha1.AgentRollout, total, errored int, avgScore string) string {
+	maxErrorPercent := rolloutDefaultMaxErrorPercent
+	if rollout.Spec.MaxErrorPercent != nil {
+		maxErrorPercent = *rollout.Spec.MaxErrorPercent
+	}
+	if total > 0 && errored*100/total > maxErrorPercent {
+		return fmt.Sprintf("error rate %d%% exceeds %d%%", errored*100/total, maxErrorPercent)
+	}
+
+	if rollout.Spec.MinScore == "" || avgScore == "" {
+		return ""
+	}
+	minScore, err := strconv.ParseFloat(rollout.Spec.MinScore, 64)
+	if err != nil {
+		return ""
+	}
+	score, err := strconv.ParseFloat(avgScore, 64)
+	if err != nil {
+		return ""
+	}
+	if score < minScore {
+		return fmt.Sprintf("average score %s below threshold %s", avgScore, rollout.Spec.MinScore)
+	}
+	return ""
+}
+
+// promoteCanary applies the candidate revision to the stable agent and
+// removes the canary
+func (r *AgentRolloutReconciler) promoteCanary(ctx context.Context, rollout *arkv1alpha1.AgentRollout, agent *arkv1alpha1.Agent) (ctrl.Result, error) {
+	var canary arkv1alpha1.Agent
+	if err := r.Get(ctx, types.NamespacedName{Name: rollout.Status.CanaryAgent, Namespace: rollout.Namespace}, &canary); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to fetch canary agent for promotion: %w", err)
+	}
+
+	agent.Spec = *canary.Spec.DeepCopy()
+	if err := r.Update(ctx, agent); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to promote canary spec: %w", err)
+	}
+	if err := r.Delete(ctx, &canary); err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	rollout.Status.ObservedSpecHash = agentSpecHash(agent.Spec)
+	rollout.Status.StableSpec = agent.Spec.DeepCopy()
+	r.clearCanary(rollout)
+	r.Recorder.Event(rollout, corev1.EventTypeNormal, "CanaryPromoted", "candidate revision promoted to stable")
+	return ctrl.Result{RequeueAfter: rolloutRequeueInterval}, r.updateRolloutStatus(ctx, rollout, rolloutPhaseStable, "canary promoted")
+}
+
+// rollbackCanary removes the canary and keeps the stable revision serving all
+// traffic
+func (r *AgentRolloutReconciler) rollbackCanary(ctx context.Context, rollout *arkv1alpha1.AgentRollout, reason string) (ctrl.Result, error) {
+	canary := &arkv1alpha1.Agent{
+		ObjectMeta: metav1.ObjectMeta{Name: rollout.Status.CanaryAgent, Namespace: rollout.Namespace},
+	}
+	if err := r.Delete(ctx, canary); err != nil && !errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	r.clearCanary(rollout)
+	r.Recorder.Event(rollout, corev1.EventTypeWarning, "CanaryRolledBack", reason)
+	return ctrl.Result{RequeueAfter: rolloutRequeueInterval}, r.updateRolloutStatus(ctx, rollout, rolloutPhaseStable, "canary rolled back: "+reason)
+}
+
+func (r *AgentRolloutReconciler) clearCanary(rollout *arkv1alpha1.AgentRollout) {
+	rollout.Status.CanaryAgent = ""
+	rollout.Status.StartTime = nil
+	rollout.Status.CanaryQueries = 0
+	rollout.Status.CanaryErrors = 0
+	rollout.Status.CanaryScore = ""
+}
+
+// collectCanaryStats aggregates terminal queries that were routed to the
+// canary during the current window
+func (r *AgentRolloutReconciler) collectCanaryStats(ctx context.Context, rollout *arkv1alpha1.AgentRollout) (total, errored int, avgScore string, err error) {
+	var queries arkv1alpha1.QueryList
+	if err := r.List(ctx, &queries, client.InNamespace(rollout.Namespace)); err != nil {
+		return 0, 0, "", err
+	}
+
+	var scoreSum float64
+	var scored int
+	for _, query := range queries.Items {
+		if query.Annotations[annotations.CanaryAgent] != rollout.Status.CanaryAgent {
+			continue
+		}
+		if rollout.Status.StartTime != nil && query.CreationTimestamp.Before(rollout.Status.StartTime) {
+			continue
+		}
+		switch query.Status.Phase {
+		case statusError:
+			total++
+			errored++
+		case statusDone:
+			total++
+			for _, evaluation := range query.Status.Evaluations {
+				if score, err := strconv.ParseFloat(evaluation.Score, 64); err == nil {
+					scoreSum += score
+					scored++
+				}
+			}
+		}
+	}
+
+	if scored > 0 {
+		avgScore = strconv.FormatFloat(scoreSum/float64(scored), 'f', 2, 64)
+	}
+	return total, errored, avgScore, nil
+}
+
+func (r *AgentRolloutReconciler) updateRolloutStatus(ctx context.Context, rollout *arkv1alpha1.AgentRollout, phase, message string) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+	rollout.Status.Phase = phase
+	rollout.Status.Message = message
+	err := r.Status().Update(ctx, rollout)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to update AgentRollout status", "phase", phase)
+	}
+	return err
+}
+
+// canaryPercent returns the configured routing percentage with its default
+func canaryPercent(rollout *arkv1alpha1.AgentRollout) int {
+	if rollout.Spec.CanaryPercent != nil {
+		return *rollout.Spec.CanaryPercent
+	}
+	return rolloutDefaultCanaryPercent
+}
+
+// agentSpecHash fingerprints an agent spec for change detection
+func agentSpecHash(spec arkv1alpha1.AgentSpec) string {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return ""
+	}
+	digest := sha256.Sum256(raw)
+	return hex.EncodeToString(digest[:])
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AgentRolloutReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arkv1alpha1.AgentRollout{}).
+		Watches(&arkv1alpha1.Agent{}, handler.EnqueueRequestsFromMapFunc(r.rolloutsForAgent)).
+		Named("agentrollout").
+		Complete(r)
+}
+
+// rolloutsForAgent enqueues the rollouts targeting a changed agent
+func (r *AgentRolloutReconciler) rolloutsForAgent(ctx context.Context, obj client.Object) []reconcile.Request {
+	var rollouts arkv1alpha1.AgentRolloutList
+	if err := r.List(ctx, &rollouts, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, rollout := range rollouts.Items {
+		if rollout.Spec.Agent != obj.GetName() && rollout.Status.CanaryAgent != obj.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: rollout.Name, Namespace: rollout.Namespace},
+		})
+	}
+	return requests
+}
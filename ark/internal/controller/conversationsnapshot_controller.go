@@ -0,0 +1,264 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/common"
+	"mckinsey.com/ark/internal/genai"
+)
+
+const (
+	snapshotModeExport   = "export"
+	snapshotModeImport   = "import"
+	snapshotModeSnapshot = "snapshot"
+	snapshotModeRestore  = "restore"
+
+	importChunkSize = 100
+)
+
+// ConversationSnapshotReconciler reconciles a ConversationSnapshot object
+type ConversationSnapshotReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=conversationsnapshots,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=conversationsnapshots/status,verbs=get;update;patch
+
+func (r *ConversationSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	var snapshot arkv1alpha1.ConversationSnapshot
+	if err := r.Get(ctx, req.NamespacedName, &snapshot); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to fetch ConversationSnapshot")
+		return ctrl.Result{}, err
+	}
+
+	switch snapshot.Status.Phase {
+	case statusReady, statusError:
+		return ctrl.Result{}, nil
+	case statusRunning:
+		return r.processSnapshot(ctx, snapshot)
+	default:
+		if err := r.updateStatus(ctx, snapshot, statusRunning, fmt.Sprintf("Starting %s", snapshot.Spec.Mode)); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+}
+
+func (r *ConversationSnapshotReconciler) processSnapshot(ctx context.Context, snapshot arkv1alpha1.ConversationSnapshot) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if err := r.checkMemoryCapabilities(ctx, snapshot); err != nil {
+		log.Error(err, "memory capability check failed", "snapshot", snapshot.Name)
+		return ctrl.Result{}, r.updateStatus(ctx, snapshot, statusError, err.Error())
+	}
+
+	recorder := genai.NewConversationSnapshotRecorder(&snapshot, r.Recorder)
+	memoryCtx := genai.MemoryContext{
+		SessionID:      snapshot.Spec.SessionId,
+		ConversationID: snapshot.Spec.SessionId,
+		QueryName:      snapshot.Name,
+	}
+	memory, err := genai.NewMemoryForQuery(ctx, r.Client, &snapshot.Spec.Memory, snapshot.Namespace, recorder, memoryCtx, "")
+	if err != nil {
+		log.Error(err, "failed to resolve memory for snapshot", "snapshot", snapshot.Name)
+		return ctrl.Result{}, r.updateStatus(ctx, snapshot, statusError, fmt.Sprintf("Failed to resolve memory: %v", err))
+	}
+	defer func() { _ = memory.Close() }()
+
+	var messageCount int
+	switch snapshot.Spec.Mode {
+	case snapshotModeExport:
+		messageCount, err = r.exportMessages(ctx, &snapshot, memory)
+	case snapshotModeImport:
+		messageCount, err = r.importMessages(ctx, snapshot, memory)
+	case snapshotModeSnapshot:
+		messageCount, err = r.takeSnapshot(ctx, &snapshot, memory)
+	case snapshotModeRestore:
+		messageCount, err = r.restoreSnapshot(ctx, snapshot, memory)
+	default:
+		err = fmt.Errorf("unknown mode: %s", snapshot.Spec.Mode)
+	}
+	if err != nil {
+		log.Error(err, "snapshot processing failed", "snapshot", snapshot.Name, "mode", snapshot.Spec.Mode)
+		return ctrl.Result{}, r.updateStatus(ctx, snapshot, statusError, err.Error())
+	}
+
+	now := metav1.Now()
+	snapshot.Status.MessageCount = messageCount
+	snapshot.Status.CompletionTime = &now
+	if err := r.updateStatus(ctx, snapshot, statusReady, fmt.Sprintf("%s complete", snapshot.Spec.Mode)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.Recorder.Event(&snapshot, corev1.EventTypeNormal, "SnapshotComplete", fmt.Sprintf("%s of %d messages for session %s", snapshot.Spec.Mode, messageCount, snapshot.Spec.SessionId))
+	return ctrl.Result{}, nil
+}
+
+// checkMemoryCapabilities gates snapshot processing on the capabilities the
+// memory service advertised during its handshake
+func (r *ConversationSnapshotReconciler) checkMemoryCapabilities(ctx context.Context, snapshot arkv1alpha1.ConversationSnapshot) error {
+	namespace := snapshot.Spec.Memory.Namespace
+	if namespace == "" {
+		namespace = snapshot.Namespace
+	}
+
+	var memory arkv1alpha1.Memory
+	if err := r.Get(ctx, client.ObjectKey{Name: snapshot.Spec.Memory.Name, Namespace: namespace}, &memory); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get memory %s: %w", snapshot.Spec.Memory.Name, err)
+	}
+
+	if memory.Status.Capabilities != nil && !memory.Status.Capabilities.Conversations {
+		return fmt.Errorf("memory service %s does not support conversations", snapshot.Spec.Memory.Name)
+	}
+	return nil
+}
+
+func (r *ConversationSnapshotReconciler) exportMessages(ctx context.Context, snapshot *arkv1alpha1.ConversationSnapshot, memory genai.MemoryInterface) (int, error) {
+	messages, err := memory.GetMessages(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read messages from memory: %w", err)
+	}
+
+	raw := make([]runtime.RawExtension, 0, len(messages))
+	for i, message := range messages {
+		data, err := json.Marshal(message)
+		if err != nil {
+			return 0, fmt.Errorf("failed to serialize message at index %d: %w", i, err)
+		}
+		raw = append(raw, runtime.RawExtension{Raw: data})
+	}
+
+	snapshot.Spec.Messages = raw
+	if err := r.Update(ctx, snapshot); err != nil {
+		return 0, fmt.Errorf("failed to store messages on snapshot: %w", err)
+	}
+	return len(messages), nil
+}
+
+// takeSnapshot captures the conversation at this point in time inside the
+// memory service and records the snapshot id on the status
+func (r *ConversationSnapshotReconciler) takeSnapshot(ctx context.Context, snapshot *arkv1alpha1.ConversationSnapshot, memory genai.MemoryInterface) (int, error) {
+	messages, err := memory.GetMessages(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read messages from memory: %w", err)
+	}
+
+	snapshotID, err := genai.SnapshotMemory(ctx, memory)
+	if err != nil {
+		return 0, fmt.Errorf("failed to capture snapshot: %w", err)
+	}
+
+	snapshot.Status.SnapshotId = snapshotID
+	return len(messages), nil
+}
+
+// restoreSnapshot rewinds the conversation to the snapshot named in the spec,
+// discarding everything written since it was captured
+func (r *ConversationSnapshotReconciler) restoreSnapshot(ctx context.Context, snapshot arkv1alpha1.ConversationSnapshot, memory genai.MemoryInterface) (int, error) {
+	if snapshot.Spec.SnapshotId == "" {
+		return 0, fmt.Errorf("restore mode requires snapshotId")
+	}
+
+	if err := genai.RestoreMemorySnapshot(ctx, memory, snapshot.Spec.SnapshotId); err != nil {
+		return 0, fmt.Errorf("failed to restore snapshot %s: %w", snapshot.Spec.SnapshotId, err)
+	}
+
+	messages, err := memory.GetMessages(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read restored conversation: %w", err)
+	}
+	return len(messages), nil
+}
+
+func (r *ConversationSnapshotReconciler) importMessages(ctx context.Context, snapshot arkv1alpha1.ConversationSnapshot, memory genai.MemoryInterface) (int, error) {
+	messages, err := r.collectImportMessages(ctx, snapshot)
+	if err != nil {
+		return 0, err
+	}
+
+	for imported := 0; imported < len(messages); imported += importChunkSize {
+		end := imported + importChunkSize
+		if end > len(messages) {
+			end = len(messages)
+		}
+		if err := memory.AddMessages(ctx, messages[imported:end]); err != nil {
+			return imported, fmt.Errorf("failed to write messages %d-%d to memory: %w", imported, end, err)
+		}
+		if end < len(messages) {
+			_ = r.updateStatus(ctx, snapshot, statusRunning, fmt.Sprintf("Imported %d of %d messages", end, len(messages)))
+		}
+	}
+	return len(messages), nil
+}
+
+// collectImportMessages gathers messages from the inline spec or the
+// referenced bulk import source, validating each before anything is written
+func (r *ConversationSnapshotReconciler) collectImportMessages(ctx context.Context, snapshot arkv1alpha1.ConversationSnapshot) ([]genai.Message, error) {
+	if snapshot.Spec.ImportSource != nil {
+		resolver := common.NewValueSourceResolver(r.Client)
+		data, err := resolver.ResolveValueSource(ctx, *snapshot.Spec.ImportSource, snapshot.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve import source: %w", err)
+		}
+		messages, err := genai.ParseImportedMessages([]byte(data))
+		if err != nil {
+			return nil, fmt.Errorf("invalid import source: %w", err)
+		}
+		return messages, nil
+	}
+
+	messages := make([]genai.Message, 0, len(snapshot.Spec.Messages))
+	for i, raw := range snapshot.Spec.Messages {
+		var message genai.Message
+		if err := json.Unmarshal(raw.Raw, &message); err != nil {
+			return nil, fmt.Errorf("failed to parse message at index %d: %w", i, err)
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+func (r *ConversationSnapshotReconciler) updateStatus(ctx context.Context, snapshot arkv1alpha1.ConversationSnapshot, status, message string) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+	snapshot.Status.Phase = status
+	snapshot.Status.Message = message
+	err := r.Status().Update(ctx, &snapshot)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to update ConversationSnapshot status", "status", status)
+	}
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ConversationSnapshotReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arkv1alpha1.ConversationSnapshot{}).
+		Named("conversationsnapshot").
+		Complete(r)
+}
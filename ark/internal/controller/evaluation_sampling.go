@@ -0,0 +1,69 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+// sampleEvaluators filters the resolved evaluators according to the query's
+// evaluation policy. The decision hashes the query UID and evaluator name, so
+// both the phase decision and the evaluation goroutine agree without storing
+// the draw. Returns the kept evaluators and a decision summary for status
+func sampleEvaluators(query *arkv1alpha1.Query, evaluators []arkv1alpha1.EvaluatorRef) ([]arkv1alpha1.EvaluatorRef, string) {
+	policy := query.Spec.EvaluationPolicy
+	if policy == nil || len(evaluators) == 0 {
+		return evaluators, ""
+	}
+
+	baseRate := 1.0
+	if policy.SampleRate != nil {
+		baseRate = parseSampleRate(*policy.SampleRate, baseRate)
+	}
+	for _, rule := range policy.Rules {
+		selector, err := metav1.LabelSelectorAsSelector(&rule.Selector)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(query.Labels)) {
+			baseRate = parseSampleRate(rule.SampleRate, baseRate)
+			break
+		}
+	}
+
+	kept := make([]arkv1alpha1.EvaluatorRef, 0, len(evaluators))
+	for _, evaluator := range evaluators {
+		rate := baseRate
+		if override, ok := policy.EvaluatorOverrides[evaluator.Name]; ok {
+			rate = parseSampleRate(override, rate)
+		}
+		if sampleDraw(string(query.UID), evaluator.Name) < rate {
+			kept = append(kept, evaluator)
+		}
+	}
+
+	return kept, fmt.Sprintf("sampled %d of %d evaluators (rate %.2f)", len(kept), len(evaluators), baseRate)
+}
+
+func parseSampleRate(raw string, fallback float64) float64 {
+	rate, err := strconv.ParseFloat(raw, 64)
+	if err != nil || rate < 0 || rate > 1 {
+		return fallback
+	}
+	return rate
+}
+
+// sampleDraw maps the query UID and evaluator name to a stable value in [0, 1)
+func sampleDraw(queryUID, evaluatorName string) float64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(queryUID + "/" + evaluatorName))
+	return float64(hash.Sum64()) / math.MaxUint64
+}
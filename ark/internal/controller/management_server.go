@@ -0,0 +1,302 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/genai"
+)
+
+const managementStreamPollInterval = time.Second
+
+// ManagementQueryRequest submits a query for asynchronous execution. Name is
+// optional; a generated name is used when empty
+type ManagementQueryRequest struct {
+	Namespace string                `json:"namespace,omitempty"`
+	Name      string                `json:"name,omitempty"`
+	Spec      arkv1alpha1.QuerySpec `json:"spec"`
+	Labels    map[string]string     `json:"labels,omitempty"`
+}
+
+// ManagementResourceEntry is a compact listing of an agent or model
+type ManagementResourceEntry struct {
+	Name        string `json:"name"`
+	Namespace   string `json:"namespace"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+// ManagementServer exposes CRD operations over HTTP for products integrating
+// with ark without direct Kubernetes API access: submitting, streaming and
+// canceling queries, and listing agents and models. Requests are
+// authenticated with OIDC bearer tokens when an authenticator is configured
+type ManagementServer struct {
+	Client client.Client
+	Addr   string
+	Auth   *OIDCAuthenticator
+}
+
+func (s *ManagementServer) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *ManagementServer) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/queries", s.authenticated(s.handleQueries))
+	mux.HandleFunc("/queries/", s.authenticated(s.handleQuery))
+	mux.HandleFunc("/agents", s.authenticated(s.handleAgents))
+	mux.HandleFunc("/models", s.authenticated(s.handleModels))
+
+	server := &http.Server{
+		Addr:              s.Addr,
+		Handler:           mux,
+		ReadHeaderTimeout: discoveryReadHeaderTimeout,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), discoveryShutdownTimeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	logf.Log.Info("starting management server", "addr", s.Addr, "authenticated", s.Auth != nil)
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *ManagementServer) authenticated(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.Auth != nil {
+			if err := s.Auth.Authenticate(r); err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+		next(w, r)
+	}
+}
+
+func (s *ManagementServer) handleQueries(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodPost:
+		s.submitQuery(w, r)
+	case http.MethodGet:
+		s.listQueries(w, r)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *ManagementServer) submitQuery(w http.ResponseWriter, r *http.Request) {
+	var request ManagementQueryRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	namespace := request.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	query := &arkv1alpha1.Query{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      request.Name,
+			Namespace: namespace,
+			Labels:    request.Labels,
+		},
+		Spec: request.Spec,
+	}
+	if query.Name == "" {
+		query.GenerateName = "query-"
+	}
+
+	if err := s.Client.Create(r.Context(), query); err != nil {
+		http.Error(w, fmt.Sprintf("failed to create query: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	s.writeJSON(r.Context(), w, buildSyncQueryResponse(query))
+}
+
+func (s *ManagementServer) listQueries(w http.ResponseWriter, r *http.Request) {
+	var queries arkv1alpha1.QueryList
+	if err := s.Client.List(r.Context(), &queries, client.InNamespace(requestNamespace(r))); err != nil {
+		http.Error(w, fmt.Sprintf("failed to list queries: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	entries := make([]SyncQueryResponse, 0, len(queries.Items))
+	for i := range queries.Items {
+		entries = append(entries, buildSyncQueryResponse(&queries.Items[i]))
+	}
+	s.writeJSON(r.Context(), w, entries)
+}
+
+func (s *ManagementServer) handleQuery(w http.ResponseWriter, r *http.Request) {
+	name, action, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/queries/"), "/")
+	if !ok {
+		action = ""
+	}
+	if name == "" {
+		http.Error(w, "query name is required", http.StatusBadRequest)
+		return
+	}
+
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		s.getQuery(w, r, name)
+	case action == "cancel" && r.Method == http.MethodPost:
+		s.cancelQuery(w, r, name)
+	case action == "stream" && r.Method == http.MethodGet:
+		s.streamQuery(w, r, name)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *ManagementServer) getQuery(w http.ResponseWriter, r *http.Request, name string) {
+	var query arkv1alpha1.Query
+	if err := s.Client.Get(r.Context(), client.ObjectKey{Namespace: requestNamespace(r), Name: name}, &query); err != nil {
+		http.Error(w, err.Error(), queryLookupStatus(err))
+		return
+	}
+	s.writeJSON(r.Context(), w, buildSyncQueryResponse(&query))
+}
+
+func (s *ManagementServer) cancelQuery(w http.ResponseWriter, r *http.Request, name string) {
+	var query arkv1alpha1.Query
+	if err := s.Client.Get(r.Context(), client.ObjectKey{Namespace: requestNamespace(r), Name: name}, &query); err != nil {
+		http.Error(w, err.Error(), queryLookupStatus(err))
+		return
+	}
+
+	query.Spec.Cancel = true
+	if err := s.Client.Update(r.Context(), &query); err != nil {
+		http.Error(w, fmt.Sprintf("failed to cancel query: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	s.writeJSON(r.Context(), w, buildSyncQueryResponse(&query))
+}
+
+// streamQuery serves the query's status updates as server-sent events until
+// it reaches a terminal phase or the client disconnects
+func (s *ManagementServer) streamQuery(w http.ResponseWriter, r *http.Request, name string) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	ticker := time.NewTicker(managementStreamPollInterval)
+	defer ticker.Stop()
+
+	lastVersion := ""
+	for {
+		var query arkv1alpha1.Query
+		if err := s.Client.Get(r.Context(), client.ObjectKey{Namespace: requestNamespace(r), Name: name}, &query); err != nil {
+			return
+		}
+
+		if query.ResourceVersion != lastVersion {
+			lastVersion = query.ResourceVersion
+			payload, err := json.Marshal(buildSyncQueryResponse(&query))
+			if err != nil {
+				return
+			}
+			fmt.Fprintf(w, "data: %s\n\n", payload)
+			flusher.Flush()
+		}
+
+		switch query.Status.Phase {
+		case statusDone, statusError, statusCanceled:
+			return
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ManagementServer) handleAgents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var agents arkv1alpha1.AgentList
+	if err := s.Client.List(r.Context(), &agents, client.InNamespace(requestNamespace(r))); err != nil {
+		http.Error(w, fmt.Sprintf("failed to list agents: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	entries := make([]ManagementResourceEntry, 0, len(agents.Items))
+	for _, agent := range agents.Items {
+		entries = append(entries, ManagementResourceEntry{
+			Name:        agent.Name,
+			Namespace:   agent.Namespace,
+			Description: agent.Spec.Description,
+		})
+	}
+	s.writeJSON(r.Context(), w, entries)
+}
+
+func (s *ManagementServer) handleModels(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var models arkv1alpha1.ModelList
+	if err := s.Client.List(r.Context(), &models, client.InNamespace(requestNamespace(r))); err != nil {
+		http.Error(w, fmt.Sprintf("failed to list models: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	entries := make([]ManagementResourceEntry, 0, len(models.Items))
+	for _, model := range models.Items {
+		entries = append(entries, ManagementResourceEntry{
+			Name:      model.Name,
+			Namespace: model.Namespace,
+			Type:      model.Spec.Type,
+		})
+	}
+	s.writeJSON(r.Context(), w, entries)
+}
+
+func (s *ManagementServer) writeJSON(ctx context.Context, w http.ResponseWriter, payload any) {
+	w.Header().Set("Content-Type", genai.ContentTypeJSON)
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		logf.FromContext(ctx).Error(err, "failed to encode management response")
+	}
+}
+
+func requestNamespace(r *http.Request) string {
+	if namespace := r.URL.Query().Get("namespace"); namespace != "" {
+		return namespace
+	}
+	return "default"
+}
@@ -0,0 +1,165 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/common"
+	"mckinsey.com/ark/internal/genai"
+)
+
+const (
+	// memoryCompactionInterval bounds how often a memory's sessions are
+	// checked for compaction, independent of the probe interval
+	memoryCompactionInterval = time.Hour
+
+	memoryCompactionDefaultIdle       = 24 * time.Hour
+	memoryCompactionDefaultKeepRecent = 10
+
+	// memoryArchiveSessionSuffix names the session that accumulates original
+	// messages replaced by compaction summaries
+	memoryArchiveSessionSuffix = "-archive"
+)
+
+// maybeCompactSessions summarizes older messages of idle sessions with the
+// configured model, archiving the originals and keeping the most recent
+// messages verbatim. Runs piggybacked on the readiness probe cycle,
+// rate-limited per memory
+func (r *MemoryReconciler) maybeCompactSessions(ctx context.Context, memory *arkv1alpha1.Memory) {
+	if memory.Spec.Compaction == nil {
+		return
+	}
+	if memory.Status.Capabilities == nil || !memory.Status.Capabilities.Sessions {
+		return
+	}
+	if memory.Status.LastResolvedAddress == nil || *memory.Status.LastResolvedAddress == "" {
+		return
+	}
+
+	key := memory.Namespace + "/" + memory.Name
+	if last, ok := r.compactions.Load(key); ok {
+		if time.Since(last.(time.Time)) < memoryCompactionInterval {
+			return
+		}
+	}
+	r.compactions.Store(key, time.Now())
+
+	if err := r.compactSessions(ctx, memory); err != nil {
+		logf.FromContext(ctx).Error(err, "memory session compaction failed", "memory", memory.Name)
+	}
+}
+
+func (r *MemoryReconciler) compactSessions(ctx context.Context, memory *arkv1alpha1.Memory) error {
+	baseURL := strings.TrimSuffix(*memory.Status.LastResolvedAddress, "/")
+	httpClient := common.NewHTTPClientWithLogging(ctx)
+
+	sessions, err := genai.ListMemorySessions(ctx, httpClient, baseURL)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	idle := memoryCompactionDefaultIdle
+	if memory.Spec.Compaction.IdleThreshold != nil && memory.Spec.Compaction.IdleThreshold.Duration > 0 {
+		idle = memory.Spec.Compaction.IdleThreshold.Duration
+	}
+
+	for _, session := range sessions {
+		if strings.HasSuffix(session.SessionID, memoryArchiveSessionSuffix) {
+			continue
+		}
+		if session.LastActivity == "" {
+			continue
+		}
+		lastActivity, err := time.Parse(time.RFC3339, session.LastActivity)
+		if err != nil || time.Since(lastActivity) < idle {
+			continue
+		}
+
+		if err := r.compactSession(ctx, memory, session.SessionID); err != nil {
+			logf.FromContext(ctx).Error(err, "failed to compact session", "memory", memory.Name, "session", session.SessionID)
+		}
+	}
+	return nil
+}
+
+// compactSession archives the session's older messages, then rewrites the
+// session as a model-written summary followed by the most recent messages
+func (r *MemoryReconciler) compactSession(ctx context.Context, memory *arkv1alpha1.Memory, sessionID string) error {
+	recorder := genai.NewMemoryRecorder(memory, r.Recorder)
+	session, err := r.sessionMemory(ctx, memory, sessionID, recorder)
+	if err != nil {
+		return err
+	}
+
+	messages, err := session.GetMessages(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get messages: %w", err)
+	}
+
+	keepRecent := memoryCompactionDefaultKeepRecent
+	if memory.Spec.Compaction.KeepRecent != nil {
+		keepRecent = *memory.Spec.Compaction.KeepRecent
+	}
+	if len(messages) <= keepRecent+1 {
+		return nil
+	}
+	older := messages[:len(messages)-keepRecent]
+	recent := messages[len(messages)-keepRecent:]
+
+	model, err := genai.LoadModel(ctx, r.Client, &memory.Spec.Compaction.ModelRef, memory.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to load compaction model: %w", err)
+	}
+
+	summary, err := genai.SummarizeMessages(ctx, model, older)
+	if err != nil {
+		return err
+	}
+
+	archive, err := r.sessionMemory(ctx, memory, sessionID+memoryArchiveSessionSuffix, recorder)
+	if err != nil {
+		return err
+	}
+	if err := archive.AddMessages(ctx, older); err != nil {
+		return fmt.Errorf("failed to archive messages: %w", err)
+	}
+
+	baseURL := strings.TrimSuffix(*memory.Status.LastResolvedAddress, "/")
+	if err := genai.DeleteMemorySession(ctx, common.NewHTTPClientWithLogging(ctx), baseURL, sessionID); err != nil {
+		return fmt.Errorf("failed to clear session: %w", err)
+	}
+	compacted := append([]genai.Message{genai.NewSummaryMessage(summary)}, recent...)
+	if err := session.AddMessages(ctx, compacted); err != nil {
+		// The session is already cleared; put the original messages back so a
+		// transient write failure cannot lose the recent verbatim messages,
+		// falling back to the archive when the restore fails too
+		if restoreErr := session.AddMessages(ctx, messages); restoreErr == nil {
+			return fmt.Errorf("failed to write compacted session, original messages restored: %w", err)
+		}
+		if archiveErr := archive.AddMessages(ctx, recent); archiveErr != nil {
+			return fmt.Errorf("failed to write compacted session and to preserve %d recent messages: %w", len(recent), err)
+		}
+		return fmt.Errorf("failed to write compacted session, recent messages preserved in archive: %w", err)
+	}
+
+	r.Recorder.Event(memory, corev1.EventTypeNormal, "SessionCompacted",
+		fmt.Sprintf("Replaced %d messages in session %s with a summary, keeping %d recent", len(older), sessionID, len(recent)))
+	return nil
+}
+
+func (r *MemoryReconciler) sessionMemory(ctx context.Context, memory *arkv1alpha1.Memory, sessionID string, recorder genai.EventEmitter) (genai.MemoryInterface, error) {
+	session, err := genai.NewHTTPMemory(ctx, r.Client, memory.Name, memory.Namespace, recorder,
+		genai.MemoryContext{SessionID: sessionID}, genai.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create memory client for session %s: %w", sessionID, err)
+	}
+	return session, nil
+}
@@ -5,9 +5,16 @@ package controller
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -16,6 +23,13 @@ import (
 
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
 	"mckinsey.com/ark/internal/common"
+	"mckinsey.com/ark/internal/genai"
+)
+
+const (
+	memoryHealthEndpoint = "/health"
+	memoryProbeInterval  = 30 * time.Second
+	memoryProbeTimeout   = 5 * time.Second
 )
 
 // MemoryReconciler reconciles a Memory object
@@ -23,7 +37,12 @@ type MemoryReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-	resolver *common.ValueSourceResolver
+
+	// Last session sweep time per memory, keyed by namespace/name
+	sweeps sync.Map
+
+	// Last session compaction time per memory, keyed by namespace/name
+	compactions sync.Map
 }
 
 // +kubebuilder:rbac:groups=ark.mckinsey.com,resources=memories,verbs=get;list;watch;create;update;patch;delete
@@ -32,7 +51,9 @@ type MemoryReconciler struct {
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
-// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 
 func (r *MemoryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
@@ -49,9 +70,12 @@ func (r *MemoryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 	// State machine approach following MCPServer pattern
 	switch memory.Status.Phase {
-	case statusReady, statusError:
-		// Terminal states - no further processing needed
+	case statusError:
+		// Terminal state - no further processing needed
 		return ctrl.Result{}, nil
+	case statusReady:
+		// Keep probing the resolved address so queries can fail fast on outages
+		return r.probeMemory(ctx, memory)
 	case statusRunning:
 		// Continue processing
 		return r.processMemory(ctx, memory)
@@ -64,19 +88,21 @@ func (r *MemoryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 }
 
-func (r *MemoryReconciler) getResolver() *common.ValueSourceResolver {
-	if r.resolver == nil {
-		r.resolver = common.NewValueSourceResolver(r.Client)
-	}
-	return r.resolver
-}
-
 func (r *MemoryReconciler) processMemory(ctx context.Context, memory arkv1alpha1.Memory) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 	log.Info("Processing memory", "memory", memory.Name)
 
-	resolver := r.getResolver()
-	resolvedAddress, err := resolver.ResolveValueSource(ctx, memory.Spec.Address, memory.Namespace)
+	if memory.Spec.Managed {
+		if err := r.reconcileManagedMemory(ctx, &memory); err != nil {
+			log.Error(err, "failed to reconcile managed memory resources", "memory", memory.Name)
+			if err := r.updateStatus(ctx, memory, statusError, fmt.Sprintf("Failed to deploy managed memory: %v", err)); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{}, nil
+		}
+	}
+
+	resolvedAddress, err := genai.ResolveMemoryAddress(ctx, r.Client, &memory)
 	if err != nil {
 		log.Error(err, "failed to resolve Memory address", "memory", memory.Name)
 		if err := r.updateStatus(ctx, memory, statusError, fmt.Sprintf("Failed to resolve address: %v", err)); err != nil {
@@ -97,8 +123,21 @@ func (r *MemoryReconciler) processMemory(ctx context.Context, memory arkv1alpha1
 		return ctrl.Result{}, nil
 	}
 
+	// Discover what the service supports rather than inferring from annotations
+	capabilities, err := genai.DiscoverMemoryCapabilities(ctx, common.NewHTTPClientWithLogging(ctx), strings.TrimSuffix(resolvedAddress, "/"))
+	if err != nil {
+		log.Error(err, "failed to discover memory capabilities", "memory", memory.Name, "address", resolvedAddress)
+		r.Recorder.Event(&memory, corev1.EventTypeWarning, "CapabilityDiscoveryFailed", err.Error())
+	}
+	memory.Status.Capabilities = capabilities
+
+	statusMessage := "Memory address resolved and validated"
+	if capabilities != nil && capabilities.ProtocolVersion != "" {
+		statusMessage = fmt.Sprintf("Memory address resolved, protocol version %s", capabilities.ProtocolVersion)
+	}
+
 	// Mark as ready
-	if err := r.updateStatus(ctx, memory, statusReady, "Memory address resolved and validated"); err != nil {
+	if err := r.updateStatus(ctx, memory, statusReady, statusMessage); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -106,7 +145,81 @@ func (r *MemoryReconciler) processMemory(ctx context.Context, memory arkv1alpha1
 	r.Recorder.Event(&memory, corev1.EventTypeNormal, "AddressResolved", fmt.Sprintf("Successfully resolved address: %s", resolvedAddress))
 	log.Info("Memory processed successfully", "memory", memory.Name, "address", resolvedAddress)
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: memoryProbeInterval}, nil
+}
+
+// probeMemory checks the health endpoint of the resolved address and maintains
+// the Ready condition with the observed latency or last error
+func (r *MemoryReconciler) probeMemory(ctx context.Context, memory arkv1alpha1.Memory) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if memory.Status.LastResolvedAddress == nil || *memory.Status.LastResolvedAddress == "" {
+		return ctrl.Result{}, r.updateStatus(ctx, memory, statusError, "Memory has no resolved address to probe")
+	}
+
+	probeURL := strings.TrimSuffix(*memory.Status.LastResolvedAddress, "/") + memoryHealthEndpoint
+	latency, err := r.checkMemoryHealth(ctx, probeURL)
+
+	condition := metav1.Condition{
+		Type:               genai.MemoryReadyCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ProbeSucceeded",
+		Message:            fmt.Sprintf("Health probe succeeded in %s", latency.Round(time.Millisecond)),
+		ObservedGeneration: memory.Generation,
+	}
+	if err != nil {
+		log.Info("memory health probe failed", "memory", memory.Name, "url", probeURL, "error", err.Error())
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ProbeFailed"
+		condition.Message = err.Error()
+	}
+
+	previous := meta.FindStatusCondition(memory.Status.Conditions, genai.MemoryReadyCondition)
+	meta.SetStatusCondition(&memory.Status.Conditions, condition)
+
+	if err := r.Status().Update(ctx, &memory); err != nil {
+		logf.FromContext(ctx).Error(err, "failed to update Memory status", "memory", memory.Name)
+		return ctrl.Result{}, err
+	}
+
+	if previous == nil || previous.Status != condition.Status {
+		eventType := corev1.EventTypeNormal
+		if condition.Status == metav1.ConditionFalse {
+			eventType = corev1.EventTypeWarning
+		}
+		r.Recorder.Event(&memory, eventType, condition.Reason, condition.Message)
+	}
+
+	if err == nil {
+		r.maybeSweepSessions(ctx, &memory)
+		r.maybeCompactSessions(ctx, &memory)
+	}
+
+	return ctrl.Result{RequeueAfter: memoryProbeInterval}, nil
+}
+
+// checkMemoryHealth performs a single health probe and returns the observed latency
+func (r *MemoryReconciler) checkMemoryHealth(ctx context.Context, probeURL string) (time.Duration, error) {
+	probeCtx, cancel := context.WithTimeout(ctx, memoryProbeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(probeCtx, http.MethodGet, probeURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create probe request: %w", err)
+	}
+
+	start := time.Now()
+	resp, err := common.NewHTTPClientWithLogging(ctx).Do(req)
+	latency := time.Since(start)
+	if err != nil {
+		return latency, fmt.Errorf("health probe failed after %s: %w", latency.Round(time.Millisecond), err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 500 {
+		return latency, fmt.Errorf("health probe returned HTTP status %d after %s", resp.StatusCode, latency.Round(time.Millisecond))
+	}
+	return latency, nil
 }
 
 // updateStatus updates the Memory status following the same pattern as MCPServer controller
@@ -135,6 +248,8 @@ func (r *MemoryReconciler) validateMemoryAddress(address string) error {
 func (r *MemoryReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&arkv1alpha1.Memory{}).
+		Owns(&appsv1.Deployment{}).
+		Owns(&corev1.Service{}).
 		Named("memory").
 		Complete(r)
 }
@@ -0,0 +1,133 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/common"
+	"mckinsey.com/ark/internal/genai"
+)
+
+// memorySessionSweepInterval bounds how often a memory's sessions are swept,
+// independent of the much shorter probe interval
+const memorySessionSweepInterval = time.Hour
+
+// maybeSweepSessions garbage-collects sessions the memory service still holds
+// but no Query references anymore, once they exceed the retention window.
+// Sweeps run piggybacked on the readiness probe cycle, rate-limited per memory
+func (r *MemoryReconciler) maybeSweepSessions(ctx context.Context, memory *arkv1alpha1.Memory) {
+	if memory.Spec.SessionRetention == nil || memory.Spec.SessionRetention.Duration <= 0 {
+		return
+	}
+	if memory.Status.Capabilities == nil || !memory.Status.Capabilities.Sessions {
+		return
+	}
+	if memory.Status.LastResolvedAddress == nil || *memory.Status.LastResolvedAddress == "" {
+		return
+	}
+
+	key := memory.Namespace + "/" + memory.Name
+	if last, ok := r.sweeps.Load(key); ok {
+		if time.Since(last.(time.Time)) < memorySessionSweepInterval {
+			return
+		}
+	}
+	r.sweeps.Store(key, time.Now())
+
+	if err := r.sweepSessions(ctx, memory); err != nil {
+		logf.FromContext(ctx).Error(err, "memory session sweep failed", "memory", memory.Name)
+	}
+}
+
+func (r *MemoryReconciler) sweepSessions(ctx context.Context, memory *arkv1alpha1.Memory) error {
+	baseURL := strings.TrimSuffix(*memory.Status.LastResolvedAddress, "/")
+	httpClient := common.NewHTTPClientWithLogging(ctx)
+
+	sessions, err := genai.ListMemorySessions(ctx, httpClient, baseURL)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	owned, err := r.ownedSessionIDs(ctx, memory)
+	if err != nil {
+		return err
+	}
+
+	retention := memory.Spec.SessionRetention.Duration
+	deleted := 0
+	for _, session := range sessions {
+		if owned[session.SessionID] {
+			continue
+		}
+		// Sessions without a last-activity timestamp cannot be aged safely
+		if session.LastActivity == "" {
+			continue
+		}
+		lastActivity, err := time.Parse(time.RFC3339, session.LastActivity)
+		if err != nil || time.Since(lastActivity) < retention {
+			continue
+		}
+
+		if err := genai.DeleteMemorySession(ctx, httpClient, baseURL, session.SessionID); err != nil {
+			logf.FromContext(ctx).Error(err, "failed to delete orphaned session", "memory", memory.Name, "session", session.SessionID)
+			continue
+		}
+		deleted++
+	}
+
+	if deleted > 0 {
+		r.Recorder.Event(memory, corev1.EventTypeNormal, "SessionsSwept",
+			fmt.Sprintf("Deleted %d orphaned sessions older than %s", deleted, retention))
+	}
+	return nil
+}
+
+// ownedSessionIDs returns the session IDs still referenced by Query resources
+// whose memory reference resolves to this memory, plus the memory's own
+// default session. Queries are listed across all namespaces because memory
+// references may cross namespaces. A query's UID is always owned: queries
+// without an explicit sessionId default their session to it (see
+// memoryContextForQuery)
+func (r *MemoryReconciler) ownedSessionIDs(ctx context.Context, memory *arkv1alpha1.Memory) (map[string]bool, error) {
+	owned := map[string]bool{string(memory.UID): true}
+
+	var queries arkv1alpha1.QueryList
+	if err := r.List(ctx, &queries); err != nil {
+		return nil, fmt.Errorf("failed to list queries: %w", err)
+	}
+	for _, query := range queries.Items {
+		if !queryReferencesMemory(&query, memory) {
+			continue
+		}
+		owned[string(query.UID)] = true
+		if query.Spec.SessionId != "" {
+			owned[query.Spec.SessionId] = true
+		}
+	}
+	return owned, nil
+}
+
+// queryReferencesMemory mirrors the resolution in genai.NewMemoryForQuery: an
+// unset reference falls back to the "default" memory in the query's namespace,
+// and an unset reference namespace defaults to the query's namespace
+func queryReferencesMemory(query *arkv1alpha1.Query, memory *arkv1alpha1.Memory) bool {
+	if query.Spec.Memory == nil {
+		return memory.Name == "default" && query.Namespace == memory.Namespace
+	}
+	namespace := query.Spec.Memory.Namespace
+	if namespace == "" {
+		namespace = query.Namespace
+	}
+	return query.Spec.Memory.Name == memory.Name && namespace == memory.Namespace
+}
@@ -0,0 +1,174 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/genai"
+)
+
+const (
+	managedMemoryDefaultImage   = "ark-cluster-memory:latest"
+	managedMemoryDefaultStorage = "1Gi"
+	managedMemoryDataPath       = "/data"
+)
+
+func managedMemoryLabels(memory *arkv1alpha1.Memory) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":     "ark-managed-memory",
+		"app.kubernetes.io/instance": memory.Name,
+	}
+}
+
+func managedMemorySettings(memory *arkv1alpha1.Memory) arkv1alpha1.ManagedMemorySettings {
+	if memory.Spec.ManagedSettings != nil {
+		return *memory.Spec.ManagedSettings
+	}
+	return arkv1alpha1.ManagedMemorySettings{}
+}
+
+// reconcileManagedMemory ensures the volume claim, Deployment and Service
+// backing a managed Memory exist and match the spec, owned by the Memory so
+// deletion cascades
+func (r *MemoryReconciler) reconcileManagedMemory(ctx context.Context, memory *arkv1alpha1.Memory) error {
+	if err := r.reconcileManagedVolumeClaim(ctx, memory); err != nil {
+		return err
+	}
+	if err := r.reconcileManagedDeployment(ctx, memory); err != nil {
+		return err
+	}
+	return r.reconcileManagedService(ctx, memory)
+}
+
+func (r *MemoryReconciler) reconcileManagedVolumeClaim(ctx context.Context, memory *arkv1alpha1.Memory) error {
+	settings := managedMemorySettings(memory)
+	storageSize := settings.StorageSize
+	if storageSize == "" {
+		storageSize = managedMemoryDefaultStorage
+	}
+	quantity, err := resource.ParseQuantity(storageSize)
+	if err != nil {
+		return fmt.Errorf("invalid managed memory storage size %q: %w", storageSize, err)
+	}
+
+	claim := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: genai.ManagedMemoryServiceName(memory.Name), Namespace: memory.Namespace},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, claim, func() error {
+		// Claim spec fields are immutable after creation
+		if claim.CreationTimestamp.IsZero() {
+			claim.Labels = managedMemoryLabels(memory)
+			claim.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+			claim.Spec.Resources = corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: quantity},
+			}
+			if settings.StorageClass != "" {
+				storageClass := settings.StorageClass
+				claim.Spec.StorageClassName = &storageClass
+			}
+		}
+		return controllerutil.SetControllerReference(memory, claim, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply managed memory volume claim: %w", err)
+	}
+	return nil
+}
+
+func (r *MemoryReconciler) reconcileManagedDeployment(ctx context.Context, memory *arkv1alpha1.Memory) error {
+	settings := managedMemorySettings(memory)
+	image := settings.Image
+	if image == "" {
+		image = managedMemoryDefaultImage
+	}
+	labels := managedMemoryLabels(memory)
+	name := genai.ManagedMemoryServiceName(memory.Name)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: memory.Namespace},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, deployment, func() error {
+		deployment.Labels = labels
+		replicas := int32(1)
+		deployment.Spec.Replicas = &replicas
+		deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: labels}
+		deployment.Spec.Template = corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: labels},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  "memory",
+						Image: image,
+						Ports: []corev1.ContainerPort{
+							{Name: "http", ContainerPort: genai.ManagedMemoryPort, Protocol: corev1.ProtocolTCP},
+						},
+						Env: []corev1.EnvVar{
+							{Name: "PORT", Value: fmt.Sprintf("%d", genai.ManagedMemoryPort)},
+							{Name: "MEMORY_FILE_PATH", Value: managedMemoryDataPath + "/memory.json"},
+							{Name: "STREAM_FILE_PATH", Value: managedMemoryDataPath + "/stream.json"},
+						},
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: "data", MountPath: managedMemoryDataPath},
+						},
+						LivenessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								HTTPGet: &corev1.HTTPGetAction{Path: memoryHealthEndpoint, Port: intstr.FromString("http")},
+							},
+							InitialDelaySeconds: 10,
+							PeriodSeconds:       10,
+						},
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								HTTPGet: &corev1.HTTPGetAction{Path: memoryHealthEndpoint, Port: intstr.FromString("http")},
+							},
+							InitialDelaySeconds: 5,
+							PeriodSeconds:       10,
+						},
+					},
+				},
+				Volumes: []corev1.Volume{
+					{
+						Name: "data",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: name},
+						},
+					},
+				},
+			},
+		}
+		return controllerutil.SetControllerReference(memory, deployment, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply managed memory deployment: %w", err)
+	}
+	return nil
+}
+
+func (r *MemoryReconciler) reconcileManagedService(ctx context.Context, memory *arkv1alpha1.Memory) error {
+	labels := managedMemoryLabels(memory)
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: genai.ManagedMemoryServiceName(memory.Name), Namespace: memory.Namespace},
+	}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, service, func() error {
+		service.Labels = labels
+		service.Spec.Selector = labels
+		service.Spec.Ports = []corev1.ServicePort{
+			{Name: "http", Port: genai.ManagedMemoryPort, TargetPort: intstr.FromString("http"), Protocol: corev1.ProtocolTCP},
+		}
+		return controllerutil.SetControllerReference(memory, service, r.Scheme)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply managed memory service: %w", err)
+	}
+	return nil
+}
@@ -4,6 +4,8 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -20,7 +22,8 @@ import (
 
 const (
 	// Condition types
-	ModelAvailable = "ModelAvailable"
+	ModelAvailable      = "ModelAvailable"
+	ModelCircuitBreaker = "CircuitBreakerOpen"
 )
 
 type ModelReconciler struct {
@@ -60,6 +63,8 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	// Probe the model to test whether it is available.
 	result := r.probeModel(ctx, model)
 
+	r.setCircuitBreakerCondition(&model)
+
 	if !result.Available {
 		// Log the failure with a detailed error message. This is still 'info'
 		// as probe failures are expected - the model events and conditions
@@ -108,6 +113,21 @@ func (r *ModelReconciler) probeModel(ctx context.Context, model arkv1alpha1.Mode
 	return genai.ProbeModel(ctx, resolvedModel)
 }
 
+// setCircuitBreakerCondition surfaces the in-process circuit breaker state so
+// dashboards can see which providers are failing fast
+func (r *ModelReconciler) setCircuitBreakerCondition(model *arkv1alpha1.Model) {
+	state := genai.CircuitBreakerFor(model.Namespace + "/" + model.Name).State()
+	if state.Open {
+		message := fmt.Sprintf("Circuit breaker open after %d consecutive provider failures", state.ConsecutiveFailures)
+		if state.RetryAfter > 0 {
+			message = fmt.Sprintf("%s, next probe in %s", message, state.RetryAfter.Round(time.Second))
+		}
+		r.setCondition(model, ModelCircuitBreaker, metav1.ConditionTrue, "ConsecutiveProviderFailures", message)
+		return
+	}
+	r.setCondition(model, ModelCircuitBreaker, metav1.ConditionFalse, "Closed", "Provider calls are admitted")
+}
+
 // setCondition sets a condition on the Model
 func (r *ModelReconciler) setCondition(model *arkv1alpha1.Model, conditionType string, status metav1.ConditionStatus, reason, message string) {
 	meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
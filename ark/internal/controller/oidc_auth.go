@@ -0,0 +1,176 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"mckinsey.com/ark/internal/common"
+)
+
+const (
+	oidcDiscoveryPath      = "/.well-known/openid-configuration"
+	oidcKeyRefreshInterval = 5 * time.Minute
+)
+
+// OIDCAuthenticator validates bearer tokens issued by an OIDC provider,
+// caching the provider's signing keys between requests
+type OIDCAuthenticator struct {
+	Issuer   string
+	Audience string
+
+	mu      sync.Mutex
+	keys    map[string]*rsa.PublicKey
+	fetched time.Time
+}
+
+// NewOIDCAuthenticator builds an authenticator for the issuer; audience is
+// optional and skipped when empty
+func NewOIDCAuthenticator(issuer, audience string) *OIDCAuthenticator {
+	return &OIDCAuthenticator{
+		Issuer:   strings.TrimSuffix(issuer, "/"),
+		Audience: audience,
+	}
+}
+
+// Authenticate validates the request's bearer token signature, issuer, expiry
+// and, when configured, audience
+func (a *OIDCAuthenticator) Authenticate(r *http.Request) error {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return fmt.Errorf("missing bearer token")
+	}
+	rawToken := strings.TrimPrefix(header, "Bearer ")
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(a.Issuer),
+		jwt.WithExpirationRequired(),
+	}
+	if a.Audience != "" {
+		options = append(options, jwt.WithAudience(a.Audience))
+	}
+
+	if _, err := jwt.Parse(rawToken, a.signingKey(r.Context()), options...); err != nil {
+		return fmt.Errorf("invalid token: %w", err)
+	}
+	return nil
+}
+
+// signingKey returns a keyfunc that resolves the token's kid against the
+// provider's JWKS, refreshing the cached keys when the kid is unknown
+func (a *OIDCAuthenticator) signingKey(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		if key, ok := a.keys[kid]; ok {
+			return key, nil
+		}
+		if time.Since(a.fetched) >= oidcKeyRefreshInterval {
+			if err := a.refreshKeys(ctx); err != nil {
+				return nil, err
+			}
+		}
+		if key, ok := a.keys[kid]; ok {
+			return key, nil
+		}
+		return nil, fmt.Errorf("no signing key for kid %q", kid)
+	}
+}
+
+func (a *OIDCAuthenticator) refreshKeys(ctx context.Context) error {
+	jwksURI, err := a.discoverJWKSURI(ctx)
+	if err != nil {
+		return err
+	}
+
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := fetchJSON(ctx, jwksURI, &jwks); err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, key := range jwks.Keys {
+		if key.Kty != "RSA" {
+			continue
+		}
+		publicKey, err := parseRSAKey(key.N, key.E)
+		if err != nil {
+			continue
+		}
+		keys[key.Kid] = publicKey
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("JWKS at %s contains no usable RSA keys", jwksURI)
+	}
+
+	a.keys = keys
+	a.fetched = time.Now()
+	return nil
+}
+
+func (a *OIDCAuthenticator) discoverJWKSURI(ctx context.Context) (string, error) {
+	var discovery struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := fetchJSON(ctx, a.Issuer+oidcDiscoveryPath, &discovery); err != nil {
+		return "", fmt.Errorf("OIDC discovery failed: %w", err)
+	}
+	if discovery.JWKSURI == "" {
+		return "", fmt.Errorf("OIDC discovery document has no jwks_uri")
+	}
+	return discovery.JWKSURI, nil
+}
+
+func fetchJSON(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := common.NewHTTPClientWithLogging(ctx).Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned HTTP status %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func parseRSAKey(rawN, rawE string) (*rsa.PublicKey, error) {
+	nBytes, err := base64.RawURLEncoding.DecodeString(rawN)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	eBytes, err := base64.RawURLEncoding.DecodeString(rawE)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nBytes),
+		E: int(new(big.Int).SetBytes(eBytes).Int64()),
+	}, nil
+}
@@ -0,0 +1,219 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+const queryArchiveDefaultCapacity = 10000
+
+// QueryArchiveEntry is a compact summary of a completed query, retained in
+// the archive after TTL cleanup deletes the Query object
+type QueryArchiveEntry struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Targets     []string          `json:"targets,omitempty"`
+	Phase       string            `json:"phase"`
+	CompletedAt time.Time         `json:"completedAt"`
+	Duration    string            `json:"duration,omitempty"`
+	TotalTokens int64             `json:"totalTokens"`
+	Scores      map[string]string `json:"scores,omitempty"`
+
+	sequence uint64
+}
+
+// QueryArchiveFilter selects archive entries; zero values match everything
+type QueryArchiveFilter struct {
+	Selector  labels.Selector
+	Target    string
+	Phase     string
+	Since     time.Time
+	Until     time.Time
+	MinScore  *float64
+	MaxScore  *float64
+	MinTokens *int64
+	MaxTokens *int64
+}
+
+// QueryArchive is a bounded in-memory index of completed queries, fed by the
+// query controller as queries reach a terminal phase. It lets clients browse
+// historical queries without listing Query objects, which does not scale once
+// TTL cleanup and thousands of objects are involved
+type QueryArchive struct {
+	mu       sync.Mutex
+	capacity int
+	sequence uint64
+	entries  []QueryArchiveEntry
+}
+
+func NewQueryArchive(capacity int) *QueryArchive {
+	if capacity <= 0 {
+		capacity = queryArchiveDefaultCapacity
+	}
+	return &QueryArchive{capacity: capacity}
+}
+
+// Record stores a summary of the query, replacing any earlier entry for the
+// same query and evicting the oldest entry when the archive is full
+func (a *QueryArchive) Record(query *arkv1alpha1.Query) {
+	if a == nil {
+		return
+	}
+
+	entry := buildQueryArchiveEntry(query)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	key := query.Namespace + "/" + query.Name
+	for i := range a.entries {
+		if a.entries[i].Namespace+"/"+a.entries[i].Name == key {
+			a.entries = append(a.entries[:i], a.entries[i+1:]...)
+			break
+		}
+	}
+
+	if len(a.entries) >= a.capacity {
+		a.entries = a.entries[1:]
+	}
+
+	a.sequence++
+	entry.sequence = a.sequence
+	a.entries = append(a.entries, entry)
+}
+
+// List returns up to limit matching entries, newest first, starting after the
+// cursor returned by a previous call. An empty next cursor means the listing
+// is exhausted
+func (a *QueryArchive) List(filter QueryArchiveFilter, cursor string, limit int) ([]QueryArchiveEntry, string, error) {
+	after := uint64(0)
+	if cursor != "" {
+		parsed, err := strconv.ParseUint(cursor, 10, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid cursor %q", cursor)
+		}
+		after = parsed
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	results := make([]QueryArchiveEntry, 0, limit)
+	for i := len(a.entries) - 1; i >= 0; i-- {
+		entry := a.entries[i]
+		if after > 0 && entry.sequence >= after {
+			continue
+		}
+		if !filter.matches(entry) {
+			continue
+		}
+		if len(results) == limit {
+			return results, strconv.FormatUint(results[len(results)-1].sequence, 10), nil
+		}
+		results = append(results, entry)
+	}
+
+	return results, "", nil
+}
+
+func (f QueryArchiveFilter) matches(entry QueryArchiveEntry) bool {
+	if f.Selector != nil && !f.Selector.Matches(labels.Set(entry.Labels)) {
+		return false
+	}
+	if f.Target != "" && !containsString(entry.Targets, f.Target) {
+		return false
+	}
+	if f.Phase != "" && entry.Phase != f.Phase {
+		return false
+	}
+	if !f.Since.IsZero() && entry.CompletedAt.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && entry.CompletedAt.After(f.Until) {
+		return false
+	}
+	if f.MinTokens != nil && entry.TotalTokens < *f.MinTokens {
+		return false
+	}
+	if f.MaxTokens != nil && entry.TotalTokens > *f.MaxTokens {
+		return false
+	}
+	if f.MinScore != nil || f.MaxScore != nil {
+		return entryScoreInRange(entry, f.MinScore, f.MaxScore)
+	}
+	return true
+}
+
+// entryScoreInRange reports whether any evaluation score parses as a number
+// within the requested bounds
+func entryScoreInRange(entry QueryArchiveEntry, minScore, maxScore *float64) bool {
+	for _, score := range entry.Scores {
+		value, err := strconv.ParseFloat(score, 64)
+		if err != nil {
+			continue
+		}
+		if minScore != nil && value < *minScore {
+			continue
+		}
+		if maxScore != nil && value > *maxScore {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func buildQueryArchiveEntry(query *arkv1alpha1.Query) QueryArchiveEntry {
+	entry := QueryArchiveEntry{
+		Name:        query.Name,
+		Namespace:   query.Namespace,
+		Labels:      query.Labels,
+		Phase:       query.Status.Phase,
+		CompletedAt: time.Now().UTC(),
+		TotalTokens: query.Status.TokenUsage.TotalTokens,
+	}
+
+	if query.Status.Duration != nil {
+		entry.Duration = query.Status.Duration.Duration.String()
+	}
+
+	for _, target := range query.Spec.Targets {
+		entry.Targets = append(entry.Targets, target.Type+"/"+target.Name)
+	}
+	for _, response := range query.Status.Responses {
+		target := response.Target.Type + "/" + response.Target.Name
+		if !containsString(entry.Targets, target) {
+			entry.Targets = append(entry.Targets, target)
+		}
+	}
+
+	for _, evaluation := range query.Status.Evaluations {
+		if evaluation.Score == "" {
+			continue
+		}
+		if entry.Scores == nil {
+			entry.Scores = map[string]string{}
+		}
+		entry.Scores[evaluation.EvaluatorName] = evaluation.Score
+	}
+
+	return entry
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,229 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/genai"
+)
+
+const (
+	queryArchiveDefaultPageSize = 50
+	queryArchiveMaxPageSize     = 500
+)
+
+// QueryArchiveServer serves the query archive over HTTP so clients can browse
+// historical queries with filtering and cursor pagination, and compare two
+// query runs for regression review
+type QueryArchiveServer struct {
+	Archive *QueryArchive
+	Client  client.Reader
+	Addr    string
+}
+
+func (s *QueryArchiveServer) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *QueryArchiveServer) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/queries", s.handleQueries)
+	mux.HandleFunc("/queries/compare", s.handleCompare)
+
+	server := &http.Server{
+		Addr:              s.Addr,
+		Handler:           mux,
+		ReadHeaderTimeout: discoveryReadHeaderTimeout,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), discoveryShutdownTimeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	logf.Log.Info("starting query archive server", "addr", s.Addr)
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *QueryArchiveServer) handleQueries(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	filter, err := parseQueryArchiveFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	limit := queryArchiveDefaultPageSize
+	if rawLimit := r.URL.Query().Get("limit"); rawLimit != "" {
+		limit, err = strconv.Atoi(rawLimit)
+		if err != nil || limit <= 0 {
+			http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+		if limit > queryArchiveMaxPageSize {
+			limit = queryArchiveMaxPageSize
+		}
+	}
+
+	entries, nextCursor, err := s.Archive.List(filter, r.URL.Query().Get("cursor"), limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := map[string]any{"queries": entries}
+	if nextCursor != "" {
+		response["nextCursor"] = nextCursor
+	}
+
+	w.Header().Set("Content-Type", genai.ContentTypeJSON)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logf.FromContext(r.Context()).Error(err, "failed to encode query archive page")
+	}
+}
+
+// handleCompare loads two queries by name and returns the structured
+// comparison of the candidate run against the base run
+func (s *QueryArchiveServer) handleCompare(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if s.Client == nil {
+		http.Error(w, "query comparison is not available", http.StatusNotImplemented)
+		return
+	}
+
+	params := r.URL.Query()
+	baseName := params.Get("base")
+	candidateName := params.Get("candidate")
+	if baseName == "" || candidateName == "" {
+		http.Error(w, "base and candidate query names are required", http.StatusBadRequest)
+		return
+	}
+	namespace := params.Get("namespace")
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	base, err := s.getQuery(r.Context(), namespace, baseName)
+	if err != nil {
+		http.Error(w, err.Error(), queryLookupStatus(err))
+		return
+	}
+	candidate, err := s.getQuery(r.Context(), namespace, candidateName)
+	if err != nil {
+		http.Error(w, err.Error(), queryLookupStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", genai.ContentTypeJSON)
+	if err := json.NewEncoder(w).Encode(CompareQueries(base, candidate)); err != nil {
+		logf.FromContext(r.Context()).Error(err, "failed to encode query comparison")
+	}
+}
+
+func (s *QueryArchiveServer) getQuery(ctx context.Context, namespace, name string) (*arkv1alpha1.Query, error) {
+	var query arkv1alpha1.Query
+	if err := s.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &query); err != nil {
+		return nil, err
+	}
+	return &query, nil
+}
+
+func queryLookupStatus(err error) int {
+	if apierrors.IsNotFound(err) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+func parseQueryArchiveFilter(r *http.Request) (QueryArchiveFilter, error) {
+	params := r.URL.Query()
+	filter := QueryArchiveFilter{
+		Target: params.Get("target"),
+		Phase:  params.Get("phase"),
+	}
+
+	if rawSelector := params.Get("labelSelector"); rawSelector != "" {
+		selector, err := labels.Parse(rawSelector)
+		if err != nil {
+			return filter, errors.New("invalid labelSelector")
+		}
+		filter.Selector = selector
+	}
+
+	var err error
+	if filter.Since, err = parseArchiveTime(params.Get("since")); err != nil {
+		return filter, errors.New("since must be RFC3339")
+	}
+	if filter.Until, err = parseArchiveTime(params.Get("until")); err != nil {
+		return filter, errors.New("until must be RFC3339")
+	}
+
+	if filter.MinScore, err = parseArchiveFloat(params.Get("minScore")); err != nil {
+		return filter, errors.New("minScore must be a number")
+	}
+	if filter.MaxScore, err = parseArchiveFloat(params.Get("maxScore")); err != nil {
+		return filter, errors.New("maxScore must be a number")
+	}
+
+	if filter.MinTokens, err = parseArchiveInt(params.Get("minTokens")); err != nil {
+		return filter, errors.New("minTokens must be an integer")
+	}
+	if filter.MaxTokens, err = parseArchiveInt(params.Get("maxTokens")); err != nil {
+		return filter, errors.New("maxTokens must be an integer")
+	}
+
+	return filter, nil
+}
+
+func parseArchiveTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func parseArchiveFloat(value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+func parseArchiveInt(value string) (*int64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
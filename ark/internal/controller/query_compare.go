@@ -0,0 +1,254 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+// QueryComparisonRun summarizes one side of a comparison
+type QueryComparisonRun struct {
+	Name       string                 `json:"name"`
+	Namespace  string                 `json:"namespace"`
+	Phase      string                 `json:"phase"`
+	Duration   string                 `json:"duration,omitempty"`
+	TokenUsage arkv1alpha1.TokenUsage `json:"tokenUsage"`
+	Scores     map[string]string      `json:"scores,omitempty"`
+}
+
+// TokenUsageDelta is candidate minus base token usage
+type TokenUsageDelta struct {
+	PromptTokens     int64 `json:"promptTokens"`
+	CompletionTokens int64 `json:"completionTokens"`
+	TotalTokens      int64 `json:"totalTokens"`
+}
+
+// ResponseComparison pairs the responses of the two runs for one target.
+// Diff is a line diff of the candidate content against the base content,
+// with " ", "-" and "+" prefixes
+type ResponseComparison struct {
+	Target           string   `json:"target"`
+	BaseContent      string   `json:"baseContent,omitempty"`
+	CandidateContent string   `json:"candidateContent,omitempty"`
+	Changed          bool     `json:"changed"`
+	Diff             []string `json:"diff,omitempty"`
+	DurationDelta    string   `json:"durationDelta,omitempty"`
+}
+
+// ScoreComparison pairs the evaluator scores of the two runs; Delta is set
+// when both scores parse as numbers
+type ScoreComparison struct {
+	Evaluator string   `json:"evaluator"`
+	Base      string   `json:"base,omitempty"`
+	Candidate string   `json:"candidate,omitempty"`
+	Delta     *float64 `json:"delta,omitempty"`
+}
+
+// QueryComparison is the structured comparison of two query runs, e.g. the
+// same input against two agent or prompt revisions
+type QueryComparison struct {
+	Base          QueryComparisonRun   `json:"base"`
+	Candidate     QueryComparisonRun   `json:"candidate"`
+	Responses     []ResponseComparison `json:"responses,omitempty"`
+	TokenDelta    TokenUsageDelta      `json:"tokenDelta"`
+	DurationDelta string               `json:"durationDelta,omitempty"`
+	Scores        []ScoreComparison    `json:"scores,omitempty"`
+}
+
+// CompareQueries builds the structured comparison of a candidate run against
+// a base run
+func CompareQueries(base, candidate *arkv1alpha1.Query) QueryComparison {
+	comparison := QueryComparison{
+		Base:      buildComparisonRun(base),
+		Candidate: buildComparisonRun(candidate),
+		TokenDelta: TokenUsageDelta{
+			PromptTokens:     candidate.Status.TokenUsage.PromptTokens - base.Status.TokenUsage.PromptTokens,
+			CompletionTokens: candidate.Status.TokenUsage.CompletionTokens - base.Status.TokenUsage.CompletionTokens,
+			TotalTokens:      candidate.Status.TokenUsage.TotalTokens - base.Status.TokenUsage.TotalTokens,
+		},
+		Responses: compareResponses(base, candidate),
+		Scores:    compareScores(base, candidate),
+	}
+
+	if base.Status.Duration != nil && candidate.Status.Duration != nil {
+		comparison.DurationDelta = signedDuration(candidate.Status.Duration.Duration - base.Status.Duration.Duration)
+	}
+
+	return comparison
+}
+
+func buildComparisonRun(query *arkv1alpha1.Query) QueryComparisonRun {
+	run := QueryComparisonRun{
+		Name:       query.Name,
+		Namespace:  query.Namespace,
+		Phase:      query.Status.Phase,
+		TokenUsage: query.Status.TokenUsage,
+	}
+	if query.Status.Duration != nil {
+		run.Duration = query.Status.Duration.Duration.String()
+	}
+	for _, evaluation := range query.Status.Evaluations {
+		if evaluation.Score == "" {
+			continue
+		}
+		if run.Scores == nil {
+			run.Scores = map[string]string{}
+		}
+		run.Scores[evaluation.EvaluatorName] = evaluation.Score
+	}
+	return run
+}
+
+// compareResponses pairs responses by target, falling back to positional
+// pairing when both runs have a single response against different targets,
+// the common case when comparing an agent against its next revision
+func compareResponses(base, candidate *arkv1alpha1.Query) []ResponseComparison {
+	baseResponses := base.Status.Responses
+	candidateResponses := candidate.Status.Responses
+
+	if len(baseResponses) == 1 && len(candidateResponses) == 1 {
+		return []ResponseComparison{buildResponseComparison(
+			responseTargetKey(baseResponses[0])+" vs "+responseTargetKey(candidateResponses[0]),
+			&baseResponses[0], &candidateResponses[0])}
+	}
+
+	candidatesByTarget := map[string]*arkv1alpha1.Response{}
+	for i := range candidateResponses {
+		candidatesByTarget[responseTargetKey(candidateResponses[i])] = &candidateResponses[i]
+	}
+
+	var comparisons []ResponseComparison
+	for i := range baseResponses {
+		key := responseTargetKey(baseResponses[i])
+		comparisons = append(comparisons, buildResponseComparison(key, &baseResponses[i], candidatesByTarget[key]))
+		delete(candidatesByTarget, key)
+	}
+	for i := range candidateResponses {
+		key := responseTargetKey(candidateResponses[i])
+		if _, remaining := candidatesByTarget[key]; remaining {
+			comparisons = append(comparisons, buildResponseComparison(key, nil, &candidateResponses[i]))
+		}
+	}
+	return comparisons
+}
+
+func responseTargetKey(response arkv1alpha1.Response) string {
+	return response.Target.Type + "/" + response.Target.Name
+}
+
+func buildResponseComparison(target string, base, candidate *arkv1alpha1.Response) ResponseComparison {
+	comparison := ResponseComparison{Target: target}
+	if base != nil {
+		comparison.BaseContent = base.Content
+	}
+	if candidate != nil {
+		comparison.CandidateContent = candidate.Content
+	}
+	comparison.Changed = comparison.BaseContent != comparison.CandidateContent
+	if comparison.Changed {
+		comparison.Diff = diffLines(comparison.BaseContent, comparison.CandidateContent)
+	}
+	if base != nil && candidate != nil && base.Duration != nil && candidate.Duration != nil {
+		comparison.DurationDelta = signedDuration(candidate.Duration.Duration - base.Duration.Duration)
+	}
+	return comparison
+}
+
+func compareScores(base, candidate *arkv1alpha1.Query) []ScoreComparison {
+	baseScores := evaluationScores(base)
+	candidateScores := evaluationScores(candidate)
+
+	seen := map[string]bool{}
+	var comparisons []ScoreComparison
+	appendScore := func(evaluator string) {
+		if seen[evaluator] {
+			return
+		}
+		seen[evaluator] = true
+		comparison := ScoreComparison{
+			Evaluator: evaluator,
+			Base:      baseScores[evaluator],
+			Candidate: candidateScores[evaluator],
+		}
+		baseValue, baseErr := strconv.ParseFloat(comparison.Base, 64)
+		candidateValue, candidateErr := strconv.ParseFloat(comparison.Candidate, 64)
+		if baseErr == nil && candidateErr == nil {
+			delta := candidateValue - baseValue
+			comparison.Delta = &delta
+		}
+		comparisons = append(comparisons, comparison)
+	}
+
+	for _, evaluation := range base.Status.Evaluations {
+		appendScore(evaluation.EvaluatorName)
+	}
+	for _, evaluation := range candidate.Status.Evaluations {
+		appendScore(evaluation.EvaluatorName)
+	}
+	return comparisons
+}
+
+func evaluationScores(query *arkv1alpha1.Query) map[string]string {
+	scores := map[string]string{}
+	for _, evaluation := range query.Status.Evaluations {
+		scores[evaluation.EvaluatorName] = evaluation.Score
+	}
+	return scores
+}
+
+func signedDuration(delta time.Duration) string {
+	if delta >= 0 {
+		return "+" + delta.String()
+	}
+	return delta.String()
+}
+
+// diffLines computes a line diff of candidate against base using the longest
+// common subsequence, small enough for the bounded content kept in query
+// status
+func diffLines(base, candidate string) []string {
+	baseLines := strings.Split(base, "\n")
+	candidateLines := strings.Split(candidate, "\n")
+
+	lcs := make([][]int, len(baseLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(candidateLines)+1)
+	}
+	for i := len(baseLines) - 1; i >= 0; i-- {
+		for j := len(candidateLines) - 1; j >= 0; j-- {
+			if baseLines[i] == candidateLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var diff []string
+	i, j := 0, 0
+	for i < len(baseLines) && j < len(candidateLines) {
+		switch {
+		case baseLines[i] == candidateLines[j]:
+			diff = append(diff, " "+baseLines[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, "-"+baseLines[i])
+			i++
+		default:
+			diff = append(diff, "+"+candidateLines[j])
+			j++
+		}
+	}
+	for ; i < len(baseLines); i++ {
+		diff = append(diff, "-"+baseLines[i])
+	}
+	for ; j < len(candidateLines); j++ {
+		diff = append(diff, "+"+candidateLines[j])
+	}
+	return diff
+}
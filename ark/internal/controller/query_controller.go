@@ -5,13 +5,18 @@ package controller
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/openai/openai-go"
 	"go.opentelemetry.io/otel/attribute"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -21,23 +26,72 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/annotations"
 	"mckinsey.com/ark/internal/genai"
 	"mckinsey.com/ark/internal/telemetry"
 )
 
+const (
+	operationJanitorInterval = time.Minute
+	operationEvictionGrace   = time.Minute
+	defaultOperationTimeout  = 5 * time.Minute
+
+	maintenanceRequeueInterval = 30 * time.Second
+)
+
+// QueryConditionMaintenance is set on a pending query held back because the
+// namespace's ArkConfig has maintenance mode enabled
+const QueryConditionMaintenance = "Maintenance"
+
+// QueryConditionContentFiltered is set on a query rejected by a provider
+// content filter, with the filtered categories in the message
+const QueryConditionContentFiltered = "ContentFiltered"
+
+// QueryConditionStreaming is set to False when chunk delivery to the event
+// stream degraded during execution, with the last error in the message
+const QueryConditionStreaming = "Streaming"
+
+// QueryConditionMemoryWrite is set to False when writes to the memory backend
+// failed during execution, with the last error in the message
+const QueryConditionMemoryWrite = "MemoryWrite"
+
+// QueryConditionThrottled is set while a provider call is queued behind a
+// model's rate limits, with the expected wait in the message
+const QueryConditionThrottled = "Throttled"
+
+// QueryConditionDelivery reports whether completed responses were posted to
+// the channels in spec.deliverTo
+const QueryConditionDelivery = "Delivery"
+
+// queryOperation tracks a running execution goroutine so it can be cancelled
+// and evicted when it outlives the query deadline
+type queryOperation struct {
+	cancel   context.CancelFunc
+	started  time.Time
+	deadline time.Time
+}
+
 type targetResult struct {
-	messages []genai.Message
-	err      error
-	target   arkv1alpha1.QueryTarget
+	messages     []genai.Message
+	citations    []arkv1alpha1.Citation
+	alternatives []string
+	servedBy     string
+	timing       genai.TargetTiming
+	redactor     *genai.SecretRedactor
+	err          error
+	target       arkv1alpha1.QueryTarget
 }
 
 type QueryReconciler struct {
 	client.Client
 	Scheme     *runtime.Scheme
 	Recorder   record.EventRecorder
+	Archive    *QueryArchive
 	operations sync.Map
+	streams    sync.Map
 }
 
 // +kubebuilder:rbac:groups=ark.mckinsey.com,resources=queries,verbs=get;list;watch;create;update;patch;delete
@@ -47,8 +101,13 @@ type QueryReconciler struct {
 // +kubebuilder:rbac:groups=ark.mckinsey.com,resources=teams,verbs=get;list
 // +kubebuilder:rbac:groups=ark.mckinsey.com,resources=models,verbs=get;list
 // +kubebuilder:rbac:groups=ark.mckinsey.com,resources=evaluators,verbs=get;list
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=routers,verbs=get;list
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=deliverychannels,verbs=get;list
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=arkconfigs,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;list;watch;patch
 // +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=impersonate
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 
 func (r *QueryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
@@ -121,9 +180,20 @@ func (r *QueryReconciler) handleQueryExecution(ctx context.Context, req ctrl.Req
 		}, nil
 	case statusEvaluating:
 		return r.handleEvaluationPhase(ctx, req, obj)
+	case statusWaitingOnTool:
+		return r.handleWaitingOnTool(ctx, obj)
 	case statusRunning:
 		return r.handleRunningPhase(ctx, req, obj)
 	default:
+		if result, intercepted := r.enforceExecutionWindow(ctx, &obj); intercepted {
+			return result, nil
+		}
+		if r.maintenanceMode(ctx, obj.Namespace) {
+			return r.holdForMaintenance(ctx, &obj)
+		}
+		if meta.RemoveStatusCondition(&obj.Status.Conditions, QueryConditionMaintenance) {
+			logf.FromContext(ctx).Info("maintenance mode lifted, admitting query", "name", obj.Name, "namespace", obj.Namespace)
+		}
 		if err := r.updateStatus(ctx, &obj, statusRunning); err != nil {
 			return ctrl.Result{
 				RequeueAfter: time.Until(expiry),
@@ -133,10 +203,35 @@ func (r *QueryReconciler) handleQueryExecution(ctx context.Context, req ctrl.Req
 	}
 }
 
+// maintenanceMode reports whether the namespace's ArkConfig holds new query
+// executions; in-flight executions are unaffected
+func (r *QueryReconciler) maintenanceMode(ctx context.Context, namespace string) bool {
+	settings := genai.GetControllerSettings(ctx, r.Client, namespace)
+	return settings != nil && settings.Maintenance
+}
+
+// holdForMaintenance keeps the query pending with a condition explaining why,
+// and requeues to re-check once the maintenance window ends
+func (r *QueryReconciler) holdForMaintenance(ctx context.Context, obj *arkv1alpha1.Query) (ctrl.Result, error) {
+	changed := meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+		Type:               QueryConditionMaintenance,
+		Status:             metav1.ConditionTrue,
+		Reason:             "MaintenanceMode",
+		Message:            "namespace is in maintenance mode, execution deferred",
+		ObservedGeneration: obj.Generation,
+	})
+	if changed || obj.Status.Phase != statusPending {
+		if err := r.updateStatus(ctx, obj, statusPending); err != nil {
+			return ctrl.Result{RequeueAfter: maintenanceRequeueInterval}, err
+		}
+	}
+	return ctrl.Result{RequeueAfter: maintenanceRequeueInterval}, nil
+}
+
 func (r *QueryReconciler) handleEvaluationPhase(ctx context.Context, req ctrl.Request, obj arkv1alpha1.Query) (ctrl.Result, error) {
 	r.cleanupExistingOperation(req.NamespacedName)
 	opCtx, cancel := context.WithCancel(ctx)
-	r.operations.Store(req.NamespacedName, cancel)
+	r.storeOperation(req.NamespacedName, obj, cancel)
 	recorder := genai.NewQueryRecorder(&obj, r.Recorder)
 	tokenCollector := genai.NewTokenUsageCollector(recorder)
 	go r.executeEvaluation(opCtx, obj, req.NamespacedName, tokenCollector)
@@ -152,7 +247,7 @@ func (r *QueryReconciler) handleRunningPhase(ctx context.Context, req ctrl.Reque
 	}
 
 	opCtx, cancel := context.WithCancel(ctx)
-	r.operations.Store(req.NamespacedName, cancel)
+	r.storeOperation(req.NamespacedName, obj, cancel)
 	recorder := genai.NewQueryRecorder(&obj, r.Recorder)
 	tokenCollector := genai.NewTokenUsageCollector(recorder)
 
@@ -179,26 +274,103 @@ func (r *QueryReconciler) executeQueryAsync(opCtx context.Context, obj arkv1alph
 		}
 	}()
 
+	releaseWorker, acquireErr := genai.DefaultWorkloadScheduler().AcquireInteractive(opCtx)
+	if acquireErr != nil {
+		queryTracker.Fail(acquireErr)
+		_ = r.updateStatus(opCtx, &obj, statusError)
+		r.notifyCompletion(opCtx, &obj)
+		return
+	}
+	defer releaseWorker()
+
 	// Start session-aware query tracing
-	sessionId := obj.Spec.SessionId
-	if sessionId == "" {
-		sessionId = string(obj.UID)
+	memoryCtx := memoryContextForQuery(obj)
+
+	tracer := telemetry.NewTraceContext()
+	opCtx, querySpan := tracer.StartQuery(opCtx, obj.Name, obj.Namespace, "execute", memoryCtx.SessionID)
+	defer querySpan.End()
+	obj.Status.TraceID = telemetry.TraceIDFromContext(opCtx)
+
+	opCtx, streamHealth := genai.WithStreamHealth(opCtx)
+	opCtx, routingRecorder := genai.WithRoutingRecorder(opCtx)
+	opCtx, compensationLog := genai.WithCompensationLog(opCtx)
+	opCtx = genai.WithThrottleNotifier(opCtx, r.throttleNotifier(opCtx, &obj))
+
+	overrides, overridesErr := genai.ParseQueryOverrides(obj.Annotations)
+	if overridesErr != nil {
+		queryTracker.Fail(overridesErr)
+		_ = r.updateStatus(opCtx, &obj, statusError)
+		r.notifyCompletion(opCtx, &obj)
+		return
+	}
+	if overrides != nil {
+		opCtx = genai.WithQueryOverrides(opCtx, overrides)
+		obj.Status.AppliedOverrides = overrides.Applied
 	}
 
-	impersonatedClient, memory, err := r.setupQueryExecution(opCtx, obj, queryTracker, tokenCollector, sessionId)
+	impersonatedClient, memory, err := r.setupQueryExecution(opCtx, obj, queryTracker, tokenCollector, memoryCtx)
 	if err != nil {
+		r.notifyCompletion(opCtx, &obj)
 		return
 	}
 
 	responses, eventStream, err := r.reconcileQueue(opCtx, obj, impersonatedClient, memory, tokenCollector)
+
+	flushErr := genai.FlushMemory(context.WithoutCancel(opCtx), memory)
+	if flushErr != nil {
+		log.Error(flushErr, "Failed to flush buffered memory writes")
+	}
+
 	if err != nil {
+		var pendingJob *ToolJobPendingError
+		if errors.As(err, &pendingJob) {
+			queryTracker.Complete("waiting-on-tool")
+			obj.Status.WaitingOnJob = pendingJob.JobName
+			_ = r.updateStatus(opCtx, &obj, statusWaitingOnTool)
+			return
+		}
 		queryTracker.Fail(err)
+		compensationLog.Run(context.WithoutCancel(opCtx), tokenCollector)
+		var unknownTarget *UnknownTargetTypeError
+		if errors.As(err, &unknownTarget) {
+			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+				Type:               QueryConditionInvalidTarget,
+				Status:             metav1.ConditionTrue,
+				Reason:             "UnknownTargetType",
+				Message:            err.Error(),
+				ObservedGeneration: obj.Generation,
+			})
+		}
+		var contentFiltered *genai.ContentFilterError
+		if errors.As(err, &contentFiltered) {
+			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+				Type:               QueryConditionContentFiltered,
+				Status:             metav1.ConditionTrue,
+				Reason:             "ProviderContentFilter",
+				Message:            contentFiltered.Error(),
+				ObservedGeneration: obj.Generation,
+			})
+		}
 		_ = r.updateStatus(opCtx, &obj, statusError)
+		r.notifyCompletion(opCtx, &obj)
+		return
+	}
+
+	if flushErr != nil {
+		queryTracker.Fail(flushErr)
+		compensationLog.Run(context.WithoutCancel(opCtx), tokenCollector)
+		_ = r.updateStatus(opCtx, &obj, statusError)
+		r.notifyCompletion(opCtx, &obj)
 		return
 	}
 
 	queryTracker.Complete("resolved")
+	meta.RemoveStatusCondition(&obj.Status.Conditions, QueryConditionThrottled)
+	responses = r.enforceMaxOutputTokens(opCtx, &obj, responses)
 	obj.Status.Responses = responses
+	if decisions := routingRecorder.Decisions(); len(decisions) > 0 {
+		obj.Status.Routing = decisions
+	}
 
 	tokenSummary := tokenCollector.GetTokenSummary()
 	obj.Status.TokenUsage = arkv1alpha1.TokenUsage{
@@ -207,13 +379,30 @@ func (r *QueryReconciler) executeQueryAsync(opCtx context.Context, obj arkv1alph
 		TotalTokens:      tokenSummary.TotalTokens,
 	}
 
+	signature, signErr := genai.SignQueryResponses(opCtx, r.Client, &obj)
+	if signErr != nil {
+		log.Error(signErr, "Failed to sign query responses")
+		_ = r.updateStatus(opCtx, &obj, statusError)
+		r.notifyCompletion(opCtx, &obj)
+		return
+	}
+	obj.Status.Signature = signature
+
 	evaluators, evalErr := r.resolveEvaluators(opCtx, obj, impersonatedClient)
 	if evalErr != nil {
 		log.Error(evalErr, "Failed to resolve evaluators")
 		_ = r.updateStatus(opCtx, &obj, statusError)
+		r.notifyCompletion(opCtx, &obj)
 		return
 	}
 
+	r.deliverResponses(opCtx, &obj)
+
+	evaluators, samplingDecision := sampleEvaluators(&obj, evaluators)
+	if samplingDecision != "" {
+		obj.Status.EvaluationSampling = samplingDecision
+	}
+
 	if len(evaluators) > 0 {
 		_ = r.updateStatus(opCtx, &obj, statusEvaluating)
 		cleanupCache = false
@@ -223,17 +412,49 @@ func (r *QueryReconciler) executeQueryAsync(opCtx context.Context, obj arkv1alph
 
 	duration := &metav1.Duration{Duration: time.Since(startTime)}
 	if len(evaluators) > 0 {
+		r.setDegradationConditions(&obj, streamHealth)
 		_ = r.updateStatusWithDuration(opCtx, &obj, statusEvaluating, duration)
 		cleanupCache = false
 	} else {
-		r.finalizeEventStream(opCtx, eventStream)
+		r.finalizeEventStream(opCtx, namespacedName, eventStream)
+		r.setDegradationConditions(&obj, streamHealth)
 		_ = r.updateStatusWithDuration(opCtx, &obj, statusDone, duration)
+		r.notifyCompletion(opCtx, &obj)
+	}
+}
+
+// SubscribeToQueryStream attaches a consumer to the live chunk stream of a
+// running query, returning a detach function. It reports false when the query
+// has no active stream
+func (r *QueryReconciler) SubscribeToQueryStream(namespacedName types.NamespacedName, consumer genai.EventStreamInterface) (func(), bool) {
+	value, exists := r.streams.Load(namespacedName)
+	if !exists {
+		return nil, false
+	}
+	broadcast, ok := value.(*genai.BroadcastEventStream)
+	if !ok {
+		return nil, false
+	}
+	return broadcast.Subscribe(consumer), true
+}
+
+// notifyCompletion delivers the result to the query's completion webhook once
+// it reaches a terminal phase. Delivery failures are reported as events but do
+// not change the query outcome
+func (r *QueryReconciler) notifyCompletion(ctx context.Context, query *arkv1alpha1.Query) {
+	if query.Spec.CompletionWebhook == nil {
+		return
+	}
+	if err := genai.NotifyQueryCompletion(ctx, r.Client, query); err != nil {
+		logf.FromContext(ctx).Error(err, "failed to deliver completion webhook", "query", query.Name)
+		r.Recorder.Event(query, corev1.EventTypeWarning, "CompletionWebhookFailed", err.Error())
 	}
 }
 
 // finalizeEventStream sends the completion message to the event stream and
 // closes its connection.
-func (r *QueryReconciler) finalizeEventStream(ctx context.Context, eventStream genai.EventStreamInterface) {
+func (r *QueryReconciler) finalizeEventStream(ctx context.Context, namespacedName types.NamespacedName, eventStream genai.EventStreamInterface) {
+	r.streams.Delete(namespacedName)
 	if eventStream == nil {
 		return
 	}
@@ -247,17 +468,107 @@ func (r *QueryReconciler) finalizeEventStream(ctx context.Context, eventStream g
 		// If we cannot close the event stream, log and error but don't
 		// fail - the final message will still be available in the
 		// query response.
+		genai.StreamHealthFromContext(ctx).RecordStreamError(completionErr)
 		log.Error(completionErr, "Failed to notify query completion to event stream")
 	}
 
 	// Close the event stream. If this fails, we log and error but don't
 	// fail the query, as the final message is still recorded.
 	if closeErr := eventStream.Close(); closeErr != nil {
+		genai.StreamHealthFromContext(ctx).RecordStreamError(closeErr)
 		log.Error(closeErr, "Failed to close event stream")
 	}
 }
 
-func (r *QueryReconciler) setupQueryExecution(opCtx context.Context, obj arkv1alpha1.Query, queryTracker *genai.OperationTracker, tokenCollector *genai.TokenUsageCollector, sessionId string) (client.Client, genai.MemoryInterface, error) {
+// setDegradationConditions surfaces streaming and memory-write failures that
+// degraded the query without failing it, so clients can detect a dead stream
+// or missing conversation records behind an otherwise successful query
+func (r *QueryReconciler) setDegradationConditions(query *arkv1alpha1.Query, health *genai.StreamHealth) {
+	if err := health.StreamError(); err != nil {
+		meta.SetStatusCondition(&query.Status.Conditions, metav1.Condition{
+			Type:               QueryConditionStreaming,
+			Status:             metav1.ConditionFalse,
+			Reason:             "ChunkDeliveryDegraded",
+			Message:            err.Error(),
+			ObservedGeneration: query.Generation,
+		})
+	} else {
+		meta.RemoveStatusCondition(&query.Status.Conditions, QueryConditionStreaming)
+	}
+
+	if err := health.MemoryWriteError(); err != nil {
+		meta.SetStatusCondition(&query.Status.Conditions, metav1.Condition{
+			Type:               QueryConditionMemoryWrite,
+			Status:             metav1.ConditionFalse,
+			Reason:             "WriteFailed",
+			Message:            err.Error(),
+			ObservedGeneration: query.Generation,
+		})
+	} else {
+		meta.RemoveStatusCondition(&query.Status.Conditions, QueryConditionMemoryWrite)
+	}
+}
+
+// deliverResponses posts the completed responses to the query's delivery
+// channels. Failures never fail the query; they are surfaced on the Delivery
+// condition and as warning events
+func (r *QueryReconciler) deliverResponses(ctx context.Context, query *arkv1alpha1.Query) {
+	if len(query.Spec.DeliverTo) == 0 {
+		return
+	}
+	if err := genai.DeliverQueryResponses(ctx, r.Client, query); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to deliver query responses")
+		r.Recorder.Event(query, corev1.EventTypeWarning, "DeliveryFailed", err.Error())
+		meta.SetStatusCondition(&query.Status.Conditions, metav1.Condition{
+			Type:               QueryConditionDelivery,
+			Status:             metav1.ConditionFalse,
+			Reason:             "DeliveryFailed",
+			Message:            err.Error(),
+			ObservedGeneration: query.Generation,
+		})
+		return
+	}
+	meta.SetStatusCondition(&query.Status.Conditions, metav1.Condition{
+		Type:               QueryConditionDelivery,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Delivered",
+		Message:            fmt.Sprintf("Responses delivered to %d channels", len(query.Spec.DeliverTo)),
+		ObservedGeneration: query.Generation,
+	})
+}
+
+// throttleNotifier surfaces model rate-limit waits on the query status so
+// operators can see why a running query is making no progress. Targets fan
+// out in parallel, so updates are serialized on a local mutex
+func (r *QueryReconciler) throttleNotifier(ctx context.Context, query *arkv1alpha1.Query) genai.ThrottleNotifier {
+	var mu sync.Mutex
+	return func(model string, wait time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		meta.SetStatusCondition(&query.Status.Conditions, metav1.Condition{
+			Type:               QueryConditionThrottled,
+			Status:             metav1.ConditionTrue,
+			Reason:             "ModelRateLimited",
+			Message:            fmt.Sprintf("waiting %s for model %s rate limit", wait.Round(time.Millisecond), model),
+			ObservedGeneration: query.Generation,
+		})
+		_ = r.updateStatus(ctx, query, statusRunning)
+	}
+}
+
+func memoryContextForQuery(query arkv1alpha1.Query) genai.MemoryContext {
+	sessionID := query.Spec.SessionId
+	if sessionID == "" {
+		sessionID = string(query.UID)
+	}
+	return genai.MemoryContext{
+		SessionID: sessionID,
+		QueryName: query.Name,
+		QueryID:   string(query.UID),
+	}
+}
+
+func (r *QueryReconciler) setupQueryExecution(opCtx context.Context, obj arkv1alpha1.Query, queryTracker *genai.OperationTracker, tokenCollector *genai.TokenUsageCollector, memoryCtx genai.MemoryContext) (client.Client, genai.MemoryInterface, error) {
 	impersonatedClient, err := r.getClientForQuery(obj)
 	if err != nil {
 		queryTracker.Fail(fmt.Errorf("failed to create impersonated client: %w", err))
@@ -265,8 +576,9 @@ func (r *QueryReconciler) setupQueryExecution(opCtx context.Context, obj arkv1al
 		return nil, nil, err
 	}
 
-	memory, err := genai.NewMemoryForQuery(opCtx, impersonatedClient, obj.Spec.Memory, obj.Namespace, tokenCollector, sessionId, obj.Name)
+	memory, err := genai.NewMemoryForQuery(opCtx, impersonatedClient, obj.Spec.Memory, obj.Namespace, tokenCollector, memoryCtx, obj.Spec.Input)
 	if err != nil {
+		r.Recorder.Event(&obj, corev1.EventTypeWarning, "MemoryUnavailable", err.Error())
 		queryTracker.Fail(fmt.Errorf("failed to create memory client: %w", err))
 		_ = r.updateStatus(opCtx, &obj, statusError)
 		return nil, nil, err
@@ -288,7 +600,144 @@ func (r *QueryReconciler) resolveTargets(ctx context.Context, query arkv1alpha1.
 		allTargets = append(allTargets, targets...)
 	}
 
-	return allTargets, nil
+	if len(allTargets) == 0 {
+		targets, err := r.resolveDefaultTargets(ctx, query.Namespace, impersonatedClient)
+		if err != nil {
+			return nil, err
+		}
+		allTargets = append(allTargets, targets...)
+	}
+
+	allTargets, err := r.resolveRouterTargets(ctx, query, allTargets, impersonatedClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.applyCanaryRouting(ctx, query, allTargets), nil
+}
+
+// resolveRouterTargets replaces router targets with the per-language target
+// chosen by the router's rules, recording each decision for the query status
+func (r *QueryReconciler) resolveRouterTargets(ctx context.Context, query arkv1alpha1.Query, targets []arkv1alpha1.QueryTarget, impersonatedClient client.Client) ([]arkv1alpha1.QueryTarget, error) {
+	resolved := make([]arkv1alpha1.QueryTarget, 0, len(targets))
+	for _, target := range targets {
+		if target.Type != "router" {
+			resolved = append(resolved, target)
+			continue
+		}
+
+		var router arkv1alpha1.Router
+		if err := impersonatedClient.Get(ctx, client.ObjectKey{Name: target.Name, Namespace: query.Namespace}, &router); err != nil {
+			return nil, fmt.Errorf("failed to get router %s: %w", target.Name, err)
+		}
+
+		routed, language, err := routeByLanguage(router, query.Spec.Input)
+		if err != nil {
+			return nil, err
+		}
+		genai.RoutingRecorderFromContext(ctx).Record(arkv1alpha1.RoutingDecision{
+			Router:   router.Name,
+			Language: language,
+			Target:   *routed,
+		})
+		resolved = append(resolved, *routed)
+	}
+	return resolved, nil
+}
+
+// routeByLanguage picks the rule matching the input's detected language,
+// falling back to the router's default target when detection is inconclusive
+// or unmapped
+func routeByLanguage(router arkv1alpha1.Router, input string) (*arkv1alpha1.QueryTarget, string, error) {
+	language := genai.DetectLanguage(input)
+	if language != "" {
+		for _, rule := range router.Spec.Rules {
+			for _, code := range rule.Languages {
+				if strings.EqualFold(code, language) {
+					target := rule.Target
+					return &target, language, nil
+				}
+			}
+		}
+	}
+	if router.Spec.DefaultTarget != nil {
+		target := *router.Spec.DefaultTarget
+		return &target, language, nil
+	}
+	return nil, language, fmt.Errorf("router %s has no rule for language %q and no defaultTarget", router.Name, language)
+}
+
+// applyCanaryRouting reroutes the configured percentage of agent targets to
+// their progressing canary revision, marking routed queries with an
+// annotation so the rollout controller can attribute outcomes
+func (r *QueryReconciler) applyCanaryRouting(ctx context.Context, query arkv1alpha1.Query, targets []arkv1alpha1.QueryTarget) []arkv1alpha1.QueryTarget {
+	var rollouts arkv1alpha1.AgentRolloutList
+	if err := r.List(ctx, &rollouts, client.InNamespace(query.Namespace)); err != nil || len(rollouts.Items) == 0 {
+		return targets
+	}
+
+	canaries := map[string]*arkv1alpha1.AgentRollout{}
+	for i, rollout := range rollouts.Items {
+		if rollout.Status.Phase == rolloutPhaseProgressing && rollout.Status.CanaryAgent != "" {
+			canaries[rollout.Spec.Agent] = &rollouts.Items[i]
+		}
+	}
+	if len(canaries) == 0 {
+		return targets
+	}
+
+	var routedCanary string
+	for i, target := range targets {
+		if target.Type != "agent" {
+			continue
+		}
+		rollout, ok := canaries[target.Name]
+		if !ok || rand.Intn(100) >= canaryPercent(rollout) { //nolint:gosec
+			continue
+		}
+		targets[i].Name = rollout.Status.CanaryAgent
+		routedCanary = rollout.Status.CanaryAgent
+	}
+
+	if routedCanary != "" {
+		r.markCanaryQuery(ctx, query, routedCanary)
+	}
+	return targets
+}
+
+// markCanaryQuery annotates the query with the canary that served it;
+// attribution is best-effort and must not fail the query
+func (r *QueryReconciler) markCanaryQuery(ctx context.Context, query arkv1alpha1.Query, canaryAgent string) {
+	patched := query.DeepCopy()
+	base := client.MergeFrom(query.DeepCopy())
+	if patched.Annotations == nil {
+		patched.Annotations = map[string]string{}
+	}
+	patched.Annotations[annotations.CanaryAgent] = canaryAgent
+	if err := r.Patch(ctx, patched, base); err != nil {
+		logf.FromContext(ctx).V(1).Info("failed to annotate canary query", "query", query.Name, "error", err.Error())
+	}
+}
+
+// resolveDefaultTargets falls back to the namespace's ArkConfig default agent
+// or model when a query specifies no targets.
+func (r *QueryReconciler) resolveDefaultTargets(ctx context.Context, namespace string, impersonatedClient client.Client) ([]arkv1alpha1.QueryTarget, error) {
+	var config arkv1alpha1.ArkConfig
+	key := types.NamespacedName{Name: "default", Namespace: namespace}
+	if err := impersonatedClient.Get(ctx, key, &config); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get ArkConfig %v: %w", key, err)
+	}
+
+	if config.Spec.DefaultAgent != "" {
+		return []arkv1alpha1.QueryTarget{{Type: "agent", Name: config.Spec.DefaultAgent}}, nil
+	}
+	if config.Spec.DefaultModel != "" {
+		return []arkv1alpha1.QueryTarget{{Type: "model", Name: config.Spec.DefaultModel}}, nil
+	}
+	return nil, nil
 }
 
 func (r *QueryReconciler) resolveSelector(ctx context.Context, selector *metav1.LabelSelector, namespace string, impersonatedClient client.Client) ([]arkv1alpha1.QueryTarget, error) {
@@ -412,13 +861,8 @@ func (r *QueryReconciler) reconcileQueue(ctx context.Context, query arkv1alpha1.
 	// Create event stream if streaming is requested
 	var eventStream genai.EventStreamInterface
 	if genai.IsStreamingEnabled(query) {
-		sessionId := query.Spec.SessionId
-		if sessionId == "" {
-			sessionId = string(query.UID)
-		}
-
 		var err error
-		eventStream, err = genai.NewEventStreamForQuery(ctx, r.Client, query.Namespace, sessionId, query.Name)
+		eventStream, err = genai.NewEventStreamForQuery(ctx, r.Client, query.Namespace, memoryContextForQuery(query))
 		if err != nil {
 			// Configuration error - fail the query
 			return nil, nil, fmt.Errorf("streaming configuration error: %w", err)
@@ -426,11 +870,25 @@ func (r *QueryReconciler) reconcileQueue(ctx context.Context, query arkv1alpha1.
 
 		if eventStream == nil {
 			// No streaming service configured - just warn
+			genai.StreamHealthFromContext(ctx).RecordStreamError(fmt.Errorf("streaming requested but no streaming service configured"))
 			logf.FromContext(ctx).Info("Streaming requested but no streaming service configured",
 				"query", query.Name,
 				"namespace", query.Namespace)
+		} else {
+			broadcast := genai.NewBroadcastEventStream()
+			broadcast.Subscribe(eventStream)
+			r.streams.Store(types.NamespacedName{Name: query.Name, Namespace: query.Namespace}, broadcast)
+			eventStream = broadcast
 		}
 	}
+	if query.Spec.Hedging != nil {
+		response, err := r.executeHedgedTargets(ctx, query, impersonatedClient, memory, eventStream, tokenCollector)
+		if err != nil {
+			return nil, eventStream, err
+		}
+		return []arkv1alpha1.Response{*response}, eventStream, nil
+	}
+
 	targets, err := r.resolveTargets(ctx, query, impersonatedClient)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to resolve targets: %w", err)
@@ -444,8 +902,22 @@ func (r *QueryReconciler) reconcileQueue(ctx context.Context, query arkv1alpha1.
 		wg.Add(1)
 		go func(target arkv1alpha1.QueryTarget) {
 			defer wg.Done()
-			responses, err := r.executeTarget(ctx, query, target, impersonatedClient, memory, eventStream, tokenCollector)
-			resultChan <- targetResult{responses, err, target}
+			targetCtx, citationTracker := genai.WithCitationTracker(ctx)
+			targetCtx, timingTracker := genai.WithTimingTracker(targetCtx)
+			targetCtx, alternativesTracker := genai.WithAlternativesTracker(targetCtx)
+			targetCtx, servedByTracker := genai.WithServedByTracker(targetCtx)
+			targetCtx, secretRedactor := genai.WithSecretRedactor(targetCtx)
+			targetMemory := memory
+			if query.Spec.ThreadScope == arkv1alpha1.ThreadScopePerTarget && memory != nil {
+				targetMemory = genai.ScopeMemoryToThread(memory, target.Type+"/"+target.Name)
+			}
+			responses, err := r.executeTargetWithRetry(targetCtx, query, target, impersonatedClient, targetMemory, targetEventStream(target, eventStream), tokenCollector)
+			if targetMemory != nil && targetMemory != memory {
+				if flushErr := genai.FlushMemory(context.WithoutCancel(targetCtx), targetMemory); flushErr != nil && err == nil {
+					err = flushErr
+				}
+			}
+			resultChan <- targetResult{responses, citationTracker.Citations(), alternativesTracker.Alternatives(), servedByTracker.Model(), timingTracker.Summary(), secretRedactor, err, target}
 		}(target)
 	}
 
@@ -458,18 +930,68 @@ func (r *QueryReconciler) reconcileQueue(ctx context.Context, query arkv1alpha1.
 		}
 		// Skip targets that were delegated to external execution engines (messages == nil)
 		if result.messages != nil {
-			rawBytes, _ := json.Marshal(result.messages) // full original message array
-			allResponses = append(allResponses, arkv1alpha1.Response{
-				Target:  result.target,
-				Content: messageToText(result.messages[len(result.messages)-1]), // Get last message explicitly
-				Raw:     string(rawBytes),
-			})
+			allResponses = append(allResponses, *buildTargetResponse(result))
 		}
 	}
 
 	return allResponses, eventStream, nil
 }
 
+// buildTargetResponse assembles the status response for a completed target,
+// with secret-sourced values redacted
+func buildTargetResponse(result targetResult) *arkv1alpha1.Response {
+	rawBytes, _ := json.Marshal(result.messages) // full original message array
+	response := &arkv1alpha1.Response{
+		Target:       result.target,
+		Content:      result.redactor.Redact(messageToText(result.messages[len(result.messages)-1])), // Get last message explicitly
+		Raw:          result.redactor.Redact(string(rawBytes)),
+		Citations:    result.citations,
+		Alternatives: result.alternatives,
+		ServedBy:     result.servedBy,
+		Duration:     &metav1.Duration{Duration: result.timing.Duration},
+	}
+	if result.timing.TimeToFirstToken > 0 {
+		response.TimeToFirstToken = &metav1.Duration{Duration: result.timing.TimeToFirstToken}
+	}
+	if result.timing.ModelDuration > 0 {
+		response.ModelDuration = &metav1.Duration{Duration: result.timing.ModelDuration}
+	}
+	if result.timing.ToolDuration > 0 {
+		response.ToolDuration = &metav1.Duration{Duration: result.timing.ToolDuration}
+	}
+	return response
+}
+
+// enforceMaxOutputTokens applies the query's post-hoc response cap, covering
+// providers that ignore or only loosely honor the output token limit. Each
+// truncation is surfaced as a ResponseTruncated warning event
+func (r *QueryReconciler) enforceMaxOutputTokens(ctx context.Context, query *arkv1alpha1.Query, responses []arkv1alpha1.Response) []arkv1alpha1.Response {
+	limit := query.Spec.MaxOutputTokens
+	if limit == nil {
+		return responses
+	}
+	for i := range responses {
+		truncated, changed := genai.TruncateToTokenLimit(responses[i].Content, *limit)
+		if !changed {
+			continue
+		}
+		responses[i].Content = truncated
+		message := fmt.Sprintf("response for target %s/%s truncated to approximately %d tokens", responses[i].Target.Type, responses[i].Target.Name, *limit)
+		logf.FromContext(ctx).Info("response truncated by query output token cap", "target", responses[i].Target.Name, "maxOutputTokens", *limit)
+		r.Recorder.Event(query, corev1.EventTypeWarning, "ResponseTruncated", message)
+	}
+	return responses
+}
+
+// targetEventStream honors a target's stream override: false withholds the
+// query event stream so the target runs without forwarding chunks
+func targetEventStream(target arkv1alpha1.QueryTarget, eventStream genai.EventStreamInterface) genai.EventStreamInterface {
+	if target.Stream != nil && !*target.Stream {
+		return nil
+	}
+	return eventStream
+}
+
 // messageToText extracts text content from a single OpenAI message format structure.
 // This function assumes the message follows OpenAI's ChatCompletionMessageParamUnion format.
 func messageToText(message genai.Message) string {
@@ -505,6 +1027,9 @@ func (r *QueryReconciler) updateStatusWithDuration(ctx context.Context, query *a
 	if err != nil {
 		logf.FromContext(ctx).Error(err, "failed to update query status", "status", status)
 	}
+	if status == statusDone || status == statusError || status == statusCanceled {
+		r.Archive.Record(query)
+	}
 	return err
 }
 
@@ -513,15 +1038,53 @@ func (r *QueryReconciler) finalize(ctx context.Context, query *arkv1alpha1.Query
 	log.Info("finalizing query", "name", query.Name, "namespace", query.Namespace)
 
 	nsName := types.NamespacedName{Name: query.Name, Namespace: query.Namespace}
-	if cancel, exists := r.operations.Load(nsName); exists {
-		if cancelFunc, ok := cancel.(context.CancelFunc); ok {
-			cancelFunc()
+	if value, exists := r.operations.Load(nsName); exists {
+		if op, ok := value.(*queryOperation); ok {
+			op.cancel()
 		}
 		r.operations.Delete(nsName)
 		log.Info("cancelled running operation for query", "name", query.Name, "namespace", query.Namespace)
 	}
 }
 
+// executeTargetWithRetry re-runs a failed target per the query retry policy,
+// waiting the configured backoff between attempts. Pending async tool jobs
+// and cancellations pass through without retrying
+func (r *QueryReconciler) executeTargetWithRetry(ctx context.Context, query arkv1alpha1.Query, target arkv1alpha1.QueryTarget, impersonatedClient client.Client, memory genai.MemoryInterface, eventStream genai.EventStreamInterface, tokenCollector *genai.TokenUsageCollector) ([]genai.Message, error) {
+	attempts := 1
+	var backoff time.Duration
+	if retry := query.Spec.Retry; retry != nil {
+		attempts = retry.MaxAttempts
+		if retry.Backoff != nil {
+			backoff = retry.Backoff.Duration
+		}
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		messages, err := r.executeTarget(ctx, query, target, impersonatedClient, memory, eventStream, tokenCollector)
+		if err == nil {
+			return messages, nil
+		}
+		var pendingJob *ToolJobPendingError
+		if errors.As(err, &pendingJob) || ctx.Err() != nil {
+			return nil, err
+		}
+		lastErr = err
+		if attempt == attempts {
+			break
+		}
+		logf.FromContext(ctx).Info("retrying failed target", "target", target.Name, "attempt", attempt, "maxAttempts", attempts, "error", err.Error())
+		r.Recorder.Event(&query, corev1.EventTypeWarning, "TargetRetried", fmt.Sprintf("target %s/%s failed on attempt %d of %d: %v", target.Type, target.Name, attempt, attempts, err))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+	return nil, lastErr
+}
+
 func (r *QueryReconciler) executeTarget(ctx context.Context, query arkv1alpha1.Query, target arkv1alpha1.QueryTarget, impersonatedClient client.Client, memory genai.MemoryInterface, eventStream genai.EventStreamInterface, tokenCollector *genai.TokenUsageCollector) ([]genai.Message, error) {
 	// Store query in context for access in deeper call stacks
 	ctx = context.WithValue(ctx, genai.QueryContextKey, &query)
@@ -547,7 +1110,28 @@ func (r *QueryReconciler) executeTarget(ctx context.Context, query arkv1alpha1.Q
 		"target": targetString,
 	})
 
+	if labels := genai.CollectChargebackLabels(genai.GetArkConfig(ctx, impersonatedClient, query.Namespace), &query); len(labels) > 0 {
+		ctx = genai.WithChargebackLabels(ctx, labels)
+	}
+
+	if user := genai.ResolveUserIdentity(&query); user != "" {
+		ctx = genai.WithUserIdentity(ctx, user)
+	}
+
+	if query.Spec.ToolChoice != "" {
+		ctx = genai.WithToolChoiceOverride(ctx, query.Spec.ToolChoice)
+	}
+
+	ctx = genai.WithToolOverrides(ctx, query.Spec.ToolOverrides)
+
+	if query.Spec.MaxOutputTokens != nil {
+		ctx = genai.WithMaxOutputTokensOverride(ctx, *query.Spec.MaxOutputTokens)
+	}
+
 	timeout := 5 * time.Minute
+	if settings := genai.GetControllerSettings(ctx, impersonatedClient, query.Namespace); settings != nil && settings.DefaultQueryTimeout != nil {
+		timeout = settings.DefaultQueryTimeout.Duration
+	}
 	if query.Spec.Timeout != nil {
 		timeout = query.Spec.Timeout.Duration
 	}
@@ -557,20 +1141,16 @@ func (r *QueryReconciler) executeTarget(ctx context.Context, query arkv1alpha1.Q
 	var messages []genai.Message
 	var err error
 
-	switch target.Type {
-	case "agent":
-		messages, err = r.executeAgent(execCtx, query, target.Name, impersonatedClient, memory, eventStream, tokenCollector)
-	case "team":
-		messages, err = r.executeTeam(execCtx, query, target.Name, impersonatedClient, memory, eventStream, tokenCollector)
-	case "model":
-		messages, err = r.executeModel(execCtx, query, target.Name, impersonatedClient, memory, eventStream, tokenCollector)
-	case "tool":
-		messages, err = r.executeTool(execCtx, query, target.Name, impersonatedClient, tokenCollector)
-	default:
-		panic(fmt.Errorf("unknown query target type:%s", target.Type))
+	if executor, ok := lookupTargetExecutor(target.Type); ok {
+		messages, err = executor(r, execCtx, query, target.Name, impersonatedClient, memory, eventStream, tokenCollector)
+	} else {
+		err = &UnknownTargetTypeError{Type: target.Type}
 	}
 
 	metadata := map[string]string{"targetType": target.Type, "targetName": target.Name}
+	if user := genai.UserIdentityFromContext(ctx); user != "" {
+		metadata["user"] = user
+	}
 
 	if err != nil {
 		telemetry.RecordError(span, err)
@@ -596,6 +1176,24 @@ func (r *QueryReconciler) executeTarget(ctx context.Context, query arkv1alpha1.Q
 	return messages, err
 }
 
+// resolveQueryMessages returns the prior conversation and the user message
+// for a query, from spec.messages when provided or the templated spec.input
+func resolveQueryMessages(ctx context.Context, impersonatedClient client.Client, query arkv1alpha1.Query) ([]genai.Message, genai.Message, error) {
+	if len(query.Spec.Messages) > 0 {
+		parsed, err := genai.ParseQueryMessages(query.Spec.Messages)
+		if err != nil {
+			return nil, genai.Message{}, fmt.Errorf("failed to parse query messages: %w", err)
+		}
+		return parsed[:len(parsed)-1], parsed[len(parsed)-1], nil
+	}
+
+	resolvedInput, err := genai.ResolveQueryInput(ctx, impersonatedClient, query.Namespace, query.Spec.Input, query.Spec.Parameters)
+	if err != nil {
+		return nil, genai.Message{}, fmt.Errorf("failed to resolve query input: %w", err)
+	}
+	return nil, genai.NewUserMessage(resolvedInput), nil
+}
+
 func (r *QueryReconciler) executeAgent(ctx context.Context, query arkv1alpha1.Query, agentName string, impersonatedClient client.Client, memory genai.MemoryInterface, eventStream genai.EventStreamInterface, tokenCollector *genai.TokenUsageCollector) ([]genai.Message, error) {
 	var agentCRD arkv1alpha1.Agent
 	agentKey := types.NamespacedName{Name: agentName, Namespace: query.Namespace}
@@ -621,27 +1219,42 @@ func (r *QueryReconciler) executeAgent(ctx context.Context, query arkv1alpha1.Qu
 		return nil, fmt.Errorf("unable to load initial messages: %w", err)
 	}
 
-	resolvedInput, err := genai.ResolveQueryInput(ctx, impersonatedClient, query.Namespace, query.Spec.Input, query.Spec.Parameters)
+	priorMessages, userMessage, err := resolveQueryMessages(ctx, impersonatedClient, query)
 	if err != nil {
-		return nil, fmt.Errorf("failed to resolve query input: %w", err)
+		return nil, err
 	}
+	messages = append(messages, priorMessages...)
 
-	userMessage := genai.NewUserMessage(resolvedInput)
+	// Save the input messages up front so mid-query readers see them ahead of
+	// incrementally written tool rounds
+	inputMessages := append(append([]genai.Message{}, priorMessages...), userMessage)
+	if err := memory.AddMessages(ctx, inputMessages); err != nil {
+		return nil, fmt.Errorf("failed to save query messages to memory: %w", err)
+	}
 
-	responseMessages, err := agent.Execute(ctx, userMessage, messages, memory, eventStream)
+	execCtx, writes := genai.WithIncrementalWriteTracker(ctx)
+	responseMessages, err := agent.Execute(execCtx, userMessage, messages, memory, eventStream)
 	if err != nil {
 		return nil, err
 	}
 
-	// Save new messages to memory (user message + response messages)
-	newMessages := append([]genai.Message{userMessage}, responseMessages...)
-	if err := memory.AddMessages(ctx, query.Name, newMessages); err != nil {
+	// Save whatever was not already persisted during execution
+	if err := memory.AddMessages(ctx, remainingMessages(responseMessages, writes.Persisted())); err != nil {
 		return nil, fmt.Errorf("failed to save new messages to memory: %w", err)
 	}
 
 	return responseMessages, nil
 }
 
+// remainingMessages returns the response messages not yet written to memory
+// by incremental tool-round persistence
+func remainingMessages(responseMessages []genai.Message, persisted int) []genai.Message {
+	if persisted >= len(responseMessages) {
+		return nil
+	}
+	return responseMessages[persisted:]
+}
+
 func (r *QueryReconciler) executeTeam(ctx context.Context, query arkv1alpha1.Query, teamName string, impersonatedClient client.Client, memory genai.MemoryInterface, eventStream genai.EventStreamInterface, tokenCollector *genai.TokenUsageCollector) ([]genai.Message, error) {
 	var teamCRD arkv1alpha1.Team
 	teamKey := types.NamespacedName{Name: teamName, Namespace: query.Namespace}
@@ -660,22 +1273,20 @@ func (r *QueryReconciler) executeTeam(ctx context.Context, query arkv1alpha1.Que
 		return nil, fmt.Errorf("unable to load initial messages: %w", err)
 	}
 
-	// Resolve query input with template parameters
-	resolvedInput, err := genai.ResolveQueryInput(ctx, impersonatedClient, query.Namespace, query.Spec.Input, query.Spec.Parameters)
+	priorMessages, userMessage, err := resolveQueryMessages(ctx, impersonatedClient, query)
 	if err != nil {
-		return nil, fmt.Errorf("failed to resolve query input: %w", err)
+		return nil, err
 	}
-
-	userMessage := genai.NewUserMessage(resolvedInput)
+	messages = append(messages, priorMessages...)
 
 	responseMessages, err := team.Execute(ctx, userMessage, messages, memory, eventStream)
 	if err != nil {
 		return nil, err
 	}
 
-	// Save new messages to memory (user message + response messages)
-	newMessages := append([]genai.Message{userMessage}, responseMessages...)
-	if err := memory.AddMessages(ctx, query.Name, newMessages); err != nil {
+	// Save new messages to memory (prior + user message + response messages)
+	newMessages := append(append(append([]genai.Message{}, priorMessages...), userMessage), responseMessages...)
+	if err := memory.AddMessages(ctx, newMessages); err != nil {
 		return nil, fmt.Errorf("failed to save new messages to memory: %w", err)
 	}
 
@@ -700,18 +1311,25 @@ func (r *QueryReconciler) executeModel(ctx context.Context, query arkv1alpha1.Qu
 		return nil, fmt.Errorf("unable to load initial messages: %w", err)
 	}
 
-	// Resolve query input with template parameters
-	resolvedInput, err := genai.ResolveQueryInput(ctx, impersonatedClient, query.Namespace, query.Spec.Input, query.Spec.Parameters)
-	if err != nil {
-		return nil, fmt.Errorf("failed to resolve query input: %w", err)
+	if prompt, ok := genai.PinnedSystemPrompt(ctx, memory); ok && !hasSystemMessage(messages) {
+		messages = append([]genai.Message{genai.NewSystemMessage(prompt)}, messages...)
 	}
 
-	userMessage := genai.NewUserMessage(resolvedInput)
+	priorMessages, userMessage, err := resolveQueryMessages(ctx, impersonatedClient, query)
+	if err != nil {
+		return nil, err
+	}
+	messages = append(messages, priorMessages...)
 
 	// Append user message to conversation history
 	messages = append(messages, userMessage)
 	allMessages := messages
 
+	model, err = genai.EnsureContextFits(ctx, impersonatedClient, model, allMessages, query.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("context window check failed for model %v: %w", modelKey, err)
+	}
+
 	// Create operation tracker for the model call
 	modelTracker := genai.NewOperationTracker(tokenCollector, ctx, "ModelCall", modelName, map[string]string{
 		"model":     modelName,
@@ -753,9 +1371,9 @@ func (r *QueryReconciler) executeModel(ctx context.Context, query arkv1alpha1.Qu
 		responseMessages = []genai.Message{assistantMessage}
 	}
 
-	// Save new messages to memory (user message + response messages)
-	newMessages := append([]genai.Message{userMessage}, responseMessages...)
-	if err := memory.AddMessages(ctx, query.Name, newMessages); err != nil {
+	// Save new messages to memory (prior + user message + response messages)
+	newMessages := append(append(append([]genai.Message{}, priorMessages...), userMessage), responseMessages...)
+	if err := memory.AddMessages(ctx, newMessages); err != nil {
 		return nil, fmt.Errorf("failed to save new messages to memory: %w", err)
 	}
 
@@ -786,6 +1404,14 @@ func (r *QueryReconciler) executeTool(ctx context.Context, query arkv1alpha1.Que
 		toolArgs = map[string]any{"input": resolvedInput}
 	}
 
+	if toolCRD.Spec.Type == arkv1alpha1.ToolTypeJob {
+		jobName, err := r.launchToolJob(ctx, query, &toolCRD, toolArgs)
+		if err != nil {
+			return nil, err
+		}
+		return nil, &ToolJobPendingError{JobName: jobName}
+	}
+
 	// Create tool call using proper openai types
 	toolCall := genai.ToolCall{
 		ID: "tool-call-" + toolName,
@@ -819,11 +1445,41 @@ func (r *QueryReconciler) executeTool(ctx context.Context, query arkv1alpha1.Que
 		return nil, fmt.Errorf("tool execution failed: %w", err)
 	}
 
-	// Create response message with tool result
-	assistantMessage := genai.NewAssistantMessage(result.Content)
-	responseMessages := []genai.Message{assistantMessage}
+	return toolResponseMessages(query.Spec.ToolResponseFormat, toolCall, toolArgs, result.Content)
+}
 
-	return responseMessages, nil
+// toolResponseMessages shapes the tool output according to the query's
+// toolResponseFormat, so downstream consumers can opt into tool-role
+// semantics or a machine-readable JSON response instead of a plain
+// assistant message
+func toolResponseMessages(format string, toolCall genai.ToolCall, toolArgs map[string]any, content string) ([]genai.Message, error) {
+	switch format {
+	case arkv1alpha1.ToolResponseFormatTool:
+		assistantCall := genai.Message{
+			OfAssistant: &openai.ChatCompletionAssistantMessageParam{
+				ToolCalls: []openai.ChatCompletionMessageToolCallParam{{
+					ID: toolCall.ID,
+					Function: openai.ChatCompletionMessageToolCallFunctionParam{
+						Name:      toolCall.Function.Name,
+						Arguments: toolCall.Function.Arguments,
+					},
+				}},
+			},
+		}
+		return []genai.Message{assistantCall, genai.ToolMessage(content, toolCall.ID)}, nil
+	case arkv1alpha1.ToolResponseFormatJSON:
+		structured, err := json.Marshal(map[string]any{
+			"tool":      toolCall.Function.Name,
+			"arguments": toolArgs,
+			"output":    content,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal structured tool response: %w", err)
+		}
+		return []genai.Message{genai.NewAssistantMessage(string(structured))}, nil
+	default:
+		return []genai.Message{genai.NewAssistantMessage(content)}, nil
+	}
 }
 
 func mustMarshalJSON(v any) string {
@@ -834,6 +1490,15 @@ func mustMarshalJSON(v any) string {
 	return string(data)
 }
 
+func hasSystemMessage(messages []genai.Message) bool {
+	for _, message := range messages {
+		if message.OfSystem != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *QueryReconciler) loadInitialMessages(ctx context.Context, memory genai.MemoryInterface) ([]genai.Message, error) {
 	messages, err := memory.GetMessages(ctx)
 	if err != nil {
@@ -878,8 +1543,8 @@ func (r *QueryReconciler) getClientForQuery(query arkv1alpha1.Query) (client.Cli
 func (r *QueryReconciler) cleanupExistingOperation(namespacedName types.NamespacedName) {
 	if existingOp, exists := r.operations.Load(namespacedName); exists {
 		logf.Log.Info("Found existing operation, clearing due to cancel", "query", namespacedName.String())
-		if cancel, ok := existingOp.(context.CancelFunc); ok {
-			cancel()
+		if op, ok := existingOp.(*queryOperation); ok {
+			op.cancel()
 		}
 		r.operations.Delete(namespacedName)
 	} else {
@@ -887,6 +1552,62 @@ func (r *QueryReconciler) cleanupExistingOperation(namespacedName types.Namespac
 	}
 }
 
+// storeOperation records a running execution with its cancel function and
+// the deadline after which the janitor may evict it
+func (r *QueryReconciler) storeOperation(namespacedName types.NamespacedName, obj arkv1alpha1.Query, cancel context.CancelFunc) {
+	timeout := defaultOperationTimeout
+	if obj.Spec.Timeout != nil {
+		timeout = obj.Spec.Timeout.Duration
+	}
+	now := time.Now()
+	r.operations.Store(namespacedName, &queryOperation{
+		cancel:   cancel,
+		started:  now,
+		deadline: now.Add(timeout + operationEvictionGrace),
+	})
+}
+
+// runOperationJanitor periodically evicts operations whose goroutines exited
+// abnormally, so handleRunningPhase does not see them as running forever
+func (r *QueryReconciler) runOperationJanitor(ctx context.Context) error {
+	ticker := time.NewTicker(operationJanitorInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			r.evictStaleOperations()
+		}
+	}
+}
+
+func (r *QueryReconciler) evictStaleOperations() {
+	now := time.Now()
+	r.operations.Range(func(key, value any) bool {
+		op, ok := value.(*queryOperation)
+		if !ok {
+			r.operations.Delete(key)
+			return true
+		}
+		if now.After(op.deadline) {
+			logf.Log.Info("Evicting stale query operation", "query", key, "started", op.started)
+			op.cancel()
+			r.operations.Delete(key)
+		}
+		return true
+	})
+}
+
+func (r *QueryReconciler) inFlightOperations() int64 {
+	var count int64
+	r.operations.Range(func(key, value any) bool {
+		count++
+		return true
+	})
+	return count
+}
+
 func (r *QueryReconciler) executeEvaluation(ctx context.Context, obj arkv1alpha1.Query, namespacedName types.NamespacedName, tokenCollector *genai.TokenUsageCollector) {
 	log := logf.FromContext(ctx)
 	defer func() {
@@ -898,6 +1619,16 @@ func (r *QueryReconciler) executeEvaluation(ctx context.Context, obj arkv1alpha1
 
 	startTime := time.Now()
 
+	releaseWorker, acquireErr := genai.DefaultWorkloadScheduler().AcquireBackground(ctx)
+	if acquireErr != nil {
+		log.Error(acquireErr, "Evaluation canceled while waiting for a worker")
+		if updateErr := r.updateStatus(ctx, &obj, statusError); updateErr != nil {
+			log.Error(updateErr, "Failed to update status")
+		}
+		return
+	}
+	defer releaseWorker()
+
 	impersonatedClient, err := r.getClientForQuery(obj)
 	if err != nil {
 		log.Error(err, "Failed to create impersonated client for evaluation", "duration", time.Since(startTime))
@@ -915,6 +1646,7 @@ func (r *QueryReconciler) executeEvaluation(ctx context.Context, obj arkv1alpha1
 		}
 		return
 	}
+	evaluators, _ = sampleEvaluators(&obj, evaluators)
 
 	evaluationResults, err := genai.CallEvaluators(ctx, impersonatedClient, obj, evaluators, tokenCollector)
 	duration := time.Since(startTime)
@@ -926,11 +1658,33 @@ func (r *QueryReconciler) executeEvaluation(ctx context.Context, obj arkv1alpha1
 		}
 	} else {
 		obj.Status.Evaluations = evaluationResults
+		setEvaluationConditions(&obj, evaluationResults)
 
 		if updateErr := r.updateStatus(ctx, &obj, statusDone); updateErr != nil {
 			log.Error(updateErr, "Failed to update status")
 		}
 	}
+	r.notifyCompletion(ctx, &obj)
+}
+
+// setEvaluationConditions records a per-evaluator condition, so a single
+// failing evaluator is visible without voiding the other evaluators' scores
+func setEvaluationConditions(query *arkv1alpha1.Query, results []arkv1alpha1.EvaluationResult) {
+	for _, result := range results {
+		condition := metav1.Condition{
+			Type:               "Evaluation-" + result.EvaluatorName,
+			Status:             metav1.ConditionTrue,
+			Reason:             "EvaluationSucceeded",
+			Message:            fmt.Sprintf("score: %s, passed: %t", result.Score, result.Passed),
+			ObservedGeneration: query.Generation,
+		}
+		if evalErr, failed := result.Metadata["error"]; failed {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "EvaluationFailed"
+			condition.Message = evalErr
+		}
+		meta.SetStatusCondition(&query.Status.Conditions, condition)
+	}
 }
 
 func (r *QueryReconciler) executeModelWithStreaming(ctx context.Context, model *genai.Model, messages []genai.Message, eventStream genai.EventStreamInterface, modelTracker *genai.OperationTracker) ([]genai.Message, error) {
@@ -963,9 +1717,56 @@ func (r *QueryReconciler) executeModelWithStreaming(ctx context.Context, model *
 	return responseMessages, nil
 }
 
+// queryBacklog samples execution demand from the informer cache: queries
+// admitted but not yet executing, the targets they will fan out to, and how
+// long they have been waiting
+func (r *QueryReconciler) queryBacklog(ctx context.Context) telemetry.QueryBacklogStats {
+	var queries arkv1alpha1.QueryList
+	if err := r.List(ctx, &queries); err != nil {
+		logf.FromContext(ctx).V(1).Error(err, "failed to list queries for backlog metrics")
+		return telemetry.QueryBacklogStats{}
+	}
+
+	var stats telemetry.QueryBacklogStats
+	var totalWait time.Duration
+	now := time.Now()
+
+	for i := range queries.Items {
+		query := &queries.Items[i]
+		if query.Status.Phase != "" && query.Status.Phase != statusPending {
+			continue
+		}
+		if _, executing := r.operations.Load(types.NamespacedName{Name: query.Name, Namespace: query.Namespace}); executing {
+			continue
+		}
+		stats.PendingQueries++
+		if targets := int64(len(query.Spec.Targets)); targets > 0 {
+			stats.QueuedTargets += targets
+		} else {
+			stats.QueuedTargets++
+		}
+		totalWait += now.Sub(query.CreationTimestamp.Time)
+	}
+
+	if stats.PendingQueries > 0 {
+		stats.AverageWaitSeconds = totalWait.Seconds() / float64(stats.PendingQueries)
+	}
+	return stats
+}
+
 func (r *QueryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := telemetry.RegisterInFlightQueryGauge(r.inFlightOperations); err != nil {
+		return fmt.Errorf("failed to register in-flight query gauge: %w", err)
+	}
+	if err := telemetry.RegisterQueryBacklogGauges(r.queryBacklog); err != nil {
+		return fmt.Errorf("failed to register query backlog gauges: %w", err)
+	}
+	if err := mgr.Add(manager.RunnableFunc(r.runOperationJanitor)); err != nil {
+		return fmt.Errorf("failed to add operation janitor: %w", err)
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&arkv1alpha1.Query{}).
+		Owns(&batchv1.Job{}).
 		Named("query").
 		Complete(r)
 }
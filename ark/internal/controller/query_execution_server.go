@@ -0,0 +1,207 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/genai"
+)
+
+const (
+	syncQueryDefaultTimeout = 5 * time.Minute
+	syncQueryMaxTimeout     = 30 * time.Minute
+	syncQueryPollInterval   = time.Second
+)
+
+// SyncQueryRequest creates a query to execute synchronously. Name is
+// optional; a generated name is used when empty
+type SyncQueryRequest struct {
+	Namespace string                `json:"namespace,omitempty"`
+	Name      string                `json:"name,omitempty"`
+	Timeout   string                `json:"timeout,omitempty"`
+	Spec      arkv1alpha1.QuerySpec `json:"spec"`
+	Labels    map[string]string     `json:"labels,omitempty"`
+}
+
+// SyncQueryResponse is the terminal state of a synchronously executed query
+type SyncQueryResponse struct {
+	Name        string                         `json:"name"`
+	Namespace   string                         `json:"namespace"`
+	Phase       string                         `json:"phase"`
+	Responses   []arkv1alpha1.Response         `json:"responses,omitempty"`
+	Evaluations []arkv1alpha1.EvaluationResult `json:"evaluations,omitempty"`
+	TokenUsage  arkv1alpha1.TokenUsage         `json:"tokenUsage"`
+	Duration    string                         `json:"duration,omitempty"`
+}
+
+// QueryExecutionServer exposes a blocking execution endpoint: it creates a
+// Query, waits for a terminal phase and returns the responses, so clients do
+// not each implement their own watch loop for the ask-and-wait case
+type QueryExecutionServer struct {
+	Client client.Client
+	Addr   string
+}
+
+func (s *QueryExecutionServer) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *QueryExecutionServer) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/queries", s.handleExecute)
+
+	server := &http.Server{
+		Addr:              s.Addr,
+		Handler:           mux,
+		ReadHeaderTimeout: discoveryReadHeaderTimeout,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), discoveryShutdownTimeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	logf.Log.Info("starting query execution server", "addr", s.Addr)
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *QueryExecutionServer) handleExecute(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var request SyncQueryRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	timeout, err := parseSyncQueryTimeout(request.Timeout)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	query, err := s.createQuery(r.Context(), request)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to create query: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	final, err := s.waitForCompletion(r.Context(), query, timeout)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			http.Error(w, fmt.Sprintf("query %s/%s did not complete within %s", query.Namespace, query.Name, timeout), http.StatusGatewayTimeout)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", genai.ContentTypeJSON)
+	if err := json.NewEncoder(w).Encode(buildSyncQueryResponse(final)); err != nil {
+		logf.FromContext(r.Context()).Error(err, "failed to encode sync query response")
+	}
+}
+
+func parseSyncQueryTimeout(raw string) (time.Duration, error) {
+	if raw == "" {
+		return syncQueryDefaultTimeout, nil
+	}
+	timeout, err := time.ParseDuration(raw)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("timeout must be a positive duration, e.g. 2m")
+	}
+	if timeout > syncQueryMaxTimeout {
+		timeout = syncQueryMaxTimeout
+	}
+	return timeout, nil
+}
+
+func (s *QueryExecutionServer) createQuery(ctx context.Context, request SyncQueryRequest) (*arkv1alpha1.Query, error) {
+	namespace := request.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	query := &arkv1alpha1.Query{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      request.Name,
+			Namespace: namespace,
+			Labels:    request.Labels,
+		},
+		Spec: request.Spec,
+	}
+	if query.Name == "" {
+		query.GenerateName = "query-"
+	}
+
+	if err := s.Client.Create(ctx, query); err != nil {
+		return nil, err
+	}
+	return query, nil
+}
+
+// waitForCompletion polls the query until it reaches a terminal phase or the
+// timeout elapses; on timeout the query is left running for asynchronous
+// observation
+func (s *QueryExecutionServer) waitForCompletion(ctx context.Context, query *arkv1alpha1.Query, timeout time.Duration) (*arkv1alpha1.Query, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(syncQueryPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-waitCtx.Done():
+			return nil, waitCtx.Err()
+		case <-ticker.C:
+		}
+
+		var current arkv1alpha1.Query
+		if err := s.Client.Get(waitCtx, client.ObjectKeyFromObject(query), &current); err != nil {
+			if errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+				return nil, waitCtx.Err()
+			}
+			return nil, fmt.Errorf("failed to get query %s/%s: %w", query.Namespace, query.Name, err)
+		}
+
+		switch current.Status.Phase {
+		case statusDone, statusError, statusCanceled:
+			return &current, nil
+		}
+	}
+}
+
+func buildSyncQueryResponse(query *arkv1alpha1.Query) SyncQueryResponse {
+	response := SyncQueryResponse{
+		Name:        query.Name,
+		Namespace:   query.Namespace,
+		Phase:       query.Status.Phase,
+		Responses:   query.Status.Responses,
+		Evaluations: query.Status.Evaluations,
+		TokenUsage:  query.Status.TokenUsage,
+	}
+	if query.Status.Duration != nil {
+		response.Duration = query.Status.Duration.Duration.String()
+	}
+	return response
+}
@@ -0,0 +1,110 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/genai"
+)
+
+// executeHedgedTargets races the hedging targets, giving each launch the
+// configured head start over the next, and returns the response of the first
+// target that finishes acceptably. Remaining executions are canceled once a
+// winner is chosen
+func (r *QueryReconciler) executeHedgedTargets(ctx context.Context, query arkv1alpha1.Query, impersonatedClient client.Client, memory genai.MemoryInterface, eventStream genai.EventStreamInterface, tokenCollector *genai.TokenUsageCollector) (*arkv1alpha1.Response, error) {
+	hedging := query.Spec.Hedging
+	log := logf.FromContext(ctx)
+
+	var delay time.Duration
+	if hedging.Delay != nil {
+		delay = hedging.Delay.Duration
+	}
+
+	raceCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	resultChan := make(chan targetResult, len(hedging.Targets))
+	var wg sync.WaitGroup
+
+	for i, target := range hedging.Targets {
+		wg.Add(1)
+		go func(target arkv1alpha1.QueryTarget, headStart time.Duration) {
+			defer wg.Done()
+			if headStart > 0 {
+				select {
+				case <-raceCtx.Done():
+					resultChan <- targetResult{err: raceCtx.Err(), target: target}
+					return
+				case <-time.After(headStart):
+				}
+			}
+			targetCtx, citationTracker := genai.WithCitationTracker(raceCtx)
+			targetCtx, timingTracker := genai.WithTimingTracker(targetCtx)
+			targetCtx, alternativesTracker := genai.WithAlternativesTracker(targetCtx)
+			targetCtx, servedByTracker := genai.WithServedByTracker(targetCtx)
+			targetCtx, secretRedactor := genai.WithSecretRedactor(targetCtx)
+			messages, err := r.executeTarget(targetCtx, query, target, impersonatedClient, memory, targetEventStream(target, eventStream), tokenCollector)
+			resultChan <- targetResult{messages, citationTracker.Citations(), alternativesTracker.Alternatives(), servedByTracker.Model(), timingTracker.Summary(), secretRedactor, err, target}
+		}(target, time.Duration(i)*delay)
+	}
+
+	go func() {
+		wg.Wait()
+		close(resultChan)
+	}()
+
+	var lastErr error
+	for i := 0; i < len(hedging.Targets); i++ {
+		result := <-resultChan
+		if result.err != nil {
+			if raceCtx.Err() == nil {
+				lastErr = result.err
+				log.Info("hedged target failed, waiting on remaining hedges", "target", result.target.Name, "error", result.err.Error())
+			}
+			continue
+		}
+		if result.messages == nil {
+			continue
+		}
+		response := buildTargetResponse(result)
+		if !r.hedgeResponseAccepted(ctx, query, *response, tokenCollector) {
+			log.Info("hedged response rejected by evaluator, waiting on remaining hedges", "target", result.target.Name)
+			continue
+		}
+		log.Info("hedged target won the race", "target", result.target.Name, "duration", result.timing.Duration.String())
+		return response, nil
+	}
+
+	if lastErr != nil {
+		return nil, fmt.Errorf("all hedged targets failed: %w", lastErr)
+	}
+	return nil, fmt.Errorf("no hedged target produced an acceptable response")
+}
+
+// hedgeResponseAccepted runs the optional gating evaluator against the
+// candidate response. Without an evaluator any successful response wins, and
+// an evaluator failure accepts the response rather than failing the query
+func (r *QueryReconciler) hedgeResponseAccepted(ctx context.Context, query arkv1alpha1.Query, response arkv1alpha1.Response, recorder genai.EventEmitter) bool {
+	evaluatorRef := query.Spec.Hedging.Evaluator
+	if evaluatorRef == nil {
+		return true
+	}
+
+	candidate := query.DeepCopy()
+	candidate.Status.Responses = []arkv1alpha1.Response{response}
+
+	result, err := genai.CallSingleEvaluator(ctx, r.Client, *candidate, *evaluatorRef, recorder)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "hedging evaluator failed, accepting response", "evaluator", evaluatorRef.Name)
+		return true
+	}
+	return result.Passed
+}
@@ -0,0 +1,66 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/genai"
+)
+
+// QueryConditionInvalidTarget is set on a query that references a target type
+// with no registered executor
+const QueryConditionInvalidTarget = "InvalidTarget"
+
+// UnknownTargetTypeError reports a query target type with no registered
+// executor, so callers can distinguish it from execution failures
+type UnknownTargetTypeError struct {
+	Type string
+}
+
+func (e *UnknownTargetTypeError) Error() string {
+	return fmt.Sprintf("unknown query target type: %s", e.Type)
+}
+
+// targetExecutorFunc executes a single query target of a given type
+type targetExecutorFunc func(r *QueryReconciler, ctx context.Context, query arkv1alpha1.Query, targetName string, impersonatedClient client.Client, memory genai.MemoryInterface, eventStream genai.EventStreamInterface, tokenCollector *genai.TokenUsageCollector) ([]genai.Message, error)
+
+var (
+	targetExecutorsMu sync.RWMutex
+	targetExecutors   = map[string]targetExecutorFunc{}
+)
+
+// RegisterTargetExecutor registers an executor for a target type, allowing new
+// target types to be added without modifying the dispatch in executeTarget
+func RegisterTargetExecutor(targetType string, executor targetExecutorFunc) {
+	targetExecutorsMu.Lock()
+	defer targetExecutorsMu.Unlock()
+	targetExecutors[targetType] = executor
+}
+
+func lookupTargetExecutor(targetType string) (targetExecutorFunc, bool) {
+	targetExecutorsMu.RLock()
+	defer targetExecutorsMu.RUnlock()
+	executor, ok := targetExecutors[targetType]
+	return executor, ok
+}
+
+func init() {
+	RegisterTargetExecutor("agent", func(r *QueryReconciler, ctx context.Context, query arkv1alpha1.Query, targetName string, impersonatedClient client.Client, memory genai.MemoryInterface, eventStream genai.EventStreamInterface, tokenCollector *genai.TokenUsageCollector) ([]genai.Message, error) {
+		return r.executeAgent(ctx, query, targetName, impersonatedClient, memory, eventStream, tokenCollector)
+	})
+	RegisterTargetExecutor("team", func(r *QueryReconciler, ctx context.Context, query arkv1alpha1.Query, targetName string, impersonatedClient client.Client, memory genai.MemoryInterface, eventStream genai.EventStreamInterface, tokenCollector *genai.TokenUsageCollector) ([]genai.Message, error) {
+		return r.executeTeam(ctx, query, targetName, impersonatedClient, memory, eventStream, tokenCollector)
+	})
+	RegisterTargetExecutor("model", func(r *QueryReconciler, ctx context.Context, query arkv1alpha1.Query, targetName string, impersonatedClient client.Client, memory genai.MemoryInterface, eventStream genai.EventStreamInterface, tokenCollector *genai.TokenUsageCollector) ([]genai.Message, error) {
+		return r.executeModel(ctx, query, targetName, impersonatedClient, memory, eventStream, tokenCollector)
+	})
+	RegisterTargetExecutor("tool", func(r *QueryReconciler, ctx context.Context, query arkv1alpha1.Query, targetName string, impersonatedClient client.Client, memory genai.MemoryInterface, eventStream genai.EventStreamInterface, tokenCollector *genai.TokenUsageCollector) ([]genai.Message, error) {
+		return r.executeTool(ctx, query, targetName, impersonatedClient, tokenCollector)
+	})
+}
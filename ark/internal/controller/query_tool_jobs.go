@@ -0,0 +1,177 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/annotations"
+)
+
+const (
+	toolJobPollInterval = 15 * time.Second
+	toolJobNameMaxLen   = 63
+)
+
+var jobCommandParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// ToolJobPendingError signals that a job tool has been launched and the query
+// should pause in the waiting-on-tool phase until the job completes
+type ToolJobPendingError struct {
+	JobName string
+}
+
+func (e *ToolJobPendingError) Error() string {
+	return fmt.Sprintf("waiting on tool job %s", e.JobName)
+}
+
+// launchToolJob creates the Kubernetes Job backing an async tool call. It is
+// idempotent: an already existing job for the same query is reused
+func (r *QueryReconciler) launchToolJob(ctx context.Context, query arkv1alpha1.Query, tool *arkv1alpha1.Tool, args map[string]any) (string, error) {
+	jobName := toolJobName(query.Name, tool.Name)
+	backoffLimit := int32(0)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: query.Namespace,
+			Labels: map[string]string{
+				annotations.ARKPrefix + "query": query.Name,
+				annotations.ARKPrefix + "tool":  tool.Name,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          &backoffLimit,
+			ActiveDeadlineSeconds: tool.Spec.Job.ActiveDeadlineSeconds,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: tool.Spec.Job.ServiceAccount,
+					Containers: []corev1.Container{{
+						Name:    "tool",
+						Image:   tool.Spec.Job.Image,
+						Command: substituteJobCommand(tool.Spec.Job.Command, args),
+					}},
+				},
+			},
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(&query, job, r.Scheme); err != nil {
+		return "", fmt.Errorf("failed to set owner reference on tool job: %w", err)
+	}
+
+	if err := r.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("failed to create tool job %s: %w", jobName, err)
+	}
+
+	return jobName, nil
+}
+
+// handleWaitingOnTool resumes a query paused on an async tool job. Job events
+// trigger reconciliation through the owner reference; the requeue is a
+// fallback for missed events
+func (r *QueryReconciler) handleWaitingOnTool(ctx context.Context, obj arkv1alpha1.Query) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	var job batchv1.Job
+	jobKey := types.NamespacedName{Name: obj.Status.WaitingOnJob, Namespace: obj.Namespace}
+	if err := r.Get(ctx, jobKey, &job); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("tool job disappeared while query was waiting", "job", jobKey)
+			return ctrl.Result{}, r.updateStatus(ctx, &obj, statusError)
+		}
+		return ctrl.Result{}, err
+	}
+
+	switch {
+	case jobHasCondition(&job, batchv1.JobComplete):
+		output, err := r.toolJobOutput(ctx, &job)
+		if err != nil {
+			log.Error(err, "failed to read tool job output", "job", jobKey)
+			return ctrl.Result{}, r.updateStatus(ctx, &obj, statusError)
+		}
+		toolName := job.Labels[annotations.ARKPrefix+"tool"]
+		obj.Status.Responses = append(obj.Status.Responses, arkv1alpha1.Response{
+			Target:  arkv1alpha1.QueryTarget{Type: "tool", Name: toolName},
+			Content: output,
+			Raw:     output,
+		})
+		obj.Status.WaitingOnJob = ""
+		return ctrl.Result{}, r.updateStatus(ctx, &obj, statusDone)
+	case jobHasCondition(&job, batchv1.JobFailed):
+		log.Info("tool job failed", "job", jobKey)
+		obj.Status.WaitingOnJob = ""
+		return ctrl.Result{}, r.updateStatus(ctx, &obj, statusError)
+	default:
+		return ctrl.Result{RequeueAfter: toolJobPollInterval}, nil
+	}
+}
+
+// toolJobOutput reads the tool output from the termination message of the
+// job's succeeded pod
+func (r *QueryReconciler) toolJobOutput(ctx context.Context, job *batchv1.Job) (string, error) {
+	var pods corev1.PodList
+	if err := r.List(ctx, &pods, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name}); err != nil {
+		return "", fmt.Errorf("failed to list pods for job %s: %w", job.Name, err)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodSucceeded {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Terminated != nil && status.State.Terminated.Message != "" {
+				return status.State.Terminated.Message, nil
+			}
+		}
+	}
+
+	return fmt.Sprintf("tool job %s completed", job.Name), nil
+}
+
+func jobHasCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func substituteJobCommand(command []string, args map[string]any) []string {
+	result := make([]string, len(command))
+	for i, part := range command {
+		result[i] = jobCommandParamPattern.ReplaceAllStringFunc(part, func(match string) string {
+			paramName := strings.Trim(match, "{}")
+			if value, exists := args[paramName]; exists {
+				return fmt.Sprintf("%v", value)
+			}
+			return match
+		})
+	}
+	return result
+}
+
+func toolJobName(queryName, toolName string) string {
+	name := fmt.Sprintf("%s-tool-%s", queryName, toolName)
+	if len(name) > toolJobNameMaxLen {
+		name = name[:toolJobNameMaxLen]
+	}
+	return strings.TrimSuffix(name, "-")
+}
@@ -0,0 +1,145 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+// QueryConditionExecutionWindow is set on a query held back or failed by its
+// spec.executionWindow constraints
+const QueryConditionExecutionWindow = "ExecutionWindow"
+
+// enforceExecutionWindow holds the query pending until its execution window
+// opens, or fails it when the window has already closed. The second return
+// value reports whether admission was intercepted
+func (r *QueryReconciler) enforceExecutionWindow(ctx context.Context, obj *arkv1alpha1.Query) (ctrl.Result, bool) {
+	window := obj.Spec.ExecutionWindow
+	now := time.Now().UTC()
+
+	if window == nil {
+		return ctrl.Result{}, false
+	}
+
+	if window.NotAfter != nil && now.After(window.NotAfter.Time) {
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               QueryConditionExecutionWindow,
+			Status:             metav1.ConditionFalse,
+			Reason:             "WindowClosed",
+			Message:            fmt.Sprintf("execution window closed at %s before the query started", window.NotAfter.Format(time.RFC3339)),
+			ObservedGeneration: obj.Generation,
+		})
+		if err := r.updateStatus(ctx, obj, statusError); err != nil {
+			return ctrl.Result{RequeueAfter: maintenanceRequeueInterval}, true
+		}
+		return ctrl.Result{}, true
+	}
+
+	wait := timeUntilWindowOpens(window, now)
+	if wait <= 0 {
+		if meta.RemoveStatusCondition(&obj.Status.Conditions, QueryConditionExecutionWindow) {
+			logf.FromContext(ctx).Info("execution window open, admitting query", "name", obj.Name, "namespace", obj.Namespace)
+		}
+		return ctrl.Result{}, false
+	}
+
+	changed := meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+		Type:               QueryConditionExecutionWindow,
+		Status:             metav1.ConditionTrue,
+		Reason:             "WaitingForWindow",
+		Message:            fmt.Sprintf("execution deferred until the window opens in %s", wait.Round(time.Second)),
+		ObservedGeneration: obj.Generation,
+	})
+	if changed || obj.Status.Phase != statusPending {
+		if err := r.updateStatus(ctx, obj, statusPending); err != nil {
+			return ctrl.Result{RequeueAfter: maintenanceRequeueInterval}, true
+		}
+	}
+	return ctrl.Result{RequeueAfter: wait}, true
+}
+
+// timeUntilWindowOpens returns how long until the window admits execution;
+// zero or negative means the window is open now
+func timeUntilWindowOpens(window *arkv1alpha1.ExecutionWindow, now time.Time) time.Duration {
+	var wait time.Duration
+
+	if window.NotBefore != nil {
+		wait = window.NotBefore.Time.Sub(now)
+	}
+
+	if window.AllowedHours != "" {
+		earliest := now.Add(maxDuration(wait, 0))
+		hourWait, ok := timeUntilAllowedHour(window.AllowedHours, earliest)
+		if ok {
+			wait = earliest.Add(hourWait).Sub(now)
+		}
+	}
+
+	return wait
+}
+
+// timeUntilAllowedHour returns how long from now until the next allowed UTC
+// hour; zero when the current hour is allowed. Malformed ranges (caught by
+// CRD validation in practice) report ok=false and do not constrain
+func timeUntilAllowedHour(allowedHours string, now time.Time) (time.Duration, bool) {
+	from, to, err := parseHourRange(allowedHours)
+	if err != nil {
+		return 0, false
+	}
+
+	if hourInRange(now.UTC().Hour(), from, to) {
+		return 0, true
+	}
+
+	nextHour := now.UTC().Truncate(time.Hour).Add(time.Hour)
+	for i := 0; i < 24; i++ {
+		candidate := nextHour.Add(time.Duration(i) * time.Hour)
+		if hourInRange(candidate.Hour(), from, to) {
+			return candidate.Sub(now), true
+		}
+	}
+	return 0, false
+}
+
+func parseHourRange(allowedHours string) (int, int, error) {
+	parts := strings.SplitN(allowedHours, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid hour range %q", allowedHours)
+	}
+	from, err := strconv.Atoi(parts[0])
+	if err != nil || from < 0 || from > 23 {
+		return 0, 0, fmt.Errorf("invalid start hour %q", parts[0])
+	}
+	to, err := strconv.Atoi(parts[1])
+	if err != nil || to < 0 || to > 23 {
+		return 0, 0, fmt.Errorf("invalid end hour %q", parts[1])
+	}
+	return from, to, nil
+}
+
+// hourInRange reports whether the hour falls in the inclusive range,
+// supporting ranges that wrap midnight such as 22-06
+func hourInRange(hour, from, to int) bool {
+	if from <= to {
+		return hour >= from && hour <= to
+	}
+	return hour >= from || hour <= to
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,328 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/genai"
+)
+
+const (
+	statusPassed = "passed"
+	statusFailed = "failed"
+
+	scenarioDefaultTimeout  = 2 * time.Minute
+	scenarioResponseLimit   = 500
+	scenarioTranscriptLimit = 4000
+
+	simulatedUserDefaultMaxTurns = 5
+)
+
+// ScenarioTestReconciler reconciles a ScenarioTest object
+type ScenarioTestReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=scenariotests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=scenariotests/status,verbs=get;update;patch
+
+func (r *ScenarioTestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	var test arkv1alpha1.ScenarioTest
+	if err := r.Get(ctx, req.NamespacedName, &test); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to fetch ScenarioTest")
+		return ctrl.Result{}, err
+	}
+
+	switch test.Status.Phase {
+	case statusPassed, statusFailed, statusError:
+		return ctrl.Result{}, nil
+	case statusRunning:
+		return r.runScenario(ctx, test)
+	default:
+		if err := r.updateStatus(ctx, test, statusRunning, "Executing scenario"); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+}
+
+// runScenario executes the scripted turns synchronously against the agent,
+// bounded by the scenario timeout
+func (r *ScenarioTestReconciler) runScenario(ctx context.Context, test arkv1alpha1.ScenarioTest) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	timeout := scenarioDefaultTimeout
+	if test.Spec.Timeout != nil && test.Spec.Timeout.Duration > 0 {
+		timeout = test.Spec.Timeout.Duration
+	}
+	if (len(test.Spec.Turns) == 0) == (test.Spec.SimulatedUser == nil) {
+		return ctrl.Result{}, r.updateStatus(ctx, test, statusError, "exactly one of turns or simulatedUser must be set")
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	var results []arkv1alpha1.ScenarioTurnResult
+	var err error
+	if test.Spec.SimulatedUser != nil {
+		results, err = r.executeSimulatedConversation(runCtx, &test)
+	} else {
+		results, err = r.executeTurns(runCtx, &test)
+	}
+	test.Status.Turns = results
+	test.Status.Duration = &metav1.Duration{Duration: time.Since(start).Round(time.Millisecond)}
+
+	if err != nil {
+		log.Error(err, "scenario execution failed", "test", test.Name)
+		if err := r.updateStatus(ctx, test, statusError, fmt.Sprintf("Scenario execution failed: %v", err)); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	passed := 0
+	for _, result := range results {
+		if result.Passed {
+			passed++
+		}
+	}
+
+	phase := statusPassed
+	if passed < len(results) {
+		phase = statusFailed
+	}
+	for _, evaluation := range test.Status.Evaluations {
+		if !evaluation.Passed {
+			phase = statusFailed
+		}
+	}
+	return ctrl.Result{}, r.updateStatus(ctx, test, phase, fmt.Sprintf("%d/%d turns passed", passed, len(results)))
+}
+
+// executeSimulatedConversation lets the persona model drive the conversation
+// against the agent under test, recording each exchange as a turn and scoring
+// the transcript with the configured evaluators
+func (r *ScenarioTestReconciler) executeSimulatedConversation(ctx context.Context, test *arkv1alpha1.ScenarioTest) ([]arkv1alpha1.ScenarioTurnResult, error) {
+	sim := test.Spec.SimulatedUser
+
+	var agentCRD arkv1alpha1.Agent
+	agentKey := types.NamespacedName{Name: test.Spec.Agent, Namespace: test.Namespace}
+	if err := r.Get(ctx, agentKey, &agentCRD); err != nil {
+		return nil, fmt.Errorf("unable to get agent %v: %w", agentKey, err)
+	}
+
+	recorder := genai.NewScenarioTestRecorder(test, r.Recorder)
+	agent, err := genai.MakeAgent(ctx, r.Client, &agentCRD, recorder)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make agent %v: %w", agentKey, err)
+	}
+
+	personaModel, err := genai.LoadModel(ctx, r.Client, &sim.ModelRef, test.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load persona model %s: %w", sim.ModelRef.Name, err)
+	}
+
+	maxTurns := simulatedUserDefaultMaxTurns
+	if sim.MaxTurns != nil {
+		maxTurns = *sim.MaxTurns
+	}
+
+	var history []genai.Message
+	var results []arkv1alpha1.ScenarioTurnResult
+	for turn := 1; turn <= maxTurns; turn++ {
+		if ctx.Err() != nil {
+			return results, ctx.Err()
+		}
+
+		input, done, err := genai.NextSimulatedUserTurn(ctx, personaModel, sim.Persona, sim.Goal, history)
+		if err != nil {
+			return results, fmt.Errorf("turn %d: %w", turn, err)
+		}
+		if done {
+			break
+		}
+
+		userMessage := genai.NewUserMessage(input)
+		responseMessages, err := agent.Execute(ctx, userMessage, history, genai.NewNoopMemory(), nil)
+		if err != nil {
+			return results, fmt.Errorf("turn %d failed: %w", turn, err)
+		}
+
+		history = append(append(history, userMessage), responseMessages...)
+		response := finalAssistantContent(responseMessages)
+		if len(response) > scenarioResponseLimit {
+			response = response[:scenarioResponseLimit]
+		}
+		results = append(results, arkv1alpha1.ScenarioTurnResult{Turn: turn, Passed: true, Response: response})
+	}
+
+	transcript := genai.RenderTranscript(history)
+	test.Status.Evaluations = r.evaluateTranscript(ctx, test, transcript, recorder)
+	if len(transcript) > scenarioTranscriptLimit {
+		transcript = transcript[:scenarioTranscriptLimit]
+	}
+	test.Status.Transcript = transcript
+	return results, nil
+}
+
+// evaluateTranscript scores the finished conversation against the simulated
+// user's evaluators, presenting the transcript as a query response and the
+// goal as the input
+func (r *ScenarioTestReconciler) evaluateTranscript(ctx context.Context, test *arkv1alpha1.ScenarioTest, transcript string, recorder genai.EventEmitter) []arkv1alpha1.EvaluationResult {
+	sim := test.Spec.SimulatedUser
+	if len(sim.Evaluators) == 0 || transcript == "" {
+		return nil
+	}
+
+	candidate := arkv1alpha1.Query{
+		ObjectMeta: metav1.ObjectMeta{Name: test.Name, Namespace: test.Namespace},
+		Spec:       arkv1alpha1.QuerySpec{Input: sim.Goal},
+		Status:     arkv1alpha1.QueryStatus{Responses: []arkv1alpha1.Response{{Content: transcript}}},
+	}
+
+	var results []arkv1alpha1.EvaluationResult
+	for _, evaluatorRef := range sim.Evaluators {
+		result, err := genai.CallSingleEvaluator(ctx, r.Client, candidate, evaluatorRef, recorder)
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "transcript evaluation failed", "evaluator", evaluatorRef.Name)
+			results = append(results, arkv1alpha1.EvaluationResult{EvaluatorName: evaluatorRef.Name, Metadata: map[string]string{"error": err.Error()}})
+			continue
+		}
+		results = append(results, *result)
+	}
+	return results
+}
+
+func (r *ScenarioTestReconciler) executeTurns(ctx context.Context, test *arkv1alpha1.ScenarioTest) ([]arkv1alpha1.ScenarioTurnResult, error) {
+	var agentCRD arkv1alpha1.Agent
+	agentKey := types.NamespacedName{Name: test.Spec.Agent, Namespace: test.Namespace}
+	if err := r.Get(ctx, agentKey, &agentCRD); err != nil {
+		return nil, fmt.Errorf("unable to get agent %v: %w", agentKey, err)
+	}
+
+	recorder := genai.NewScenarioTestRecorder(test, r.Recorder)
+	agent, err := genai.MakeAgent(ctx, r.Client, &agentCRD, recorder)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make agent %v: %w", agentKey, err)
+	}
+
+	var history []genai.Message
+	results := make([]arkv1alpha1.ScenarioTurnResult, 0, len(test.Spec.Turns))
+	for i, turn := range test.Spec.Turns {
+		if ctx.Err() != nil {
+			return results, ctx.Err()
+		}
+
+		userMessage := genai.NewUserMessage(turn.Input)
+		responseMessages, err := agent.Execute(ctx, userMessage, history, genai.NewNoopMemory(), nil)
+		if err != nil {
+			return results, fmt.Errorf("turn %d failed: %w", i+1, err)
+		}
+
+		history = append(append(history, userMessage), responseMessages...)
+		results = append(results, evaluateScenarioTurn(i+1, turn, responseMessages))
+	}
+	return results, nil
+}
+
+// evaluateScenarioTurn checks a turn's assertions against the agent's
+// response messages
+func evaluateScenarioTurn(turn int, spec arkv1alpha1.ScenarioTurn, responseMessages []genai.Message) arkv1alpha1.ScenarioTurnResult {
+	response := finalAssistantContent(responseMessages)
+	toolCalls := calledToolNames(responseMessages)
+
+	var failures []string
+	for _, tool := range spec.ExpectedToolCalls {
+		if !containsString(toolCalls, tool) {
+			failures = append(failures, fmt.Sprintf("expected tool call %q, got %v", tool, toolCalls))
+		}
+	}
+	for _, substring := range spec.Contains {
+		if !strings.Contains(response, substring) {
+			failures = append(failures, fmt.Sprintf("response does not contain %q", substring))
+		}
+	}
+	for _, substring := range spec.NotContains {
+		if strings.Contains(response, substring) {
+			failures = append(failures, fmt.Sprintf("response contains forbidden %q", substring))
+		}
+	}
+
+	if len(response) > scenarioResponseLimit {
+		response = response[:scenarioResponseLimit]
+	}
+	return arkv1alpha1.ScenarioTurnResult{
+		Turn:     turn,
+		Passed:   len(failures) == 0,
+		Response: response,
+		Failures: failures,
+	}
+}
+
+func finalAssistantContent(messages []genai.Message) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if assistant := messages[i].OfAssistant; assistant != nil && assistant.Content.OfString.Valid() {
+			return assistant.Content.OfString.Value
+		}
+	}
+	return ""
+}
+
+func calledToolNames(messages []genai.Message) []string {
+	var names []string
+	for _, message := range messages {
+		assistant := message.OfAssistant
+		if assistant == nil {
+			continue
+		}
+		for _, call := range assistant.ToolCalls {
+			if !containsString(names, call.Function.Name) {
+				names = append(names, call.Function.Name)
+			}
+		}
+	}
+	return names
+}
+
+func (r *ScenarioTestReconciler) updateStatus(ctx context.Context, test arkv1alpha1.ScenarioTest, status, message string) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+	test.Status.Phase = status
+	test.Status.Message = message
+	err := r.Status().Update(ctx, &test)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to update ScenarioTest status", "status", status)
+	}
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ScenarioTestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arkv1alpha1.ScenarioTest{}).
+		Named("scenariotest").
+		Complete(r)
+}
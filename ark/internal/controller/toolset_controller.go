@@ -0,0 +1,221 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/annotations"
+	"mckinsey.com/ark/internal/common"
+	"mckinsey.com/ark/internal/genai"
+)
+
+const (
+	toolSetLabel         = annotations.ARKPrefix + "toolset"
+	toolSetFetchTimeout  = 30 * time.Second
+	toolSetResyncDefault = 10 * time.Minute
+)
+
+var toolNameSanitizePattern = regexp.MustCompile(`[^a-z0-9-]+`)
+
+type ToolSetReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=toolsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=toolsets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=tools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps;secrets,verbs=get;list
+
+func (r *ToolSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	toolSet := &arkv1alpha1.ToolSet{}
+	if err := r.Get(ctx, req.NamespacedName, toolSet); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	document, err := r.loadOpenAPIDocument(ctx, toolSet)
+	if err != nil {
+		return r.failToolSet(ctx, toolSet, err)
+	}
+
+	definitions, err := genai.GenerateToolsFromOpenAPI(document, toolSet.Spec.Operations)
+	if err != nil {
+		return r.failToolSet(ctx, toolSet, err)
+	}
+
+	toolNames, err := r.materializeTools(ctx, toolSet, definitions)
+	if err != nil {
+		return r.failToolSet(ctx, toolSet, err)
+	}
+
+	if err := r.pruneTools(ctx, toolSet, toolNames); err != nil {
+		return r.failToolSet(ctx, toolSet, err)
+	}
+
+	sort.Strings(toolNames)
+	toolSet.Status.Phase = statusReady
+	toolSet.Status.Message = fmt.Sprintf("generated %d tools", len(toolNames))
+	toolSet.Status.Tools = toolNames
+	if err := r.Status().Update(ctx, toolSet); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("tool set synced", "toolset", toolSet.Name, "tools", len(toolNames))
+	return ctrl.Result{RequeueAfter: r.resyncInterval(toolSet)}, nil
+}
+
+func (r *ToolSetReconciler) failToolSet(ctx context.Context, toolSet *arkv1alpha1.ToolSet, reconcileErr error) (ctrl.Result, error) {
+	logf.FromContext(ctx).Error(reconcileErr, "failed to sync tool set", "toolset", toolSet.Name)
+	toolSet.Status.Phase = statusError
+	toolSet.Status.Message = reconcileErr.Error()
+	if err := r.Status().Update(ctx, toolSet); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.resyncInterval(toolSet)}, nil
+}
+
+func (r *ToolSetReconciler) loadOpenAPIDocument(ctx context.Context, toolSet *arkv1alpha1.ToolSet) ([]byte, error) {
+	if toolSet.Spec.SpecRef != nil {
+		resolver := common.NewValueSourceResolver(r.Client)
+		document, err := resolver.ResolveValueSource(ctx, *toolSet.Spec.SpecRef, toolSet.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve OpenAPI spec: %w", err)
+		}
+		return []byte(document), nil
+	}
+
+	if toolSet.Spec.URL == "" {
+		return nil, fmt.Errorf("either url or specRef must be set")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, toolSetFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, toolSet.Spec.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for OpenAPI spec: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch OpenAPI spec: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("OpenAPI spec fetch returned status %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func (r *ToolSetReconciler) materializeTools(ctx context.Context, toolSet *arkv1alpha1.ToolSet, definitions []genai.OpenAPIToolDefinition) ([]string, error) {
+	toolNames := make([]string, 0, len(definitions))
+
+	for _, definition := range definitions {
+		toolName := generatedToolName(toolSet.Name, definition.Name)
+		tool := &arkv1alpha1.Tool{
+			ObjectMeta: metav1.ObjectMeta{Name: toolName, Namespace: toolSet.Namespace},
+		}
+
+		schema, err := json.Marshal(definition.InputSchema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal input schema for operation %s: %w", definition.Name, err)
+		}
+
+		_, err = controllerutil.CreateOrUpdate(ctx, r.Client, tool, func() error {
+			if tool.Labels == nil {
+				tool.Labels = map[string]string{}
+			}
+			tool.Labels[toolSetLabel] = toolSet.Name
+			tool.Spec.Type = arkv1alpha1.ToolTypeHTTP
+			tool.Spec.Description = definition.Description
+			tool.Spec.InputSchema = &runtime.RawExtension{Raw: schema}
+			tool.Spec.HTTP = &arkv1alpha1.HTTPSpec{
+				URL:     definition.URL,
+				Method:  definition.Method,
+				Headers: toolSet.Spec.Headers,
+			}
+			return controllerutil.SetControllerReference(toolSet, tool, r.Scheme)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply tool %s: %w", toolName, err)
+		}
+
+		toolNames = append(toolNames, toolName)
+	}
+
+	return toolNames, nil
+}
+
+// pruneTools removes generated tools whose operations are no longer present
+// in the OpenAPI document
+func (r *ToolSetReconciler) pruneTools(ctx context.Context, toolSet *arkv1alpha1.ToolSet, desired []string) error {
+	desiredNames := make(map[string]bool, len(desired))
+	for _, name := range desired {
+		desiredNames[name] = true
+	}
+
+	var tools arkv1alpha1.ToolList
+	if err := r.List(ctx, &tools, client.InNamespace(toolSet.Namespace), client.MatchingLabels{toolSetLabel: toolSet.Name}); err != nil {
+		return fmt.Errorf("failed to list generated tools: %w", err)
+	}
+
+	for i := range tools.Items {
+		tool := &tools.Items[i]
+		if desiredNames[tool.Name] {
+			continue
+		}
+		if err := r.Delete(ctx, tool); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete stale tool %s: %w", tool.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *ToolSetReconciler) resyncInterval(toolSet *arkv1alpha1.ToolSet) time.Duration {
+	if toolSet.Spec.SyncInterval != nil && toolSet.Spec.SyncInterval.Duration > 0 {
+		return toolSet.Spec.SyncInterval.Duration
+	}
+	return toolSetResyncDefault
+}
+
+func generatedToolName(toolSetName, operationID string) string {
+	name := toolSetName + "-" + toolNameSanitizePattern.ReplaceAllString(strings.ToLower(operationID), "-")
+	name = strings.Trim(name, "-")
+	if len(name) > 63 {
+		name = strings.Trim(name[:63], "-")
+	}
+	return name
+}
+
+func (r *ToolSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arkv1alpha1.ToolSet{}).
+		Owns(&arkv1alpha1.Tool{}).
+		Named("toolset").
+		Complete(r)
+}
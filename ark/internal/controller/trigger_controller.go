@@ -0,0 +1,262 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/annotations"
+)
+
+// triggerFiredHistoryTTL bounds the per-object fired history: entries this old
+// outlast any event the cluster still retains, so they can never gate a firing
+const triggerFiredHistoryTTL = 24 * time.Hour
+
+// TriggerReconciler reconciles a Trigger object
+type TriggerReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=triggers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=triggers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=queries,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;patch
+
+func (r *TriggerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	var trigger arkv1alpha1.Trigger
+	if err := r.Get(ctx, req.NamespacedName, &trigger); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "unable to fetch Trigger")
+		return ctrl.Result{}, err
+	}
+
+	event, cooldown, err := r.findMatchingEvent(ctx, trigger)
+	if err != nil {
+		log.Error(err, "failed to list events for Trigger", "trigger", trigger.Name)
+		return ctrl.Result{}, err
+	}
+
+	if event == nil {
+		result := ctrl.Result{}
+		if cooldown > 0 {
+			result.RequeueAfter = cooldown
+		}
+		if trigger.Status.Phase != statusReady {
+			return result, r.updateStatus(ctx, trigger, statusReady, "Watching for matching events")
+		}
+		return result, nil
+	}
+
+	query := r.buildQuery(trigger, event)
+	if err := r.Create(ctx, query); err != nil {
+		log.Error(err, "failed to create Query from Trigger", "trigger", trigger.Name)
+		return ctrl.Result{}, r.updateStatus(ctx, trigger, statusError, fmt.Sprintf("Failed to create query: %v", err))
+	}
+
+	now := metav1.Now()
+	trigger.Status.Phase = statusReady
+	trigger.Status.Message = fmt.Sprintf("Fired for event %s/%s", event.InvolvedObject.Kind, event.InvolvedObject.Name)
+	trigger.Status.LastFiredTime = &now
+	if trigger.Status.LastFiredByObject == nil {
+		trigger.Status.LastFiredByObject = map[string]metav1.Time{}
+	}
+	trigger.Status.LastFiredByObject[triggerObjectKey(event)] = now
+	r.pruneFiredHistory(&trigger)
+	trigger.Status.LastQueryName = query.Name
+	trigger.Status.FiredCount++
+	if err := r.Status().Update(ctx, &trigger); err != nil {
+		log.Error(err, "failed to update Trigger status", "trigger", trigger.Name)
+		return ctrl.Result{}, err
+	}
+
+	r.Recorder.Event(&trigger, corev1.EventTypeNormal, "QueryCreated", fmt.Sprintf("Created query %s for event %s", query.Name, event.Reason))
+	return ctrl.Result{}, nil
+}
+
+// findMatchingEvent returns the newest event matching the trigger selector
+// that occurred after the trigger last fired for the event's involved object,
+// skipping objects still inside their cooldown. Before the first firing for an
+// object the trigger's creation time is the baseline, so pre-existing events
+// that may be long resolved cannot fire a freshly created trigger. When no
+// event is fireable but at least one is only held back by its cooldown, the
+// shortest remaining cooldown is returned so the caller can requeue.
+func (r *TriggerReconciler) findMatchingEvent(ctx context.Context, trigger arkv1alpha1.Trigger) (*corev1.Event, time.Duration, error) {
+	var events corev1.EventList
+	if err := r.List(ctx, &events, client.InNamespace(trigger.Namespace)); err != nil {
+		return nil, 0, err
+	}
+
+	var newest *corev1.Event
+	var cooldown time.Duration
+	for i := range events.Items {
+		event := &events.Items[i]
+		if !eventMatches(trigger.Spec.Events, event) {
+			continue
+		}
+		key := triggerObjectKey(event)
+		if !eventTime(event).After(r.firedBaseline(trigger, key)) {
+			continue
+		}
+		if remaining := r.cooldownRemaining(trigger, key); remaining > 0 {
+			if cooldown == 0 || remaining < cooldown {
+				cooldown = remaining
+			}
+			continue
+		}
+		if newest == nil || eventTime(event).After(eventTime(newest)) {
+			newest = event
+		}
+	}
+	return newest, cooldown, nil
+}
+
+func eventMatches(selector arkv1alpha1.EventSelector, event *corev1.Event) bool {
+	if selector.Type != "" && selector.Type != event.Type {
+		return false
+	}
+	if selector.Reason != "" && selector.Reason != event.Reason {
+		return false
+	}
+	if selector.InvolvedObjectKind != "" && selector.InvolvedObjectKind != event.InvolvedObject.Kind {
+		return false
+	}
+	if selector.InvolvedObjectName != "" && selector.InvolvedObjectName != event.InvolvedObject.Name {
+		return false
+	}
+	return true
+}
+
+func eventTime(event *corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+func triggerObjectKey(event *corev1.Event) string {
+	return event.InvolvedObject.Kind + "/" + event.InvolvedObject.Name
+}
+
+// firedBaseline returns the time before which events for the given involved
+// object are considered already handled. Triggers that fired before per-object
+// tracking existed fall back to the trigger-wide last fired time
+func (r *TriggerReconciler) firedBaseline(trigger arkv1alpha1.Trigger, objectKey string) time.Time {
+	if last, ok := trigger.Status.LastFiredByObject[objectKey]; ok {
+		return last.Time
+	}
+	if trigger.Status.LastFiredByObject == nil && trigger.Status.LastFiredTime != nil {
+		return trigger.Status.LastFiredTime.Time
+	}
+	return trigger.CreationTimestamp.Time
+}
+
+func (r *TriggerReconciler) cooldownRemaining(trigger arkv1alpha1.Trigger, objectKey string) time.Duration {
+	if trigger.Spec.Cooldown == nil {
+		return 0
+	}
+	last, ok := trigger.Status.LastFiredByObject[objectKey]
+	if !ok {
+		return 0
+	}
+	return trigger.Spec.Cooldown.Duration - time.Since(last.Time)
+}
+
+// pruneFiredHistory drops per-object entries old enough that neither the
+// cooldown nor the event retention window can still be affected by them,
+// keeping status bounded as involved objects churn
+func (r *TriggerReconciler) pruneFiredHistory(trigger *arkv1alpha1.Trigger) {
+	horizon := triggerFiredHistoryTTL
+	if trigger.Spec.Cooldown != nil && trigger.Spec.Cooldown.Duration > horizon {
+		horizon = trigger.Spec.Cooldown.Duration
+	}
+	for key, last := range trigger.Status.LastFiredByObject {
+		if time.Since(last.Time) > horizon {
+			delete(trigger.Status.LastFiredByObject, key)
+		}
+	}
+}
+
+func (r *TriggerReconciler) buildQuery(trigger arkv1alpha1.Trigger, event *corev1.Event) *arkv1alpha1.Query {
+	parameters := append([]arkv1alpha1.Parameter{
+		{Name: "eventReason", Value: event.Reason},
+		{Name: "eventMessage", Value: event.Message},
+		{Name: "eventType", Value: event.Type},
+		{Name: "objectKind", Value: event.InvolvedObject.Kind},
+		{Name: "objectName", Value: event.InvolvedObject.Name},
+	}, trigger.Spec.Query.Parameters...)
+
+	return &arkv1alpha1.Query{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: trigger.Name + "-",
+			Namespace:    trigger.Namespace,
+			Annotations: map[string]string{
+				annotations.TriggeredFrom: trigger.Name,
+			},
+		},
+		Spec: arkv1alpha1.QuerySpec{
+			Input:          trigger.Spec.Query.Input,
+			Parameters:     parameters,
+			Targets:        trigger.Spec.Query.Targets,
+			Memory:         trigger.Spec.Query.Memory,
+			ServiceAccount: trigger.Spec.Query.ServiceAccount,
+		},
+	}
+}
+
+func (r *TriggerReconciler) updateStatus(ctx context.Context, trigger arkv1alpha1.Trigger, status, message string) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+	trigger.Status.Phase = status
+	trigger.Status.Message = message
+	err := r.Status().Update(ctx, &trigger)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to update Trigger status", "status", status)
+	}
+	return err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TriggerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arkv1alpha1.Trigger{}).
+		Watches(&corev1.Event{}, handler.EnqueueRequestsFromMapFunc(r.triggersForEvent)).
+		Named("trigger").
+		Complete(r)
+}
+
+// triggersForEvent enqueues all triggers in the event's namespace.
+func (r *TriggerReconciler) triggersForEvent(ctx context.Context, obj client.Object) []reconcile.Request {
+	var triggers arkv1alpha1.TriggerList
+	if err := r.List(ctx, &triggers, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(triggers.Items))
+	for _, trigger := range triggers.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: trigger.Name, Namespace: trigger.Namespace},
+		})
+	}
+	return requests
+}
@@ -8,7 +8,8 @@ const (
 	statusPending    = "pending"
 	statusRunning    = "running"
 	statusEvaluating = "evaluating"
-	statusDone       = "done"
+	statusWaitingOnTool = "waiting-on-tool"
+	statusDone          = "done"
 	statusError      = "error"
 	statusCanceled   = "canceled"
 	statusReady      = "ready"
@@ -0,0 +1,198 @@
+/* Copyright 2025. McKinsey & Company */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/genai"
+)
+
+const (
+	usageReportName          = "usage-report"
+	usageReportDefaultWindow = 24 * time.Hour
+	usageReportTopTargets    = 5
+)
+
+// UsageReporter periodically aggregates query activity per namespace and
+// maintains a UsageReport resource in each active namespace, so platform
+// owners can do chargeback without a separate analytics stack
+type UsageReporter struct {
+	Client   client.Client
+	Interval time.Duration
+}
+
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=usagereports,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ark.mckinsey.com,resources=usagereports/status,verbs=get;update;patch
+
+func (r *UsageReporter) NeedLeaderElection() bool {
+	return true
+}
+
+func (r *UsageReporter) Start(ctx context.Context) error {
+	logf.Log.Info("starting usage reporter", "interval", r.Interval)
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := r.report(ctx); err != nil {
+				logf.Log.Error(err, "failed to build usage reports")
+			}
+		}
+	}
+}
+
+func (r *UsageReporter) report(ctx context.Context) error {
+	var queries arkv1alpha1.QueryList
+	if err := r.Client.List(ctx, &queries); err != nil {
+		return fmt.Errorf("failed to list queries: %w", err)
+	}
+
+	byNamespace := map[string][]arkv1alpha1.Query{}
+	for _, query := range queries.Items {
+		byNamespace[query.Namespace] = append(byNamespace[query.Namespace], query)
+	}
+
+	for namespace, namespaceQueries := range byNamespace {
+		if err := r.reportNamespace(ctx, namespace, namespaceQueries); err != nil {
+			logf.Log.Error(err, "failed to update usage report", "namespace", namespace)
+		}
+	}
+	return nil
+}
+
+func (r *UsageReporter) reportNamespace(ctx context.Context, namespace string, queries []arkv1alpha1.Query) error {
+	report, err := r.ensureReport(ctx, namespace)
+	if err != nil {
+		return err
+	}
+
+	window := usageReportDefaultWindow
+	if report.Spec.Window != nil && report.Spec.Window.Duration > 0 {
+		window = report.Spec.Window.Duration
+	}
+	windowEnd := time.Now()
+	windowStart := windowEnd.Add(-window)
+
+	report.Status = buildUsageReportStatus(queries, windowStart, windowEnd)
+	if settings := genai.GetControllerSettings(ctx, r.Client, namespace); settings != nil && settings.CostPerMillionTokens != "" {
+		if price, err := strconv.ParseFloat(settings.CostPerMillionTokens, 64); err == nil {
+			report.Status.Cost = fmt.Sprintf("%.4f", float64(report.Status.TokenUsage.TotalTokens)/1e6*price)
+		}
+	}
+
+	return r.Client.Status().Update(ctx, report)
+}
+
+func (r *UsageReporter) ensureReport(ctx context.Context, namespace string) (*arkv1alpha1.UsageReport, error) {
+	var report arkv1alpha1.UsageReport
+	key := client.ObjectKey{Name: usageReportName, Namespace: namespace}
+	err := r.Client.Get(ctx, key, &report)
+	if err == nil {
+		return &report, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	report = arkv1alpha1.UsageReport{
+		ObjectMeta: metav1.ObjectMeta{Name: usageReportName, Namespace: namespace},
+	}
+	if err := r.Client.Create(ctx, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+func buildUsageReportStatus(queries []arkv1alpha1.Query, windowStart, windowEnd time.Time) arkv1alpha1.UsageReportStatus {
+	status := arkv1alpha1.UsageReportStatus{
+		WindowStart: metav1.NewTime(windowStart),
+		WindowEnd:   metav1.NewTime(windowEnd),
+	}
+
+	targets := map[string]*arkv1alpha1.TargetUsage{}
+	for i := range queries {
+		query := &queries[i]
+		if !queryInReportWindow(query, windowStart) {
+			continue
+		}
+
+		status.Queries++
+		if query.Status.Phase == statusError {
+			status.Errors++
+		}
+		status.TokenUsage.PromptTokens += query.Status.TokenUsage.PromptTokens
+		status.TokenUsage.CompletionTokens += query.Status.TokenUsage.CompletionTokens
+		status.TokenUsage.TotalTokens += query.Status.TokenUsage.TotalTokens
+
+		for _, target := range queryReportTargets(query) {
+			usage, ok := targets[target]
+			if !ok {
+				usage = &arkv1alpha1.TargetUsage{Target: target}
+				targets[target] = usage
+			}
+			usage.Queries++
+			usage.TotalTokens += query.Status.TokenUsage.TotalTokens
+		}
+	}
+
+	if status.Queries > 0 {
+		status.ErrorRate = fmt.Sprintf("%.1f%%", float64(status.Errors)/float64(status.Queries)*100)
+	}
+	status.TopTargets = topTargetUsage(targets)
+	return status
+}
+
+func queryInReportWindow(query *arkv1alpha1.Query, windowStart time.Time) bool {
+	switch query.Status.Phase {
+	case statusDone, statusError, statusCanceled:
+	default:
+		return false
+	}
+	return query.CreationTimestamp.Time.After(windowStart)
+}
+
+func queryReportTargets(query *arkv1alpha1.Query) []string {
+	var targets []string
+	for _, target := range query.Spec.Targets {
+		targets = append(targets, target.Type+"/"+target.Name)
+	}
+	for _, response := range query.Status.Responses {
+		target := response.Target.Type + "/" + response.Target.Name
+		if !containsString(targets, target) {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+func topTargetUsage(targets map[string]*arkv1alpha1.TargetUsage) []arkv1alpha1.TargetUsage {
+	usages := make([]arkv1alpha1.TargetUsage, 0, len(targets))
+	for _, usage := range targets {
+		usages = append(usages, *usage)
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].Queries != usages[j].Queries {
+			return usages[i].Queries > usages[j].Queries
+		}
+		return usages[i].Target < usages[j].Target
+	})
+	if len(usages) > usageReportTopTargets {
+		usages = usages[:usageReportTopTargets]
+	}
+	return usages
+}
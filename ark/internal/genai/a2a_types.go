@@ -8,5 +8,6 @@ import (
 
 // Use the official A2A library types
 type (
-	A2AAgentCard = server.AgentCard
+	A2AAgentCard  = server.AgentCard
+	A2AAgentSkill = server.AgentSkill
 )
@@ -3,10 +3,13 @@ package genai
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/packages/param"
+	"go.opentelemetry.io/otel/attribute"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -14,21 +17,28 @@ import (
 
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
 	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
+	"mckinsey.com/ark/internal/telemetry"
 )
 
 type Agent struct {
-	Name            string
-	Namespace       string
-	Prompt          string
-	Description     string
-	Parameters      []arkv1alpha1.Parameter
-	Model           *Model
-	Tools           *ToolRegistry
-	Recorder        EventEmitter
-	ExecutionEngine *arkv1alpha1.ExecutionEngineRef
-	Annotations     map[string]string
-	OutputSchema    *runtime.RawExtension
-	client          client.Client
+	Name              string
+	Namespace         string
+	Prompt            string
+	Description       string
+	Parameters        []arkv1alpha1.Parameter
+	Model             *Model
+	Tools             *ToolRegistry
+	Recorder          EventEmitter
+	ExecutionEngine   *arkv1alpha1.ExecutionEngineRef
+	Annotations       map[string]string
+	OutputSchema      *runtime.RawExtension
+	ParallelToolCalls *bool
+	ToolChoice        string
+	StrictTools       *bool
+	GenerationOptions *GenerationOptions
+	MessageTransforms []arkv1alpha1.MessageTransform
+	Examples          []arkv1alpha1.AgentExample
+	client            client.Client
 }
 
 // FullName returns the namespace/name format for the agent
@@ -61,13 +71,13 @@ func (a *Agent) Execute(ctx context.Context, userInput Message, history []Messag
 		if a.ExecutionEngine.Name == "a2a" {
 			return a.executeWithA2AExecutionEngine(ctx, userInput)
 		}
-		return a.executeWithExecutionEngine(ctx, userInput, history)
+		return a.executeWithExecutionEngine(ctx, userInput, history, eventStream)
 	}
 
 	return a.executeLocally(ctx, userInput, history, memory, eventStream)
 }
 
-func (a *Agent) executeWithExecutionEngine(ctx context.Context, userInput Message, history []Message) ([]Message, error) {
+func (a *Agent) executeWithExecutionEngine(ctx context.Context, userInput Message, history []Message, eventStream EventStreamInterface) ([]Message, error) {
 	engineClient := NewExecutionEngineClient(a.client)
 
 	agentConfig, err := buildAgentConfig(a)
@@ -83,7 +93,7 @@ func (a *Agent) executeWithExecutionEngine(ctx context.Context, userInput Messag
 
 	toolDefinitions := buildToolDefinitions(a.Tools)
 
-	return engineClient.Execute(ctx, a.ExecutionEngine, agentConfig, userInput, history, toolDefinitions, a.Recorder)
+	return engineClient.Execute(ctx, a.ExecutionEngine, agentConfig, userInput, history, toolDefinitions, a.Recorder, eventStream)
 }
 
 func (a *Agent) executeWithA2AExecutionEngine(ctx context.Context, userInput Message) ([]Message, error) {
@@ -97,25 +107,64 @@ func (a *Agent) prepareMessages(ctx context.Context, userInput Message, history
 		return nil, fmt.Errorf("agent %s prompt resolution failed: %w", a.FullName(), err)
 	}
 
+	if len(a.MessageTransforms) > 0 {
+		history, err = ApplyMessageTransforms(a.MessageTransforms, history)
+		if err != nil {
+			return nil, fmt.Errorf("agent %s message transform failed: %w", a.FullName(), err)
+		}
+	}
+
 	systemMessage := NewSystemMessage(resolvedPrompt)
-	agentMessages := append([]Message{systemMessage}, history...)
+	agentMessages := append([]Message{systemMessage}, exampleMessages(a.Examples)...)
+	agentMessages = append(agentMessages, history...)
 	agentMessages = append(agentMessages, userInput)
 	return agentMessages, nil
 }
 
 // executeModelCall executes a single model call with optional streaming support.
 func (a *Agent) executeModelCall(ctx context.Context, agentMessages []Message, tools []openai.ChatCompletionToolParam, eventStream EventStreamInterface) (*openai.ChatCompletion, error) {
-	llmTracker := NewOperationTracker(a.Recorder, ctx, "LLMCall", a.Model.Model, map[string]string{
+	llmMetadata := map[string]string{
 		"agent": a.FullName(),
 		"model": a.Model.Model,
-	})
+	}
+	for key, value := range ChargebackLabelsFromContext(ctx) {
+		llmMetadata["label."+key] = value
+	}
+	llmTracker := NewOperationTracker(a.Recorder, ctx, "LLMCall", a.Model.Model, llmMetadata)
 
 	// Set schema information on the model
 	a.Model.OutputSchema = a.OutputSchema
 	// Truncate schema name to 64 chars for OpenAI API compatibility - name is purely an identifier
 	a.Model.SchemaName = fmt.Sprintf("%.64s", fmt.Sprintf("namespace-%s-agent-%s", a.Namespace, a.Name))
+	a.Model.ParallelToolCalls = a.ParallelToolCalls
+	a.Model.ToolChoice = ResolveToolChoice(ctx, a.ToolChoice)
+	a.Model.GenerationOptions = ApplyMaxOutputTokensOverride(ctx, a.GenerationOptions)
 
-	response, err := a.Model.ChatCompletion(ctx, agentMessages, eventStream, 1, tools)
+	model, err := EnsureContextFits(ctx, a.client, a.Model, agentMessages, a.Namespace)
+	if err != nil {
+		llmTracker.Fail(err)
+		return nil, fmt.Errorf("agent %s context window check failed: %w", a.FullName(), err)
+	}
+	if model != a.Model {
+		model.OutputSchema = a.Model.OutputSchema
+		model.SchemaName = a.Model.SchemaName
+		model.ParallelToolCalls = a.Model.ParallelToolCalls
+		model.ToolChoice = a.Model.ToolChoice
+		model.GenerationOptions = a.Model.GenerationOptions
+	}
+
+	callStart := time.Now()
+	response, err := model.ChatCompletion(ctx, agentMessages, eventStream, 1, tools)
+	if tracker := TimingTrackerFromContext(ctx); tracker != nil {
+		tracker.AddModelTime(time.Since(callStart))
+	}
+	if err == nil {
+		RecordServedBy(ctx, model.Model)
+	}
+	var filtered *ContentFilterError
+	if errors.As(err, &filtered) {
+		response, err = applyContentFilterStrategy(ctx, a.client, model, a.Namespace, filtered, agentMessages, eventStream, tools)
+	}
 	if err != nil {
 		llmTracker.Fail(err)
 		return nil, fmt.Errorf("agent %s execution failed: %w", a.FullName(), err)
@@ -132,9 +181,25 @@ func (a *Agent) executeModelCall(ctx context.Context, agentMessages []Message, t
 		return nil, fmt.Errorf("agent %s received empty response", a.FullName())
 	}
 
+	recordURLCitations(ctx, response)
+
 	return response, nil
 }
 
+// recordURLCitations records provider citation annotations, such as those
+// returned by models with built-in web search, on the active citation tracker
+func recordURLCitations(ctx context.Context, response *openai.ChatCompletion) {
+	tracker := CitationTrackerFromContext(ctx)
+	if tracker == nil {
+		return
+	}
+	for _, annotation := range response.Choices[0].Message.Annotations {
+		if annotation.URLCitation.URL != "" {
+			tracker.AddURLCitation(annotation.URLCitation.URL, annotation.URLCitation.Title)
+		}
+	}
+}
+
 func (a *Agent) processAssistantMessage(choice openai.ChatCompletionChoice) Message {
 	assistantMessage := Message(choice.Message.ToParam())
 
@@ -145,7 +210,11 @@ func (a *Agent) processAssistantMessage(choice openai.ChatCompletionChoice) Mess
 	return assistantMessage
 }
 
-func (a *Agent) executeToolCall(ctx context.Context, toolCall openai.ChatCompletionMessageToolCall) (Message, error) {
+func (a *Agent) executeToolCall(ctx context.Context, toolCall openai.ChatCompletionMessageToolCall, eventStream EventStreamInterface) (Message, error) {
+	ctx, span := telemetry.NewTraceContext().StartToolSpan(ctx, toolCall.Function.Name, a.Tools.GetToolType(toolCall.Function.Name))
+	defer span.End()
+	span.SetAttributes(attribute.Int("tool.arguments.bytes", len(toolCall.Function.Arguments)))
+
 	var params map[string]interface{}
 	if err := json.Unmarshal([]byte(toolCall.Function.Arguments), &params); err != nil {
 		params = map[string]interface{}{"_raw": toolCall.Function.Arguments}
@@ -162,10 +231,20 @@ func (a *Agent) executeToolCall(ctx context.Context, toolCall openai.ChatComplet
 		"toolType":   a.Tools.GetToolType(toolCall.Function.Name),
 	})
 
+	callStart := time.Now()
 	result, err := a.Tools.ExecuteTool(ctx, ToolCall(toolCall), a.Recorder)
+	if tracker := TimingTrackerFromContext(ctx); tracker != nil {
+		tracker.AddToolTime(time.Since(callStart))
+	}
 	toolMessage := ToolMessage(result.Content, result.ID)
+	span.SetAttributes(attribute.Int("tool.result.bytes", len(result.Content)))
+
+	if frameErr := EmitToolResultFrame(ctx, eventStream, result.ID, toolCall.Function.Name, result.Content); frameErr != nil {
+		logf.FromContext(ctx).Error(frameErr, "failed to stream tool result frame", "tool", toolCall.Function.Name)
+	}
 
 	if err != nil {
+		telemetry.RecordError(span, err)
 		if IsTerminateTeam(err) {
 			toolTracker.CompleteWithTermination(err.Error())
 		} else {
@@ -173,6 +252,11 @@ func (a *Agent) executeToolCall(ctx context.Context, toolCall openai.ChatComplet
 		}
 		return toolMessage, err
 	}
+	telemetry.RecordSuccess(span)
+
+	if tracker := CitationTrackerFromContext(ctx); tracker != nil {
+		tracker.AddToolResult(result.ID, toolCall.Function.Name)
+	}
 
 	toolTracker.CompleteWithMetadata(result.Content, map[string]string{
 		"resultLength": fmt.Sprintf("%d", len(result.Content)),
@@ -182,13 +266,13 @@ func (a *Agent) executeToolCall(ctx context.Context, toolCall openai.ChatComplet
 	return toolMessage, nil
 }
 
-func (a *Agent) executeToolCalls(ctx context.Context, toolCalls []openai.ChatCompletionMessageToolCall, agentMessages, newMessages *[]Message) error {
+func (a *Agent) executeToolCalls(ctx context.Context, toolCalls []openai.ChatCompletionMessageToolCall, agentMessages, newMessages *[]Message, eventStream EventStreamInterface) error {
 	for _, tc := range toolCalls {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
 
-		toolMessage, err := a.executeToolCall(ctx, tc)
+		toolMessage, err := a.executeToolCall(ctx, tc, eventStream)
 		*agentMessages = append(*agentMessages, toolMessage)
 		*newMessages = append(*newMessages, toolMessage)
 
@@ -200,10 +284,14 @@ func (a *Agent) executeToolCalls(ctx context.Context, toolCalls []openai.ChatCom
 }
 
 // executeLocally executes the agent using the built-in OpenAI-compatible engine
-func (a *Agent) executeLocally(ctx context.Context, userInput Message, history []Message, _ MemoryInterface, eventStream EventStreamInterface) ([]Message, error) {
+func (a *Agent) executeLocally(ctx context.Context, userInput Message, history []Message, memory MemoryInterface, eventStream EventStreamInterface) ([]Message, error) {
 	var tools []openai.ChatCompletionToolParam
 	if a.Tools != nil {
 		tools = a.Tools.ToOpenAITools()
+		if a.StrictTools != nil && *a.StrictTools {
+			applyStrictToolSchemas(tools)
+		}
+		ctx = WithToolSchemaTokens(ctx, a.Tools.SchemaTokens())
 	}
 
 	agentMessages, err := a.prepareMessages(ctx, userInput, history)
@@ -211,7 +299,14 @@ func (a *Agent) executeLocally(ctx context.Context, userInput Message, history [
 		return nil, err
 	}
 
+	if memory != nil {
+		if systemMessage := agentMessages[0].OfSystem; systemMessage != nil {
+			PinSystemPrompt(ctx, memory, systemMessage.Content.OfString.Value)
+		}
+	}
+
 	newMessages := []Message{}
+	repairTurns := 0
 
 	for {
 		if ctx.Err() != nil {
@@ -226,18 +321,33 @@ func (a *Agent) executeLocally(ctx context.Context, userInput Message, history [
 		choice := response.Choices[0]
 		assistantMessage := a.processAssistantMessage(choice)
 
+		roundStart := len(newMessages)
 		agentMessages = append(agentMessages, assistantMessage)
 		newMessages = append(newMessages, assistantMessage)
 
 		if len(choice.Message.ToolCalls) == 0 {
-			return newMessages, nil
+			schemaErr := validateOutputAgainstSchema(a.OutputSchema, choice.Message.Content)
+			if schemaErr == nil {
+				return newMessages, nil
+			}
+			if repairTurns >= maxSchemaRepairTurns {
+				return newMessages, fmt.Errorf("agent %s output failed schema validation after %d repair attempts: %w", a.FullName(), repairTurns, schemaErr)
+			}
+			repairTurns++
+			logf.FromContext(ctx).Info("Agent output failed schema validation, requesting repair",
+				"agent", a.FullName(), "attempt", repairTurns, "error", schemaErr.Error())
+			agentMessages = append(agentMessages, NewUserMessage(fmt.Sprintf(
+				"Your output did not match the schema: %v. Respond again with JSON that matches the schema.", schemaErr)))
+			continue
 		}
 
-		if err := a.executeToolCalls(ctx, choice.Message.ToolCalls, &agentMessages, &newMessages); err != nil {
+		if err := a.executeToolCalls(ctx, choice.Message.ToolCalls, &agentMessages, &newMessages, eventStream); err != nil {
 			logger := logf.FromContext(ctx)
 			logger.Error(err, "Tool execution failed", "agent", a.FullName())
 			return newMessages, err
 		}
+
+		PersistToolRound(ctx, memory, newMessages[roundStart:])
 	}
 }
 
@@ -283,6 +393,7 @@ func MakeAgent(ctx context.Context, k8sClient client.Client, crd *arkv1alpha1.Ag
 	if err != nil {
 		return nil, fmt.Errorf("failed to load model for agent %s/%s: %w", crd.Namespace, crd.Name, err)
 	}
+	resolvedModel.Recorder = eventRecorder
 
 	// Validate ExecutionEngine if specified
 	if crd.Spec.ExecutionEngine != nil {
@@ -298,19 +409,37 @@ func MakeAgent(ctx context.Context, k8sClient client.Client, crd *arkv1alpha1.Ag
 	if err := tools.registerTools(ctx, k8sClient, crd); err != nil {
 		return nil, err
 	}
+	tools.applyOverrides(ctx)
 
 	return &Agent{
-		Name:            crd.Name,
-		Namespace:       crd.Namespace,
-		Prompt:          crd.Spec.Prompt,
-		Description:     crd.Spec.Description,
-		Parameters:      crd.Spec.Parameters,
-		Model:           resolvedModel,
-		Tools:           tools,
-		Recorder:        eventRecorder,
-		ExecutionEngine: crd.Spec.ExecutionEngine,
-		Annotations:     crd.Annotations,
-		OutputSchema:    crd.Spec.OutputSchema,
-		client:          k8sClient,
+		Name:              crd.Name,
+		Namespace:         crd.Namespace,
+		Prompt:            crd.Spec.Prompt,
+		Description:       crd.Spec.Description,
+		Parameters:        crd.Spec.Parameters,
+		Model:             resolvedModel,
+		Tools:             tools,
+		Recorder:          eventRecorder,
+		ExecutionEngine:   crd.Spec.ExecutionEngine,
+		Annotations:       crd.Annotations,
+		OutputSchema:      crd.Spec.OutputSchema,
+		ParallelToolCalls: crd.Spec.ParallelToolCalls,
+		ToolChoice:        crd.Spec.ToolChoice,
+		StrictTools:       crd.Spec.StrictTools,
+		GenerationOptions: makeGenerationOptions(crd.Spec),
+		MessageTransforms: crd.Spec.MessageTransforms,
+		Examples:          crd.Spec.Examples,
+		client:            k8sClient,
 	}, nil
 }
+
+func makeGenerationOptions(spec arkv1alpha1.AgentSpec) *GenerationOptions {
+	if spec.MaxOutputTokens == nil && len(spec.Stop) == 0 && len(spec.LogitBias) == 0 {
+		return nil
+	}
+	return &GenerationOptions{
+		MaxOutputTokens: spec.MaxOutputTokens,
+		Stop:            spec.Stop,
+		LogitBias:       spec.LogitBias,
+	}
+}
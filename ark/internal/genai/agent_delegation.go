@@ -0,0 +1,47 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// MaxAgentDelegationDepth bounds how many agent-as-tool hops a single
+// execution may chain before delegation is refused
+const MaxAgentDelegationDepth = 5
+
+type delegationChainKey struct{}
+
+// delegationChain returns the agents already invoked as tools on this
+// execution path, oldest first
+func delegationChain(ctx context.Context) []string {
+	chain, _ := ctx.Value(delegationChainKey{}).([]string)
+	return chain
+}
+
+func withDelegationEntry(ctx context.Context, fullName string) context.Context {
+	chain := delegationChain(ctx)
+	extended := make([]string, 0, len(chain)+1)
+	extended = append(extended, chain...)
+	extended = append(extended, fullName)
+	return context.WithValue(ctx, delegationChainKey{}, extended)
+}
+
+// checkDelegation rejects delegation that would exceed the depth bound or
+// revisit an agent already on the execution path
+func checkDelegation(ctx context.Context, fullName string) error {
+	chain := delegationChain(ctx)
+	if len(chain) >= MaxAgentDelegationDepth {
+		return fmt.Errorf("agent delegation depth limit %d reached calling %s (chain: %s)",
+			MaxAgentDelegationDepth, fullName, strings.Join(chain, " -> "))
+	}
+	for _, ancestor := range chain {
+		if ancestor == fullName {
+			return fmt.Errorf("agent delegation cycle detected: %s -> %s",
+				strings.Join(chain, " -> "), fullName)
+		}
+	}
+	return nil
+}
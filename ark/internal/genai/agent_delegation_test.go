@@ -0,0 +1,48 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestCheckDelegationAllowsFreshAgent(t *testing.T) {
+	ctx := withDelegationEntry(context.Background(), "default/researcher")
+	if err := checkDelegation(ctx, "default/writer"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCheckDelegationDetectsCycle(t *testing.T) {
+	ctx := withDelegationEntry(context.Background(), "default/researcher")
+	ctx = withDelegationEntry(ctx, "default/writer")
+
+	err := checkDelegation(ctx, "default/researcher")
+	if err == nil {
+		t.Fatal("expected cycle error")
+	}
+	if !strings.Contains(err.Error(), "cycle") {
+		t.Errorf("expected cycle in error, got %v", err)
+	}
+}
+
+func TestCheckDelegationEnforcesDepthLimit(t *testing.T) {
+	ctx := context.Background()
+	for i := 0; i < MaxAgentDelegationDepth; i++ {
+		name := "default/agent-" + string(rune('a'+i))
+		if err := checkDelegation(ctx, name); err != nil {
+			t.Fatalf("unexpected error at depth %d: %v", i, err)
+		}
+		ctx = withDelegationEntry(ctx, name)
+	}
+
+	err := checkDelegation(ctx, "default/one-too-deep")
+	if err == nil {
+		t.Fatal("expected depth limit error")
+	}
+	if !strings.Contains(err.Error(), "depth limit") {
+		t.Errorf("expected depth limit in error, got %v", err)
+	}
+}
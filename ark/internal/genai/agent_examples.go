@@ -0,0 +1,49 @@
+package genai
+
+import (
+	"fmt"
+
+	"github.com/openai/openai-go"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+// exampleMessages renders few-shot examples as proper conversation messages:
+// a user turn, an assistant turn carrying any demonstrated tool calls, and a
+// tool message per call result, so providers see well-formed tool-call shapes
+// instead of examples pasted into the system prompt
+func exampleMessages(examples []arkv1alpha1.AgentExample) []Message {
+	var messages []Message
+	for i, example := range examples {
+		messages = append(messages, NewUserMessage(example.User))
+
+		if len(example.ToolCalls) == 0 {
+			messages = append(messages, NewAssistantMessage(example.Assistant))
+			continue
+		}
+
+		assistant := openai.ChatCompletionAssistantMessageParam{}
+		if example.Assistant != "" {
+			assistant.Content.OfString = openai.String(example.Assistant)
+		}
+		for j, call := range example.ToolCalls {
+			assistant.ToolCalls = append(assistant.ToolCalls, openai.ChatCompletionMessageToolCallParam{
+				ID: exampleToolCallID(i, j),
+				Function: openai.ChatCompletionMessageToolCallFunctionParam{
+					Name:      call.Name,
+					Arguments: call.Arguments,
+				},
+			})
+		}
+		messages = append(messages, Message{OfAssistant: &assistant})
+
+		for j, call := range example.ToolCalls {
+			messages = append(messages, ToolMessage(call.Result, exampleToolCallID(i, j)))
+		}
+	}
+	return messages
+}
+
+func exampleToolCallID(example, call int) string {
+	return fmt.Sprintf("example-%d-%d", example, call)
+}
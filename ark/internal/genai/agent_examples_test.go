@@ -0,0 +1,58 @@
+package genai
+
+import (
+	"testing"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func TestExampleMessagesPlainPair(t *testing.T) {
+	messages := exampleMessages([]arkv1alpha1.AgentExample{
+		{User: "what is the capital of France?", Assistant: "Paris"},
+	})
+
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+	if messages[0].OfUser == nil {
+		t.Errorf("expected first message to be a user message")
+	}
+	if messages[1].OfAssistant == nil {
+		t.Errorf("expected second message to be an assistant message")
+	}
+}
+
+func TestExampleMessagesWithToolCalls(t *testing.T) {
+	messages := exampleMessages([]arkv1alpha1.AgentExample{
+		{
+			User: "weather in Boston?",
+			ToolCalls: []arkv1alpha1.AgentExampleToolCall{
+				{Name: "get-weather", Arguments: `{"location":"Boston"}`, Result: "sunny"},
+			},
+		},
+	})
+
+	if len(messages) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(messages))
+	}
+	assistant := messages[1].OfAssistant
+	if assistant == nil || len(assistant.ToolCalls) != 1 {
+		t.Fatalf("expected assistant message with one tool call")
+	}
+	if assistant.ToolCalls[0].Function.Name != "get-weather" {
+		t.Errorf("expected tool call name get-weather, got %s", assistant.ToolCalls[0].Function.Name)
+	}
+	tool := messages[2].OfTool
+	if tool == nil {
+		t.Fatalf("expected tool message")
+	}
+	if tool.ToolCallID != assistant.ToolCalls[0].ID {
+		t.Errorf("expected tool message to reference the demonstrated call")
+	}
+}
+
+func TestExampleMessagesEmpty(t *testing.T) {
+	if messages := exampleMessages(nil); len(messages) != 0 {
+		t.Errorf("expected no messages for no examples, got %d", len(messages))
+	}
+}
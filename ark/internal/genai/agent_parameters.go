@@ -25,7 +25,7 @@ func (a *Agent) resolvePrompt(ctx context.Context) (string, error) {
 	}
 
 	if len(templateData) == 0 {
-		return a.Prompt, nil
+		return applySystemPromptOverride(ctx, a.Prompt), nil
 	}
 
 	tmpl, err := template.New("agent-prompt").Parse(a.Prompt)
@@ -38,7 +38,7 @@ func (a *Agent) resolvePrompt(ctx context.Context) (string, error) {
 		return "", fmt.Errorf("template execution failed: %w", err)
 	}
 
-	return buf.String(), nil
+	return applySystemPromptOverride(ctx, buf.String()), nil
 }
 
 func (a *Agent) resolveParameters(ctx context.Context) (map[string]string, error) {
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -97,6 +98,8 @@ func CreateToolExecutor(ctx context.Context, k8sClient client.Client, tool *arkv
 		return createMCPExecutor(ctx, k8sClient, tool, namespace, mcpPool)
 	case ToolTypeAgent:
 		return createAgentExecutor(ctx, k8sClient, tool, namespace)
+	case ToolTypeJob:
+		return nil, fmt.Errorf("job tool %s runs asynchronously and can only be used as a query target", tool.Name)
 	default:
 		return nil, fmt.Errorf("unsupported tool type %s for tool %s", tool.Spec.Type, tool.Name)
 	}
@@ -191,6 +194,17 @@ func (r *ToolRegistry) registerSingleCustomTool(ctx context.Context, k8sClient c
 		return err
 	}
 
+	if len(tool.Spec.InjectedParameters) > 0 {
+		toolDef.Parameters = stripInjectedParameters(toolDef.Parameters, tool.Spec.InjectedParameters)
+		executor = &InjectingToolExecutor{
+			BaseExecutor: executor,
+			K8sClient:    k8sClient,
+			Namespace:    namespace,
+			Parameters:   tool.Spec.InjectedParameters,
+			ToolName:     tool.Name,
+		}
+	}
+
 	if len(functions) > 0 {
 		executor = &FilteredToolExecutor{
 			BaseExecutor: executor,
@@ -198,10 +212,42 @@ func (r *ToolRegistry) registerSingleCustomTool(ctx context.Context, k8sClient c
 		}
 	}
 
+	if tool.Spec.Sanitization != nil {
+		executor = &SanitizingToolExecutor{
+			BaseExecutor: executor,
+			Sanitization: tool.Spec.Sanitization,
+			ToolName:     tool.Name,
+		}
+	}
+
+	if tool.Spec.Compensation != nil {
+		compensationExecutor, err := r.createCompensationExecutor(ctx, k8sClient, tool.Spec.Compensation.ToolName, namespace)
+		if err != nil {
+			return err
+		}
+		executor = &CompensatingToolExecutor{
+			BaseExecutor:         executor,
+			ToolName:             tool.Name,
+			CompensationTool:     tool.Spec.Compensation.ToolName,
+			CompensationExecutor: compensationExecutor,
+		}
+	}
+
 	r.RegisterTool(toolDef, executor)
 	return nil
 }
 
+// createCompensationExecutor builds the executor for a tool's compensation,
+// independent of whether the agent's toolset includes that tool
+func (r *ToolRegistry) createCompensationExecutor(ctx context.Context, k8sClient client.Client, toolName, namespace string) (ToolExecutor, error) {
+	tool := &arkv1alpha1.Tool{}
+	key := types.NamespacedName{Name: toolName, Namespace: namespace}
+	if err := k8sClient.Get(ctx, key, tool); err != nil {
+		return nil, fmt.Errorf("failed to get compensation tool %v: %w", key, err)
+	}
+	return CreateToolExecutor(ctx, k8sClient, tool, namespace, r.mcpPool)
+}
+
 func (r *ToolRegistry) registerTool(ctx context.Context, k8sClient client.Client, agentTool arkv1alpha1.AgentTool, namespace string) error {
 	switch agentTool.Type {
 	case AgentToolTypeBuiltIn:
@@ -261,12 +307,26 @@ func (a *AgentToolExecutor) Execute(ctx context.Context, call ToolCall, recorder
 		}, fmt.Errorf("input parameter must be a string for agent tool %s", a.AgentName)
 	}
 
+	fullName := fmt.Sprintf("%s/%s", a.Namespace, a.AgentName)
+	if err := checkDelegation(ctx, fullName); err != nil {
+		return ToolResult{
+			ID:    call.ID,
+			Name:  call.Function.Name,
+			Error: err.Error(),
+		}, err
+	}
+	ctx = withDelegationEntry(ctx, fullName)
+
 	// Log the agent execution
 	log := logf.FromContext(ctx)
 	log.Info("calling agent directly", "agent", a.AgentName, "namespace", a.Namespace, "input", inputStr)
 
+	// Collect the delegated agent's token usage separately; the collector
+	// forwards events to the parent recorder so overall totals still include it
+	subCollector := NewTokenUsageCollector(recorder)
+
 	// Create the Agent object using the Agent CRD and recorder
-	agent, err := MakeAgent(ctx, a.k8sClient, a.AgentCRD, recorder)
+	agent, err := MakeAgent(ctx, a.k8sClient, a.AgentCRD, subCollector)
 	if err != nil {
 		return ToolResult{
 			ID:    call.ID,
@@ -294,6 +354,17 @@ func (a *AgentToolExecutor) Execute(ctx context.Context, call ToolCall, recorder
 
 	lastMessage := responseMessages[len(responseMessages)-1]
 
+	if usage := subCollector.GetTokenSummary(); usage.TotalTokens > 0 {
+		recorder.EmitEvent(ctx, corev1.EventTypeNormal, "AgentDelegationComplete", ExecutionEvent{
+			BaseEvent: BaseEvent{Name: a.AgentName, Metadata: map[string]string{
+				"promptTokens":     fmt.Sprintf("%d", usage.PromptTokens),
+				"completionTokens": fmt.Sprintf("%d", usage.CompletionTokens),
+				"totalTokens":      fmt.Sprintf("%d", usage.TotalTokens),
+			}},
+			Type: "agent",
+		})
+	}
+
 	log.Info("agent direct call response", "agent", a.AgentName, "response", lastMessage.OfAssistant.Content.OfString.Value)
 
 	return ToolResult{
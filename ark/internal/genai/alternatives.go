@@ -0,0 +1,80 @@
+package genai
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openai/openai-go"
+)
+
+const (
+	// MaxResponseAlternatives bounds how many extra choices are kept per target
+	MaxResponseAlternatives = 4
+	// MaxAlternativeLength truncates each alternative to keep status small
+	MaxAlternativeLength = 8192
+)
+
+// AlternativesTracker collects completion choices beyond the first when a
+// model is called with n>1, so best-of selection, evaluation, and human
+// review can access every candidate via query status.
+type AlternativesTracker struct {
+	mu           sync.Mutex
+	alternatives []string
+}
+
+const alternativesTrackerKey contextKey = "alternativesTracker"
+
+// WithAlternativesTracker installs an alternatives tracker on the context,
+// returning the tracker so callers can read the candidates after execution
+func WithAlternativesTracker(ctx context.Context) (context.Context, *AlternativesTracker) {
+	tracker := &AlternativesTracker{}
+	return context.WithValue(ctx, alternativesTrackerKey, tracker), tracker
+}
+
+// AlternativesTrackerFromContext returns the tracker installed on the
+// context, or nil when alternative tracking is not active
+func AlternativesTrackerFromContext(ctx context.Context) *AlternativesTracker {
+	if val := ctx.Value(alternativesTrackerKey); val != nil {
+		if tracker, ok := val.(*AlternativesTracker); ok {
+			return tracker
+		}
+	}
+	return nil
+}
+
+// AddChoices records the content of every choice after the first, bounded by
+// MaxResponseAlternatives and truncated to MaxAlternativeLength each
+func (t *AlternativesTracker) AddChoices(choices []openai.ChatCompletionChoice) {
+	if len(choices) < 2 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, choice := range choices[1:] {
+		if len(t.alternatives) >= MaxResponseAlternatives {
+			return
+		}
+		content := choice.Message.Content
+		if len(content) > MaxAlternativeLength {
+			content = content[:MaxAlternativeLength]
+		}
+		t.alternatives = append(t.alternatives, content)
+	}
+}
+
+// RecordAlternativeChoices forwards extra choices to the tracker installed on
+// the context, if any
+func RecordAlternativeChoices(ctx context.Context, choices []openai.ChatCompletionChoice) {
+	if tracker := AlternativesTrackerFromContext(ctx); tracker != nil {
+		tracker.AddChoices(choices)
+	}
+}
+
+func (t *AlternativesTracker) Alternatives() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]string(nil), t.alternatives...)
+}
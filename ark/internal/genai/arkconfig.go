@@ -0,0 +1,30 @@
+package genai
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+// GetArkConfig returns the namespace's "default" ArkConfig, or nil when the
+// namespace has none.
+func GetArkConfig(ctx context.Context, k8sClient client.Client, namespace string) *arkv1alpha1.ArkConfig {
+	var config arkv1alpha1.ArkConfig
+	key := client.ObjectKey{Name: "default", Namespace: namespace}
+	if err := k8sClient.Get(ctx, key, &config); err != nil {
+		return nil
+	}
+	return &config
+}
+
+// GetControllerSettings returns the namespace's controller settings, or nil
+// when the namespace has no ArkConfig or no controller section.
+func GetControllerSettings(ctx context.Context, k8sClient client.Client, namespace string) *arkv1alpha1.ControllerSettings {
+	config := GetArkConfig(ctx, k8sClient, namespace)
+	if config == nil {
+		return nil
+	}
+	return config.Spec.Controller
+}
@@ -0,0 +1,55 @@
+package genai
+
+import (
+	"fmt"
+)
+
+// CapabilityMismatchError reports a request using a feature the chosen model
+// declares unsupported, so callers see a clear failure instead of a provider
+// 400
+type CapabilityMismatchError struct {
+	Model      string
+	Capability string
+}
+
+func (e *CapabilityMismatchError) Error() string {
+	return fmt.Sprintf("CapabilityMismatch: model %s does not support %s", e.Model, e.Capability)
+}
+
+// checkCapabilities validates the request against the model's declared
+// capabilities. Undeclared capabilities are not checked
+func (m *Model) checkCapabilities(messages []Message, hasTools, streaming bool) error {
+	caps := m.Capabilities
+	if caps == nil {
+		return nil
+	}
+
+	if hasTools && caps.Tools != nil && !*caps.Tools {
+		return &CapabilityMismatchError{Model: m.Model, Capability: "tools"}
+	}
+	if streaming && caps.Streaming != nil && !*caps.Streaming {
+		return &CapabilityMismatchError{Model: m.Model, Capability: "streaming"}
+	}
+	if m.OutputSchema != nil && caps.JSONMode != nil && !*caps.JSONMode {
+		return &CapabilityMismatchError{Model: m.Model, Capability: "json_mode (structured output)"}
+	}
+	if caps.Vision != nil && !*caps.Vision && messagesContainImages(messages) {
+		return &CapabilityMismatchError{Model: m.Model, Capability: "vision (image input)"}
+	}
+
+	return nil
+}
+
+func messagesContainImages(messages []Message) bool {
+	for _, message := range messages {
+		if message.OfUser == nil {
+			continue
+		}
+		for _, part := range message.OfUser.Content.OfArrayOfContentParts {
+			if part.OfImageURL != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package genai
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/openai/openai-go/option"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+const chargebackLabelsKey contextKey = "chargebackLabels"
+
+// CollectChargebackLabels resolves the configured chargeback keys against the
+// query's labels, falling back to its annotations
+func CollectChargebackLabels(config *arkv1alpha1.ArkConfig, query *arkv1alpha1.Query) map[string]string {
+	if config == nil || len(config.Spec.ChargebackLabels) == 0 {
+		return nil
+	}
+
+	labels := make(map[string]string)
+	for _, key := range config.Spec.ChargebackLabels {
+		if value, ok := query.Labels[key]; ok {
+			labels[key] = value
+			continue
+		}
+		if value, ok := query.Annotations[key]; ok {
+			labels[key] = value
+		}
+	}
+
+	if len(labels) == 0 {
+		return nil
+	}
+	return labels
+}
+
+// WithChargebackLabels installs the resolved chargeback labels on the context
+// for provider and metrics propagation
+func WithChargebackLabels(ctx context.Context, labels map[string]string) context.Context {
+	return context.WithValue(ctx, chargebackLabelsKey, labels)
+}
+
+// ChargebackLabelsFromContext returns the chargeback labels on the context, or
+// nil when chargeback propagation is not active
+func ChargebackLabelsFromContext(ctx context.Context) map[string]string {
+	if val := ctx.Value(chargebackLabelsKey); val != nil {
+		if labels, ok := val.(map[string]string); ok {
+			return labels
+		}
+	}
+	return nil
+}
+
+// formatChargebackUser renders the chargeback labels as a deterministic
+// key=value list suitable for the provider user field
+func formatChargebackUser(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// chargebackHeaderOptions renders the chargeback labels as gateway request
+// headers of the form X-Ark-<key>
+func chargebackHeaderOptions(ctx context.Context) []option.RequestOption {
+	labels := ChargebackLabelsFromContext(ctx)
+	if len(labels) == 0 {
+		return nil
+	}
+
+	options := make([]option.RequestOption, 0, len(labels))
+	for key, value := range labels {
+		options = append(options, option.WithHeader("X-Ark-"+sanitizeHeaderKey(key), value))
+	}
+	return options
+}
+
+func sanitizeHeaderKey(key string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.':
+			return r
+		default:
+			return '-'
+		}
+	}, key)
+}
@@ -0,0 +1,117 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBreakerFailureThreshold = 5
+	defaultBreakerCooldown         = 30 * time.Second
+)
+
+// CircuitOpenError is returned when a model's circuit breaker is open and the
+// call is rejected without reaching the provider
+type CircuitOpenError struct {
+	Model      string
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker open for model '%s', retry in %s", e.Model, e.RetryAfter.Round(time.Second))
+}
+
+// CircuitState describes a breaker for status reporting
+type CircuitState struct {
+	Open                bool
+	ConsecutiveFailures int
+	RetryAfter          time.Duration
+}
+
+// CircuitBreaker trips after consecutive provider failures for one model and
+// fails calls fast for a cooldown window. After the cooldown one probe call is
+// let through; its outcome closes the breaker or re-arms the cooldown
+type CircuitBreaker struct {
+	mu               sync.Mutex
+	failureThreshold int
+	cooldown         time.Duration
+
+	consecutiveFailures int
+	openedAt            time.Time
+}
+
+var (
+	circuitBreakerMu sync.Mutex
+	circuitBreakers  = make(map[string]*CircuitBreaker)
+)
+
+// CircuitBreakerFor returns the process-wide breaker for the given model,
+// creating it on first use
+func CircuitBreakerFor(modelKey string) *CircuitBreaker {
+	circuitBreakerMu.Lock()
+	defer circuitBreakerMu.Unlock()
+
+	breaker, exists := circuitBreakers[modelKey]
+	if !exists {
+		breaker = &CircuitBreaker{
+			failureThreshold: defaultBreakerFailureThreshold,
+			cooldown:         defaultBreakerCooldown,
+		}
+		circuitBreakers[modelKey] = breaker
+	}
+	return breaker
+}
+
+// Allow returns an error when the breaker is open and the cooldown has not
+// elapsed. Once it elapses one probe call is admitted and the cooldown window
+// restarts, so a still-failing provider is probed at most once per window
+func (b *CircuitBreaker) Allow(model string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.consecutiveFailures < b.failureThreshold {
+		return nil
+	}
+	elapsed := time.Since(b.openedAt)
+	if elapsed >= b.cooldown {
+		b.openedAt = time.Now()
+		return nil
+	}
+	return &CircuitOpenError{Model: model, RetryAfter: b.cooldown - elapsed}
+}
+
+// RecordSuccess closes the breaker
+func (b *CircuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.consecutiveFailures = 0
+}
+
+// RecordFailure counts a provider failure, tripping the breaker when the
+// threshold is reached
+func (b *CircuitBreaker) RecordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.consecutiveFailures++
+	if b.consecutiveFailures >= b.failureThreshold {
+		b.openedAt = time.Now()
+	}
+}
+
+// State reports the breaker for the Model status condition
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state := CircuitState{ConsecutiveFailures: b.consecutiveFailures}
+	if b.consecutiveFailures >= b.failureThreshold {
+		state.Open = true
+		if remaining := b.cooldown - time.Since(b.openedAt); remaining > 0 {
+			state.RetryAfter = remaining
+		}
+	}
+	return state
+}
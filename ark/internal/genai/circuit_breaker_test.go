@@ -0,0 +1,74 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func testBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{failureThreshold: threshold, cooldown: cooldown}
+}
+
+func TestCircuitBreakerTripsAfterConsecutiveFailures(t *testing.T) {
+	breaker := testBreaker(3, time.Minute)
+
+	for range 2 {
+		breaker.RecordFailure()
+	}
+	if err := breaker.Allow("test-model"); err != nil {
+		t.Fatalf("expected calls admitted below the threshold, got %v", err)
+	}
+
+	breaker.RecordFailure()
+	err := breaker.Allow("test-model")
+	var open *CircuitOpenError
+	if !errors.As(err, &open) {
+		t.Fatalf("expected CircuitOpenError, got %v", err)
+	}
+	if open.Model != "test-model" || open.RetryAfter <= 0 {
+		t.Fatalf("unexpected open error: %+v", open)
+	}
+}
+
+func TestCircuitBreakerAdmitsProbeAfterCooldown(t *testing.T) {
+	breaker := testBreaker(1, 10*time.Millisecond)
+	breaker.RecordFailure()
+
+	if err := breaker.Allow("test-model"); err == nil {
+		t.Fatal("expected open breaker to reject within the cooldown")
+	}
+
+	time.Sleep(15 * time.Millisecond)
+	if err := breaker.Allow("test-model"); err != nil {
+		t.Fatalf("expected one probe admitted after the cooldown, got %v", err)
+	}
+	if err := breaker.Allow("test-model"); err == nil {
+		t.Fatal("expected the cooldown to restart after the probe")
+	}
+}
+
+func TestCircuitBreakerSuccessCloses(t *testing.T) {
+	breaker := testBreaker(1, time.Minute)
+	breaker.RecordFailure()
+	breaker.RecordSuccess()
+
+	if err := breaker.Allow("test-model"); err != nil {
+		t.Fatalf("expected closed breaker after success, got %v", err)
+	}
+	if state := breaker.State(); state.Open || state.ConsecutiveFailures != 0 {
+		t.Fatalf("expected closed state, got %+v", state)
+	}
+}
+
+func TestCircuitBreakerForReturnsSameBreaker(t *testing.T) {
+	first := CircuitBreakerFor("default/breaker-model")
+	if first != CircuitBreakerFor("default/breaker-model") {
+		t.Fatal("expected the same breaker for the same model")
+	}
+	if first == CircuitBreakerFor("default/other-model") {
+		t.Fatal("expected distinct breakers per model")
+	}
+}
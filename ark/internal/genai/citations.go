@@ -0,0 +1,76 @@
+package genai
+
+import (
+	"context"
+	"sync"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+const (
+	CitationTypeTool = "tool"
+	CitationTypeURL  = "url"
+)
+
+// CitationTracker collects the sources that contributed to a response - tool
+// results and provider URL annotations - so they can be surfaced on query
+// status for reference rendering and groundedness checks.
+type CitationTracker struct {
+	mu        sync.Mutex
+	citations []arkv1alpha1.Citation
+	seen      map[string]bool
+}
+
+const citationTrackerKey contextKey = "citationTracker"
+
+// WithCitationTracker installs a citation tracker on the context, returning
+// the tracker so callers can read the collected citations after execution
+func WithCitationTracker(ctx context.Context) (context.Context, *CitationTracker) {
+	tracker := &CitationTracker{seen: make(map[string]bool)}
+	return context.WithValue(ctx, citationTrackerKey, tracker), tracker
+}
+
+// CitationTrackerFromContext returns the tracker installed on the context, or
+// nil when citation tracking is not active
+func CitationTrackerFromContext(ctx context.Context) *CitationTracker {
+	if val := ctx.Value(citationTrackerKey); val != nil {
+		if tracker, ok := val.(*CitationTracker); ok {
+			return tracker
+		}
+	}
+	return nil
+}
+
+func (t *CitationTracker) AddToolResult(sourceID, toolName string) {
+	t.add(arkv1alpha1.Citation{
+		Type:     CitationTypeTool,
+		SourceID: sourceID,
+		Tool:     toolName,
+	}, CitationTypeTool+":"+sourceID)
+}
+
+func (t *CitationTracker) AddURLCitation(url, title string) {
+	t.add(arkv1alpha1.Citation{
+		Type:  CitationTypeURL,
+		URL:   url,
+		Title: title,
+	}, CitationTypeURL+":"+url)
+}
+
+func (t *CitationTracker) add(citation arkv1alpha1.Citation, key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.seen[key] {
+		return
+	}
+	t.seen[key] = true
+	t.citations = append(t.citations, citation)
+}
+
+func (t *CitationTracker) Citations() []arkv1alpha1.Citation {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]arkv1alpha1.Citation(nil), t.citations...)
+}
@@ -0,0 +1,104 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/common"
+)
+
+// CompletionSignatureHeader carries the HMAC signature of the completion
+// webhook payload as "t=<unix>,v1=<hex>". v1 is HMAC-SHA256 over "<t>.<body>"
+// keyed with the configured secret; receivers should recompute it and reject
+// timestamps outside a short tolerance window to prevent replay
+const CompletionSignatureHeader = "X-Ark-Signature"
+
+const completionWebhookTimeout = 30 * time.Second
+
+// CompletionWebhookPayload is the body POSTed to a query's completion webhook
+type CompletionWebhookPayload struct {
+	QueryName  string                 `json:"queryName"`
+	Namespace  string                 `json:"namespace"`
+	SessionID  string                 `json:"sessionId,omitempty"`
+	Phase      string                 `json:"phase"`
+	Responses  []arkv1alpha1.Response `json:"responses,omitempty"`
+	TokenUsage arkv1alpha1.TokenUsage `json:"tokenUsage,omitempty"`
+}
+
+// NotifyQueryCompletion delivers the query result to the webhook configured
+// in spec.completionWebhook. It is a no-op when none is configured
+func NotifyQueryCompletion(ctx context.Context, k8sClient client.Client, query *arkv1alpha1.Query) error {
+	if query.Spec.CompletionWebhook == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), completionWebhookTimeout)
+	defer cancel()
+
+	resolver := common.NewValueSourceResolver(k8sClient)
+	url, err := resolver.ResolveValueSource(ctx, query.Spec.CompletionWebhook.URL, query.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to resolve completion webhook URL: %w", err)
+	}
+
+	payload := CompletionWebhookPayload{
+		QueryName:  query.Name,
+		Namespace:  query.Namespace,
+		SessionID:  query.Spec.SessionId,
+		Phase:      query.Status.Phase,
+		Responses:  query.Status.Responses,
+		TokenUsage: query.Status.TokenUsage,
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal completion webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create completion webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if query.Spec.CompletionWebhook.SignatureSecretRef != nil {
+		secret, err := resolver.ResolveValueSource(ctx, *query.Spec.CompletionWebhook.SignatureSecretRef, query.Namespace)
+		if err != nil {
+			return fmt.Errorf("failed to resolve completion webhook signature secret: %w", err)
+		}
+		req.Header.Set(CompletionSignatureHeader, signCompletionPayload(secret, time.Now(), body))
+	}
+
+	httpClient := &http.Client{Timeout: completionWebhookTimeout}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call completion webhook: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("completion webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func signCompletionPayload(secret string, now time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%s,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
@@ -0,0 +1,40 @@
+package genai
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignCompletionPayload(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"queryName":"q1"}`)
+	signature := signCompletionPayload("webhook-secret", now, body)
+
+	parts := strings.SplitN(signature, ",", 2)
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "t=") || !strings.HasPrefix(parts[1], "v1=") {
+		t.Fatalf("unexpected signature format: %q", signature)
+	}
+	if parts[0] != "t=1700000000" {
+		t.Errorf("unexpected timestamp: %q", parts[0])
+	}
+
+	mac := hmac.New(sha256.New, []byte("webhook-secret"))
+	fmt.Fprintf(mac, "1700000000.%s", body)
+	expected := "v1=" + hex.EncodeToString(mac.Sum(nil))
+	if parts[1] != expected {
+		t.Errorf("signature mismatch: got %q want %q", parts[1], expected)
+	}
+}
+
+func TestSignCompletionPayloadDependsOnSecret(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{}`)
+	if signCompletionPayload("a", now, body) == signCompletionPayload("b", now, body) {
+		t.Error("signatures with different secrets must differ")
+	}
+}
@@ -7,9 +7,11 @@ const (
 
 // Model type constants
 const (
-	ModelTypeAzure   = "azure"
-	ModelTypeOpenAI  = "openai"
-	ModelTypeBedrock = "bedrock"
+	ModelTypeAzure     = "azure"
+	ModelTypeOpenAI    = "openai"
+	ModelTypeBedrock   = "bedrock"
+	ModelTypeAnthropic = "anthropic"
+	ModelTypeOllama    = "ollama"
 )
 
 // Agent tool type constants
@@ -31,4 +33,5 @@ const (
 	ToolTypeHTTP  = "http"
 	ToolTypeMCP   = "mcp"
 	ToolTypeAgent = "agent"
+	ToolTypeJob   = "job"
 )
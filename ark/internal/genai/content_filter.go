@@ -0,0 +1,205 @@
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openai/openai-go"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	ContentFilterStrategyFail     = "fail"
+	ContentFilterStrategySanitize = "sanitize"
+	ContentFilterStrategyFallback = "fallback"
+)
+
+// contentFilterFinishReason is both the finish reason and the error code Azure
+// OpenAI uses when a request or completion is suppressed by the content filter
+const contentFilterFinishReason = "content_filter"
+
+// ContentFilterError marks a provider rejection by a content filter, carrying
+// the categories the provider reported as filtered
+type ContentFilterError struct {
+	Categories []string
+	Err        error
+}
+
+func (e *ContentFilterError) Error() string {
+	if len(e.Categories) > 0 {
+		return fmt.Sprintf("content filtered by provider (categories: %s)", strings.Join(e.Categories, ", "))
+	}
+	return "content filtered by provider"
+}
+
+func (e *ContentFilterError) Unwrap() error {
+	return e.Err
+}
+
+// classifyContentFilterError wraps provider errors caused by a content-filter
+// rejection in a ContentFilterError; other errors pass through unchanged
+func classifyContentFilterError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var apiErr *openai.Error
+	if !errors.As(err, &apiErr) || apiErr.Code != contentFilterFinishReason {
+		return err
+	}
+	return &ContentFilterError{Categories: filteredCategories(apiErr.RawJSON()), Err: err}
+}
+
+// contentFilterResponseError returns a ContentFilterError when the provider
+// suppressed the completion output, reported via the finish reason
+func contentFilterResponseError(response *openai.ChatCompletion) error {
+	if response == nil || len(response.Choices) == 0 {
+		return nil
+	}
+	if response.Choices[0].FinishReason != contentFilterFinishReason {
+		return nil
+	}
+	return &ContentFilterError{Categories: filteredCategories(response.RawJSON())}
+}
+
+// filteredCategories extracts the category names marked filtered from a
+// provider payload carrying Azure content_filter_result annotations
+func filteredCategories(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	var doc any
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+		return nil
+	}
+
+	set := map[string]struct{}{}
+	collectFilteredCategories(doc, set)
+	if len(set) == 0 {
+		return nil
+	}
+
+	categories := make([]string, 0, len(set))
+	for category := range set {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+func collectFilteredCategories(node any, set map[string]struct{}) {
+	switch value := node.(type) {
+	case map[string]any:
+		for key, child := range value {
+			if key == "content_filter_result" || key == "content_filter_results" {
+				if results, ok := child.(map[string]any); ok {
+					for category, detail := range results {
+						if fields, ok := detail.(map[string]any); ok {
+							if filtered, ok := fields["filtered"].(bool); ok && filtered {
+								set[category] = struct{}{}
+							}
+						}
+					}
+				}
+				continue
+			}
+			collectFilteredCategories(child, set)
+		}
+	case []any:
+		for _, child := range value {
+			collectFilteredCategories(child, set)
+		}
+	}
+}
+
+// sanitizeMessagesForRetry strips hidden markup that commonly trips provider
+// content filters, returning the sanitized copy and whether anything changed
+func sanitizeMessagesForRetry(messages []Message) ([]Message, bool) {
+	changed := false
+	sanitized := make([]Message, len(messages))
+	for i, message := range messages {
+		sanitized[i] = transformMessageContent(message, func(content string) string {
+			stripped := htmlCommentPattern.ReplaceAllString(content, "")
+			stripped = scriptBlockPattern.ReplaceAllString(stripped, "")
+			stripped = zeroWidthPattern.ReplaceAllString(stripped, "")
+			if stripped != content {
+				changed = true
+			}
+			return stripped
+		})
+	}
+	return sanitized, changed
+}
+
+func transformMessageContent(message Message, transform func(string) string) Message {
+	switch {
+	case message.OfUser != nil && message.OfUser.Content.OfString.Valid():
+		copied := *message.OfUser
+		copied.Content.OfString.Value = transform(copied.Content.OfString.Value)
+		message.OfUser = &copied
+	case message.OfSystem != nil && message.OfSystem.Content.OfString.Valid():
+		copied := *message.OfSystem
+		copied.Content.OfString.Value = transform(copied.Content.OfString.Value)
+		message.OfSystem = &copied
+	case message.OfAssistant != nil && message.OfAssistant.Content.OfString.Valid():
+		copied := *message.OfAssistant
+		copied.Content.OfString.Value = transform(copied.Content.OfString.Value)
+		message.OfAssistant = &copied
+	case message.OfTool != nil && message.OfTool.Content.OfString.Valid():
+		copied := *message.OfTool
+		copied.Content.OfString.Value = transform(copied.Content.OfString.Value)
+		message.OfTool = &copied
+	}
+	return message
+}
+
+// applyContentFilterStrategy runs the model's configured recovery for a
+// content-filter rejection: one retry with sanitized input, one retry against
+// the fallback model, or nothing for the default fail strategy
+func applyContentFilterStrategy(ctx context.Context, k8sClient client.Client, model *Model, namespace string, filtered *ContentFilterError, messages []Message, eventStream EventStreamInterface, tools []openai.ChatCompletionToolParam) (*openai.ChatCompletion, error) {
+	policy := model.ContentFilterPolicy
+	if policy == nil {
+		return nil, filtered
+	}
+	log := logf.FromContext(ctx)
+
+	switch policy.Strategy {
+	case ContentFilterStrategySanitize:
+		sanitized, changed := sanitizeMessagesForRetry(messages)
+		if !changed {
+			return nil, filtered
+		}
+		log.Info("retrying content-filtered call with sanitized input", "model", model.Model, "categories", strings.Join(filtered.Categories, ","))
+		completion, err := model.ChatCompletion(ctx, sanitized, eventStream, 1, tools)
+		if err == nil {
+			RecordServedBy(ctx, model.Model)
+		}
+		return completion, err
+
+	case ContentFilterStrategyFallback:
+		if policy.FallbackModelRef == nil {
+			return nil, filtered
+		}
+		fallback, err := LoadModel(ctx, k8sClient, policy.FallbackModelRef, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load content-filter fallback model %s: %w", policy.FallbackModelRef.Name, err)
+		}
+		fallback.OutputSchema = model.OutputSchema
+		fallback.SchemaName = model.SchemaName
+		fallback.ParallelToolCalls = model.ParallelToolCalls
+		fallback.GenerationOptions = model.GenerationOptions
+		log.Info("retrying content-filtered call on fallback model", "model", model.Model, "fallbackModel", fallback.Model, "categories", strings.Join(filtered.Categories, ","))
+		completion, err := fallback.ChatCompletion(ctx, messages, eventStream, 1, tools)
+		if err == nil {
+			RecordServedBy(ctx, fallback.Model)
+		}
+		return completion, err
+
+	default:
+		return nil, filtered
+	}
+}
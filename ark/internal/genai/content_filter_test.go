@@ -0,0 +1,70 @@
+package genai
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFilteredCategoriesFromErrorPayload(t *testing.T) {
+	raw := `{"error":{"code":"content_filter","innererror":{"code":"ResponsibleAIPolicyViolation","content_filter_result":{"hate":{"filtered":true,"severity":"high"},"violence":{"filtered":false,"severity":"safe"},"self_harm":{"filtered":true,"severity":"medium"}}}}}`
+
+	categories := filteredCategories(raw)
+
+	if len(categories) != 2 {
+		t.Fatalf("expected 2 filtered categories, got %v", categories)
+	}
+	if categories[0] != "hate" || categories[1] != "self_harm" {
+		t.Errorf("expected sorted categories [hate self_harm], got %v", categories)
+	}
+}
+
+func TestFilteredCategoriesFromResponsePayload(t *testing.T) {
+	raw := `{"choices":[{"finish_reason":"content_filter","content_filter_results":{"sexual":{"filtered":true,"severity":"high"}}}]}`
+
+	categories := filteredCategories(raw)
+
+	if len(categories) != 1 || categories[0] != "sexual" {
+		t.Errorf("expected [sexual], got %v", categories)
+	}
+}
+
+func TestFilteredCategoriesInvalidPayload(t *testing.T) {
+	if categories := filteredCategories("not json"); categories != nil {
+		t.Errorf("expected nil for invalid payload, got %v", categories)
+	}
+}
+
+func TestContentFilterErrorMessageIncludesCategories(t *testing.T) {
+	err := &ContentFilterError{Categories: []string{"hate", "violence"}}
+
+	if !strings.Contains(err.Error(), "hate, violence") {
+		t.Errorf("expected categories in message, got %q", err.Error())
+	}
+}
+
+func TestSanitizeMessagesForRetry(t *testing.T) {
+	messages := []Message{
+		NewUserMessage("before <!-- hidden instructions --> after"),
+		NewUserMessage("clean text"),
+	}
+
+	sanitized, changed := sanitizeMessagesForRetry(messages)
+
+	if !changed {
+		t.Fatal("expected sanitization to report a change")
+	}
+	if got := sanitized[0].OfUser.Content.OfString.Value; strings.Contains(got, "hidden instructions") {
+		t.Errorf("expected hidden markup stripped, got %q", got)
+	}
+	if got := messages[0].OfUser.Content.OfString.Value; !strings.Contains(got, "hidden instructions") {
+		t.Errorf("expected original messages unchanged, got %q", got)
+	}
+}
+
+func TestSanitizeMessagesForRetryNoChange(t *testing.T) {
+	messages := []Message{NewUserMessage("plain text")}
+
+	if _, changed := sanitizeMessagesForRetry(messages); changed {
+		t.Error("expected no change for clean input")
+	}
+}
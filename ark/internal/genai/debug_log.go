@@ -0,0 +1,142 @@
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/openai/openai-go"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"mckinsey.com/ark/internal/annotations"
+)
+
+const (
+	debugLogDefaultSampleRate  = 0.1
+	debugLogDefaultDir         = "/tmp/ark-debug-log"
+	debugLogMinInterval        = time.Second
+	debugLogMaxEntrySize       = 64 * 1024
+	debugLogMaxFileSize  int64 = 16 * 1024 * 1024
+)
+
+// base64 data URLs carry attachments - redact them so debug logs stay small
+// and free of document contents
+var dataURLPattern = regexp.MustCompile(`data:[^;"]+;base64,[A-Za-z0-9+/=]+`)
+
+// ModelDebugLogger records sampled full chat completion requests and
+// responses to a rotating NDJSON file, for diagnosing prompt and formatting
+// bugs in production without enabling full tracing. Enabled per Model via the
+// debug-log annotation.
+type ModelDebugLogger struct {
+	mu         sync.Mutex
+	model      string
+	path       string
+	sampleRate float64
+	lastRecord time.Time
+}
+
+// NewModelDebugLoggerFromAnnotations returns a debug logger when the Model's
+// debug-log annotation is set, or nil when debug logging is disabled
+func NewModelDebugLoggerFromAnnotations(modelAnnotations map[string]string, modelName string) *ModelDebugLogger {
+	if modelAnnotations[annotations.ModelDebugLog] != TrueString {
+		return nil
+	}
+
+	sampleRate := debugLogDefaultSampleRate
+	if raw, ok := modelAnnotations[annotations.ModelDebugLogSampleRate]; ok {
+		if parsed, err := strconv.ParseFloat(raw, 64); err == nil && parsed > 0 && parsed <= 1 {
+			sampleRate = parsed
+		}
+	}
+
+	path := modelAnnotations[annotations.ModelDebugLogPath]
+	if path == "" {
+		path = filepath.Join(debugLogDefaultDir, modelName+".ndjson")
+	}
+
+	return &ModelDebugLogger{
+		model:      modelName,
+		path:       path,
+		sampleRate: sampleRate,
+	}
+}
+
+type debugLogEntry struct {
+	Time     time.Time                               `json:"time"`
+	Model    string                                  `json:"model"`
+	Request  []openai.ChatCompletionMessageParamUnion `json:"request"`
+	Response *openai.ChatCompletion                  `json:"response,omitempty"`
+	Error    string                                  `json:"error,omitempty"`
+}
+
+// Record writes a sampled, sanitized, size-capped log entry. Sampling and
+// rate limiting keep the overhead low enough for production use
+func (l *ModelDebugLogger) Record(ctx context.Context, messages []Message, response *openai.ChatCompletion, callErr error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastRecord) < debugLogMinInterval {
+		return
+	}
+	if rand.Float64() >= l.sampleRate { //nolint:gosec
+		return
+	}
+	l.lastRecord = now
+
+	entry := debugLogEntry{
+		Time:     now,
+		Model:    l.model,
+		Request:  make([]openai.ChatCompletionMessageParamUnion, len(messages)),
+		Response: response,
+	}
+	for i, msg := range messages {
+		entry.Request[i] = openai.ChatCompletionMessageParamUnion(msg)
+	}
+	if callErr != nil {
+		entry.Error = callErr.Error()
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to marshal debug log entry", "model", l.model)
+		return
+	}
+	data = dataURLPattern.ReplaceAll(data, []byte("data:redacted"))
+	if len(data) > debugLogMaxEntrySize {
+		data = data[:debugLogMaxEntrySize]
+	}
+
+	if err := l.write(data); err != nil {
+		logf.FromContext(ctx).Error(err, "failed to write debug log entry", "model", l.model, "path", l.path)
+	}
+}
+
+func (l *ModelDebugLogger) write(data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o750); err != nil {
+		return err
+	}
+
+	if info, err := os.Stat(l.path); err == nil && info.Size() > debugLogMaxFileSize {
+		if err := os.Rename(l.path, l.path+".1"); err != nil {
+			return err
+		}
+	}
+
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	_, err = file.Write(append(data, '\n'))
+	return err
+}
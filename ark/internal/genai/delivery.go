@@ -0,0 +1,209 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/common"
+)
+
+const (
+	DeliveryTypeSlack   = "slack"
+	DeliveryTypeTeams   = "teams"
+	DeliveryTypeWebhook = "webhook"
+	DeliveryTypeEmail   = "email"
+)
+
+// deliveryTemplateData is the context available to channel templates
+type deliveryTemplateData struct {
+	Query     string
+	Namespace string
+	Responses []arkv1alpha1.Response
+}
+
+// DeliverQueryResponses posts the completed query's responses to every
+// channel in spec.deliverTo, returning the joined failures. A failed channel
+// never fails the query; the caller surfaces the error on a condition
+func DeliverQueryResponses(ctx context.Context, k8sClient client.Client, query *arkv1alpha1.Query) error {
+	var failures []error
+	for _, ref := range query.Spec.DeliverTo {
+		var channel arkv1alpha1.DeliveryChannel
+		if err := k8sClient.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: query.Namespace}, &channel); err != nil {
+			failures = append(failures, fmt.Errorf("delivery channel '%s': %w", ref.Name, err))
+			continue
+		}
+		if err := deliverToChannel(ctx, k8sClient, &channel, query); err != nil {
+			failures = append(failures, fmt.Errorf("delivery channel '%s': %w", ref.Name, err))
+		}
+	}
+	return errors.Join(failures...)
+}
+
+func deliverToChannel(ctx context.Context, k8sClient client.Client, channel *arkv1alpha1.DeliveryChannel, query *arkv1alpha1.Query) error {
+	body, err := renderDeliveryBody(channel, query)
+	if err != nil {
+		return err
+	}
+
+	resolver := common.NewValueSourceResolver(k8sClient)
+	switch channel.Spec.Type {
+	case DeliveryTypeSlack:
+		if channel.Spec.Config.Slack == nil {
+			return fmt.Errorf("slack configuration missing")
+		}
+		return deliverWebhookText(ctx, resolver, channel.Spec.Config.Slack.WebhookURL, channel.Namespace, body)
+	case DeliveryTypeTeams:
+		if channel.Spec.Config.Teams == nil {
+			return fmt.Errorf("teams configuration missing")
+		}
+		return deliverWebhookText(ctx, resolver, channel.Spec.Config.Teams.WebhookURL, channel.Namespace, body)
+	case DeliveryTypeWebhook:
+		return deliverGenericWebhook(ctx, k8sClient, resolver, channel, query, body)
+	case DeliveryTypeEmail:
+		return deliverEmail(ctx, resolver, channel, query, body)
+	default:
+		return fmt.Errorf("unsupported delivery channel type: %s", channel.Spec.Type)
+	}
+}
+
+// renderDeliveryBody applies the channel template, defaulting to the response
+// contents joined by blank lines
+func renderDeliveryBody(channel *arkv1alpha1.DeliveryChannel, query *arkv1alpha1.Query) (string, error) {
+	data := deliveryTemplateData{
+		Query:     query.Name,
+		Namespace: query.Namespace,
+		Responses: query.Status.Responses,
+	}
+
+	if channel.Spec.Template == "" {
+		contents := make([]string, 0, len(data.Responses))
+		for _, response := range data.Responses {
+			contents = append(contents, response.Content)
+		}
+		return strings.Join(contents, "\n\n"), nil
+	}
+
+	tmpl, err := template.New("delivery").Parse(channel.Spec.Template)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return "", fmt.Errorf("template execution failed: %w", err)
+	}
+	return rendered.String(), nil
+}
+
+// deliverWebhookText posts the Slack/Teams incoming-webhook payload, which
+// both services accept as {"text": ...}
+func deliverWebhookText(ctx context.Context, resolver *common.ValueSourceResolver, source arkv1alpha1.ValueSource, namespace, body string) error {
+	url, err := resolver.ResolveValueSource(ctx, source, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to resolve webhook URL: %w", err)
+	}
+	return postDeliveryJSON(ctx, url, nil, map[string]string{"text": body})
+}
+
+func deliverGenericWebhook(ctx context.Context, k8sClient client.Client, resolver *common.ValueSourceResolver, channel *arkv1alpha1.DeliveryChannel, query *arkv1alpha1.Query, body string) error {
+	config := channel.Spec.Config.Webhook
+	if config == nil {
+		return fmt.Errorf("webhook configuration missing")
+	}
+	url, err := resolver.ResolveValueSource(ctx, config.URL, channel.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to resolve webhook URL: %w", err)
+	}
+
+	headers := make(map[string]string, len(config.Headers))
+	for _, header := range config.Headers {
+		value, err := ResolveHeaderValue(ctx, k8sClient, header, channel.Namespace)
+		if err != nil {
+			return fmt.Errorf("failed to resolve header '%s': %w", header.Name, err)
+		}
+		headers[header.Name] = value
+	}
+
+	payload := map[string]any{
+		"query":     query.Name,
+		"namespace": query.Namespace,
+		"content":   body,
+		"responses": query.Status.Responses,
+	}
+	return postDeliveryJSON(ctx, url, headers, payload)
+}
+
+func deliverEmail(ctx context.Context, resolver *common.ValueSourceResolver, channel *arkv1alpha1.DeliveryChannel, query *arkv1alpha1.Query, body string) error {
+	config := channel.Spec.Config.Email
+	if config == nil {
+		return fmt.Errorf("email configuration missing")
+	}
+	host, err := resolver.ResolveValueSource(ctx, config.Host, channel.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to resolve SMTP host: %w", err)
+	}
+
+	var auth smtp.Auth
+	if config.Username != nil && config.Password != nil {
+		username, err := resolver.ResolveValueSource(ctx, *config.Username, channel.Namespace)
+		if err != nil {
+			return fmt.Errorf("failed to resolve SMTP username: %w", err)
+		}
+		password, err := resolver.ResolveValueSource(ctx, *config.Password, channel.Namespace)
+		if err != nil {
+			return fmt.Errorf("failed to resolve SMTP password: %w", err)
+		}
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	subject := config.Subject
+	if subject == "" {
+		subject = query.Name
+	}
+	port := config.Port
+	if port == 0 {
+		port = 587
+	}
+	message := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n",
+		config.From, strings.Join(config.To, ", "), subject, body)
+	if err := smtp.SendMail(fmt.Sprintf("%s:%d", host, port), auth, config.From, config.To, []byte(message)); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+	return nil
+}
+
+func postDeliveryJSON(ctx context.Context, url string, headers map[string]string, payload any) error {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(encoded))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := sharedProviderHTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("delivery request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("delivery request failed (%d)", resp.StatusCode)
+	}
+	return nil
+}
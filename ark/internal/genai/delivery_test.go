@@ -0,0 +1,149 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func deliveryTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := arkv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+func deliveryTestQuery() *arkv1alpha1.Query {
+	return &arkv1alpha1.Query{
+		ObjectMeta: metav1.ObjectMeta{Name: "report-query", Namespace: "default"},
+		Spec: arkv1alpha1.QuerySpec{
+			DeliverTo: []arkv1alpha1.DeliveryChannelRef{{Name: "report-channel"}},
+		},
+		Status: arkv1alpha1.QueryStatus{
+			Responses: []arkv1alpha1.Response{
+				{Content: "first response"},
+				{Content: "second response"},
+			},
+		},
+	}
+}
+
+func TestDeliverQueryResponsesSlack(t *testing.T) {
+	var payload map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	channel := &arkv1alpha1.DeliveryChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "report-channel", Namespace: "default"},
+		Spec: arkv1alpha1.DeliveryChannelSpec{
+			Type: DeliveryTypeSlack,
+			Config: arkv1alpha1.DeliveryChannelConfig{
+				Slack: &arkv1alpha1.SlackDeliveryConfig{
+					WebhookURL: arkv1alpha1.ValueSource{Value: server.URL},
+				},
+			},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(deliveryTestScheme(t)).WithObjects(channel).Build()
+
+	if err := DeliverQueryResponses(context.Background(), k8sClient, deliveryTestQuery()); err != nil {
+		t.Fatal(err)
+	}
+	if payload["text"] != "first response\n\nsecond response" {
+		t.Fatalf("unexpected slack payload: %+v", payload)
+	}
+}
+
+func TestDeliverQueryResponsesWebhookWithTemplate(t *testing.T) {
+	var payload map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("expected authorization header, got %q", r.Header.Get("Authorization"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	channel := &arkv1alpha1.DeliveryChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "report-channel", Namespace: "default"},
+		Spec: arkv1alpha1.DeliveryChannelSpec{
+			Type:     DeliveryTypeWebhook,
+			Template: "{{.Query}}: {{len .Responses}} responses",
+			Config: arkv1alpha1.DeliveryChannelConfig{
+				Webhook: &arkv1alpha1.WebhookDeliveryConfig{
+					URL: arkv1alpha1.ValueSource{Value: server.URL},
+					Headers: []arkv1alpha1.Header{
+						{Name: "Authorization", Value: arkv1alpha1.HeaderValue{Value: "Bearer token"}},
+					},
+				},
+			},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(deliveryTestScheme(t)).WithObjects(channel).Build()
+
+	if err := DeliverQueryResponses(context.Background(), k8sClient, deliveryTestQuery()); err != nil {
+		t.Fatal(err)
+	}
+	if payload["content"] != "report-query: 2 responses" {
+		t.Fatalf("unexpected webhook content: %+v", payload)
+	}
+	if payload["namespace"] != "default" {
+		t.Fatalf("unexpected webhook namespace: %+v", payload)
+	}
+}
+
+func TestDeliverQueryResponsesReportsFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	channel := &arkv1alpha1.DeliveryChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "report-channel", Namespace: "default"},
+		Spec: arkv1alpha1.DeliveryChannelSpec{
+			Type: DeliveryTypeTeams,
+			Config: arkv1alpha1.DeliveryChannelConfig{
+				Teams: &arkv1alpha1.TeamsDeliveryConfig{
+					WebhookURL: arkv1alpha1.ValueSource{Value: server.URL},
+				},
+			},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(deliveryTestScheme(t)).WithObjects(channel).Build()
+
+	err := DeliverQueryResponses(context.Background(), k8sClient, deliveryTestQuery())
+	if err == nil || !strings.Contains(err.Error(), "report-channel") {
+		t.Fatalf("expected failure naming the channel, got %v", err)
+	}
+}
+
+func TestDeliverQueryResponsesMissingChannel(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().WithScheme(deliveryTestScheme(t)).Build()
+
+	if err := DeliverQueryResponses(context.Background(), k8sClient, deliveryTestQuery()); err == nil {
+		t.Fatal("expected error for missing delivery channel")
+	}
+}
@@ -0,0 +1,77 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CheckModelEgress validates a model base URL against the namespace's egress
+// allowlist, enforced at admission and again at client creation so tenants
+// cannot point Models at arbitrary endpoints. An absent ArkConfig or empty
+// allowlist means no restriction
+func CheckModelEgress(ctx context.Context, k8sClient client.Client, namespace, baseURL string) error {
+	if baseURL == "" {
+		return nil
+	}
+
+	config := GetArkConfig(ctx, k8sClient, namespace)
+	if config == nil || len(config.Spec.EgressAllowlist) == 0 {
+		return nil
+	}
+
+	for _, pattern := range config.Spec.EgressAllowlist {
+		if egressPatternMatches(pattern, baseURL) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("model baseURL %s is not allowed by the namespace egress allowlist", baseURL)
+}
+
+// egressPatternMatches compares the structured parts of the URL rather than
+// raw string prefixes, so a pattern like https://api.openai.com cannot be
+// bypassed by a host that merely starts with it (api.openai.com.evil.net).
+// Patterns are either full URLs (scheme, host, optional port and path prefix)
+// or bare hosts, optionally with a *. wildcard for subdomains
+func egressPatternMatches(pattern, baseURL string) bool {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return false
+	}
+
+	if !strings.Contains(pattern, "://") {
+		return egressHostMatches(pattern, parsed.Hostname())
+	}
+
+	patternURL, err := url.Parse(pattern)
+	if err != nil {
+		return false
+	}
+	if !strings.EqualFold(patternURL.Scheme, parsed.Scheme) {
+		return false
+	}
+	if patternURL.Port() != "" && patternURL.Port() != parsed.Port() {
+		return false
+	}
+	if !egressHostMatches(patternURL.Hostname(), parsed.Hostname()) {
+		return false
+	}
+
+	path := strings.TrimSuffix(patternURL.EscapedPath(), "/")
+	if path == "" {
+		return true
+	}
+	target := parsed.EscapedPath()
+	return target == path || strings.HasPrefix(target, path+"/")
+}
+
+func egressHostMatches(pattern, host string) bool {
+	if after, ok := strings.CutPrefix(pattern, "*."); ok {
+		return strings.EqualFold(host, after) || strings.HasSuffix(strings.ToLower(host), "."+strings.ToLower(after))
+	}
+	return strings.EqualFold(pattern, host)
+}
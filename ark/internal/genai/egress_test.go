@@ -0,0 +1,34 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import "testing"
+
+func TestEgressPatternMatches(t *testing.T) {
+	cases := []struct {
+		name    string
+		pattern string
+		baseURL string
+		want    bool
+	}{
+		{"exact url", "https://api.openai.com", "https://api.openai.com", true},
+		{"url with deeper path", "https://api.openai.com", "https://api.openai.com/v1", true},
+		{"host prefix bypass", "https://api.openai.com", "https://api.openai.com.evil.net/v1", false},
+		{"scheme mismatch", "https://api.openai.com", "http://api.openai.com", false},
+		{"path prefix", "https://api.openai.com/v1", "https://api.openai.com/v1/chat", true},
+		{"path prefix bypass", "https://api.openai.com/v1", "https://api.openai.com/v1evil", false},
+		{"pattern port respected", "https://gateway.internal:8443", "https://gateway.internal:8443/v1", true},
+		{"pattern port mismatch", "https://gateway.internal:8443", "https://gateway.internal:9443/v1", false},
+		{"bare host", "api.openai.com", "https://api.openai.com/v1", true},
+		{"bare host bypass", "api.openai.com", "https://api.openai.com.evil.net/v1", false},
+		{"wildcard subdomain", "*.openai.azure.com", "https://eastus.openai.azure.com/v1", true},
+		{"wildcard root", "*.openai.azure.com", "https://openai.azure.com", true},
+		{"wildcard bypass", "*.openai.azure.com", "https://fake-openai.azure.com.evil.net", false},
+	}
+
+	for _, tc := range cases {
+		if got := egressPatternMatches(tc.pattern, tc.baseURL); got != tc.want {
+			t.Errorf("%s: egressPatternMatches(%q, %q) = %v, want %v", tc.name, tc.pattern, tc.baseURL, got, tc.want)
+		}
+	}
+}
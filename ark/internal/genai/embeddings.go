@@ -0,0 +1,63 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openai/openai-go"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EmbeddingProvider is implemented by providers whose endpoint can embed text
+// through the OpenAI-compatible embeddings API
+type EmbeddingProvider interface {
+	Embed(ctx context.Context, inputs []string) ([][]float64, error)
+}
+
+// LoadEmbeddingProvider resolves a model reference and returns its provider as
+// an embedder, failing when the provider type cannot produce embeddings
+func LoadEmbeddingProvider(ctx context.Context, k8sClient client.Client, modelRef interface{}, namespace string) (EmbeddingProvider, error) {
+	model, err := LoadModel(ctx, k8sClient, modelRef, namespace)
+	if err != nil {
+		return nil, err
+	}
+	embedder, ok := model.Provider.(EmbeddingProvider)
+	if !ok {
+		return nil, fmt.Errorf("model %s (type %s) does not support embeddings", model.Model, model.Type)
+	}
+	return embedder, nil
+}
+
+func embedWithClient(ctx context.Context, client openai.Client, model string, inputs []string) ([][]float64, error) {
+	if len(inputs) == 0 {
+		return nil, nil
+	}
+	response, err := client.Embeddings.New(ctx, openai.EmbeddingNewParams{
+		Model: model,
+		Input: openai.EmbeddingNewParamsInputUnion{OfArrayOfStrings: inputs},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Data) != len(inputs) {
+		return nil, fmt.Errorf("embeddings response returned %d vectors for %d inputs", len(response.Data), len(inputs))
+	}
+	vectors := make([][]float64, len(inputs))
+	for _, item := range response.Data {
+		if item.Index < 0 || item.Index >= int64(len(inputs)) {
+			return nil, fmt.Errorf("embeddings response index %d out of range", item.Index)
+		}
+		vectors[item.Index] = item.Embedding
+	}
+	return vectors, nil
+}
+
+// Embed produces one vector per input using the provider's embeddings endpoint
+func (op *OpenAIProvider) Embed(ctx context.Context, inputs []string) ([][]float64, error) {
+	return embedWithClient(ctx, op.createClient(ctx), op.Model, inputs)
+}
+
+// Embed produces one vector per input using the provider's embeddings endpoint
+func (ap *AzureProvider) Embed(ctx context.Context, inputs []string) ([][]float64, error) {
+	return embedWithClient(ctx, ap.createClient(ctx), ap.Model, inputs)
+}
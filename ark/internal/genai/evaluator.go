@@ -19,11 +19,22 @@ import (
 	"mckinsey.com/ark/internal/common"
 )
 
+// EvaluationProtocolVersion identifies the evaluator HTTP contract. External
+// evaluators should reject requests with an apiVersion they do not understand
+const EvaluationProtocolVersion = "evaluator.ark.mckinsey.com/v1"
+
+// EvaluationRequest is the body POSTed to an evaluator's address. It carries
+// the query input and responses to judge, the evaluator's configured rubric
+// and parameters, and context identifying where the query ran
 type EvaluationRequest struct {
-	QueryID   string                 `json:"queryId"`
-	Input     string                 `json:"input"`
-	Responses []arkv1alpha1.Response `json:"responses"`
-	Query     arkv1alpha1.Query      `json:"query"`
+	APIVersion string                 `json:"apiVersion,omitempty"`
+	QueryID    string                 `json:"queryId"`
+	Input      string                 `json:"input"`
+	Responses  []arkv1alpha1.Response `json:"responses"`
+	Query      arkv1alpha1.Query      `json:"query"`
+	Rubric     string                 `json:"rubric,omitempty"`
+	Parameters map[string]string      `json:"parameters,omitempty"`
+	Context    map[string]string      `json:"context,omitempty"`
 }
 
 // GoldenExample represents a single golden dataset example
@@ -33,9 +44,13 @@ type GoldenExample struct {
 	Metadata       map[string]string `json:"metadata,omitempty"`
 }
 
+// EvaluationResponse is the body an evaluator returns: a score in [0, 1] as a
+// string, a pass/fail verdict, optional reasoning, and free-form metadata. A
+// non-empty error field marks the evaluation as failed
 type EvaluationResponse struct {
 	Score      string                  `json:"score,omitempty"`
 	Passed     bool                    `json:"passed,omitempty"`
+	Reasoning  string                  `json:"reasoning,omitempty"`
 	Metadata   map[string]string       `json:"metadata,omitempty"`
 	Error      string                  `json:"error,omitempty"`
 	TokenUsage *arkv1alpha1.TokenUsage `json:"tokenUsage,omitempty"`
@@ -67,6 +82,12 @@ func CallSingleEvaluator(ctx context.Context, k8sClient client.Client, query ark
 		"evaluator": evaluatorRef.Name,
 	})
 
+	if evaluatorRef.Name == GroundednessEvaluatorName {
+		result := EvaluateGroundedness(query)
+		tracker.Complete(fmt.Sprintf("score: %s, passed: %t", result.Score, result.Passed))
+		return result, nil
+	}
+
 	evaluator, err := loadEvaluator(ctx, k8sClient, evaluatorRef, query.Namespace)
 	if err != nil {
 		tracker.Fail(err)
@@ -79,9 +100,15 @@ func CallSingleEvaluator(ctx context.Context, k8sClient client.Client, query ark
 		return nil, err
 	}
 
+	token, err := resolveEvaluatorToken(ctx, k8sClient, evaluator)
+	if err != nil {
+		tracker.Fail(err)
+		return nil, err
+	}
+
 	// For backward compatibility with query evaluation - evaluation CRDs don't use this path
-	request := buildEvaluationRequest(query)
-	response, err := callEvaluatorHTTP(ctx, address, request)
+	request := buildEvaluationRequest(query, evaluator)
+	response, err := callEvaluatorHTTP(ctx, address, request, evaluatorCallSettings(evaluator, token))
 	if err != nil {
 		tracker.Fail(err)
 		return nil, err
@@ -92,23 +119,36 @@ func CallSingleEvaluator(ctx context.Context, k8sClient client.Client, query ark
 		Passed:   response.Passed,
 		Metadata: response.Metadata,
 	}
+	if response.Reasoning != "" {
+		if result.Metadata == nil {
+			result.Metadata = map[string]string{}
+		}
+		result.Metadata["reasoning"] = response.Reasoning
+	}
 
 	tracker.Complete(fmt.Sprintf("score: %s, passed: %t", response.Score, response.Passed))
 	return result, nil
 }
 
+// maxConcurrentEvaluations bounds evaluator fan-out so a query with many
+// evaluators does not overwhelm evaluator services
+const maxConcurrentEvaluations = 4
+
 func CallEvaluators(ctx context.Context, k8sClient client.Client, query arkv1alpha1.Query, evaluatorRefs []arkv1alpha1.EvaluatorRef, recorder EventEmitter) ([]arkv1alpha1.EvaluationResult, error) {
 	if len(evaluatorRefs) == 0 {
 		return nil, nil
 	}
 
 	results := make([]arkv1alpha1.EvaluationResult, len(evaluatorRefs))
+	semaphore := make(chan struct{}, maxConcurrentEvaluations)
 	var wg sync.WaitGroup
 
 	for i, evaluatorRef := range evaluatorRefs {
 		wg.Add(1)
 		go func(idx int, evalRef arkv1alpha1.EvaluatorRef) {
 			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
 			results[idx] = callEvaluatorWithErrorHandling(ctx, k8sClient, query, evalRef, recorder)
 		}(i, evaluatorRef)
 	}
@@ -158,16 +198,75 @@ func resolveEvaluatorAddress(ctx context.Context, k8sClient client.Client, evalu
 	return address, nil
 }
 
-func buildEvaluationRequest(query arkv1alpha1.Query) EvaluationRequest {
-	return EvaluationRequest{
-		QueryID:   string(query.UID),
-		Input:     query.Spec.Input,
-		Responses: query.Status.Responses,
-		Query:     query,
+func resolveEvaluatorToken(ctx context.Context, k8sClient client.Client, evaluator *arkv1alpha1.Evaluator) (string, error) {
+	if evaluator.Spec.TokenRef == nil {
+		return "", nil
+	}
+	resolver := common.NewValueSourceResolver(k8sClient)
+	token, err := resolver.ResolveValueSource(ctx, *evaluator.Spec.TokenRef, evaluator.Namespace)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve evaluator token: %w", err)
+	}
+	return token, nil
+}
+
+// evaluatorCallSettings collects the per-evaluator transport settings, with
+// protocol defaults applied
+func evaluatorCallSettings(evaluator *arkv1alpha1.Evaluator, token string) callSettings {
+	settings := callSettings{
+		token:      token,
+		timeout:    defaultEvaluatorTimeout,
+		maxRetries: defaultEvaluatorRetries,
+	}
+	if evaluator.Spec.Timeout != nil {
+		settings.timeout = evaluator.Spec.Timeout.Duration
+	}
+	if evaluator.Spec.MaxRetries != nil {
+		settings.maxRetries = *evaluator.Spec.MaxRetries
+	}
+	return settings
+}
+
+func buildEvaluationRequest(query arkv1alpha1.Query, evaluator *arkv1alpha1.Evaluator) EvaluationRequest {
+	request := EvaluationRequest{
+		APIVersion: EvaluationProtocolVersion,
+		QueryID:    string(query.UID),
+		Input:      query.Spec.Input,
+		Responses:  query.Status.Responses,
+		Query:      query,
+		Rubric:     evaluator.Spec.Rubric,
+		Context: map[string]string{
+			"queryName": query.Name,
+			"namespace": query.Namespace,
+			"sessionId": query.Spec.SessionId,
+		},
+	}
+	for _, parameter := range evaluator.Spec.Parameters {
+		if parameter.Value == "" {
+			continue
+		}
+		if request.Parameters == nil {
+			request.Parameters = map[string]string{}
+		}
+		request.Parameters[parameter.Name] = parameter.Value
 	}
+	return request
 }
 
-func callEvaluatorHTTPEndpoint(ctx context.Context, address, endpoint string, request any, timeout time.Duration) (*http.Response, error) {
+const (
+	defaultEvaluatorTimeout = 30 * time.Second
+	defaultEvaluatorRetries = 2
+	evaluatorRetryDelay     = 2 * time.Second
+)
+
+// callSettings carries the transport settings for a single evaluator call
+type callSettings struct {
+	token      string
+	timeout    time.Duration
+	maxRetries int
+}
+
+func callEvaluatorHTTPEndpoint(ctx context.Context, address, endpoint string, request any, timeout time.Duration, token string) (*http.Response, error) {
 	requestBody, err := json.Marshal(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
@@ -189,6 +288,9 @@ func callEvaluatorHTTPEndpoint(ctx context.Context, address, endpoint string, re
 		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
@@ -203,8 +305,28 @@ func callEvaluatorHTTPEndpoint(ctx context.Context, address, endpoint string, re
 	return resp, nil
 }
 
-func callEvaluatorHTTP(ctx context.Context, address string, request EvaluationRequest) (*EvaluationResponse, error) {
-	resp, err := callEvaluatorHTTPEndpoint(ctx, address, "", request, 30*time.Second)
+func callEvaluatorHTTP(ctx context.Context, address string, request EvaluationRequest, settings callSettings) (*EvaluationResponse, error) {
+	var lastErr error
+	for attempt := 0; attempt <= settings.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(evaluatorRetryDelay):
+			}
+		}
+
+		response, err := callEvaluatorHTTPOnce(ctx, address, request, settings)
+		if err == nil {
+			return response, nil
+		}
+		lastErr = err
+	}
+	return nil, fmt.Errorf("evaluator failed after %d attempts: %w", settings.maxRetries+1, lastErr)
+}
+
+func callEvaluatorHTTPOnce(ctx context.Context, address string, request EvaluationRequest, settings callSettings) (*EvaluationResponse, error) {
+	resp, err := callEvaluatorHTTPEndpoint(ctx, address, "", request, settings.timeout, settings.token)
 	if err != nil {
 		return nil, err
 	}
@@ -247,10 +369,16 @@ func CallUnifiedEvaluator(ctx context.Context, k8sClient client.Client, evaluato
 		return nil, err
 	}
 
+	token, err := resolveEvaluatorToken(ctx, k8sClient, evaluator)
+	if err != nil {
+		log.Error(err, "Failed to resolve evaluator token")
+		return nil, err
+	}
+
 	log.Info("Calling unified evaluator HTTP endpoint", "address", address, "requestType", request.Type, "parameters", request.Parameters, "timeout", timeout)
 
 	// Call unified evaluator HTTP endpoint
-	response, err := callUnifiedEvaluatorHTTP(ctx, address, request, timeout)
+	response, err := callUnifiedEvaluatorHTTP(ctx, address, request, timeout, token)
 	if err != nil {
 		log.Error(err, "Unified evaluator HTTP call failed")
 		return nil, err
@@ -260,7 +388,7 @@ func CallUnifiedEvaluator(ctx context.Context, k8sClient client.Client, evaluato
 	return response, nil
 }
 
-func callUnifiedEvaluatorHTTP(ctx context.Context, address string, request UnifiedEvaluationRequest, configuredTimeout time.Duration) (*EvaluationResponse, error) {
+func callUnifiedEvaluatorHTTP(ctx context.Context, address string, request UnifiedEvaluationRequest, configuredTimeout time.Duration, token string) (*EvaluationResponse, error) {
 	// Use configured timeout, with type-specific adjustments if needed
 	timeout := configuredTimeout
 	if request.Type == "baseline" && configuredTimeout < 120*time.Second {
@@ -269,7 +397,7 @@ func callUnifiedEvaluatorHTTP(ctx context.Context, address string, request Unifi
 		logf.Log.Info("Adjusted timeout for baseline evaluation", "configured", configuredTimeout, "adjusted", timeout)
 	}
 
-	resp, err := callEvaluatorHTTPEndpoint(ctx, address, "", request, timeout)
+	resp, err := callEvaluatorHTTPEndpoint(ctx, address, "", request, timeout, token)
 	if err != nil {
 		return nil, err
 	}
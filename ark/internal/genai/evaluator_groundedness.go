@@ -0,0 +1,145 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+// GroundednessEvaluatorName is a reserved evaluator name that runs the
+// built-in groundedness check in-process instead of calling an external
+// evaluator service.
+const GroundednessEvaluatorName = "groundedness"
+
+const (
+	groundednessPassThreshold    = 0.7
+	groundednessSupportThreshold = 0.5
+	maxUnsupportedClaimsReported = 5
+)
+
+// EvaluateGroundedness compares the final answer of each response against the
+// tool results and retrieved context persisted in the raw message history,
+// scoring the fraction of claims with supporting sources and flagging those
+// without any.
+func EvaluateGroundedness(query arkv1alpha1.Query) *arkv1alpha1.EvaluationResult {
+	corpus := collectGroundingContext(query.Status.Responses)
+
+	var totalClaims, supportedClaims int
+	var unsupported []string
+
+	for _, response := range query.Status.Responses {
+		for _, claim := range splitClaims(response.Content) {
+			totalClaims++
+			if claimIsSupported(claim, corpus) {
+				supportedClaims++
+				continue
+			}
+			if len(unsupported) < maxUnsupportedClaimsReported {
+				unsupported = append(unsupported, claim)
+			}
+		}
+	}
+
+	if totalClaims == 0 {
+		return &arkv1alpha1.EvaluationResult{
+			Score:    "0",
+			Passed:   false,
+			Metadata: map[string]string{"error": "no response content to evaluate"},
+		}
+	}
+
+	score := float64(supportedClaims) / float64(totalClaims)
+	metadata := map[string]string{
+		"totalClaims":     fmt.Sprintf("%d", totalClaims),
+		"supportedClaims": fmt.Sprintf("%d", supportedClaims),
+	}
+	if corpus == "" {
+		metadata["warning"] = "no tool results or retrieved context available for grounding"
+	}
+	if len(unsupported) > 0 {
+		metadata["unsupportedClaims"] = strings.Join(unsupported, " | ")
+	}
+
+	return &arkv1alpha1.EvaluationResult{
+		Score:    fmt.Sprintf("%.2f", score),
+		Passed:   score >= groundednessPassThreshold,
+		Metadata: metadata,
+	}
+}
+
+// collectGroundingContext gathers tool result content from the raw message
+// history persisted on each response
+func collectGroundingContext(responses []arkv1alpha1.Response) string {
+	var builder strings.Builder
+
+	for _, response := range responses {
+		if response.Raw == "" {
+			continue
+		}
+
+		var messages []struct {
+			Role    string          `json:"role"`
+			Content json.RawMessage `json:"content"`
+		}
+		if err := json.Unmarshal([]byte(response.Raw), &messages); err != nil {
+			continue
+		}
+
+		for _, message := range messages {
+			if message.Role != RoleTool {
+				continue
+			}
+			var content string
+			if err := json.Unmarshal(message.Content, &content); err != nil {
+				continue
+			}
+			builder.WriteString(strings.ToLower(content))
+			builder.WriteString("\n")
+		}
+	}
+
+	return builder.String()
+}
+
+// splitClaims breaks response content into sentence-level claims
+func splitClaims(content string) []string {
+	var claims []string
+	for _, sentence := range strings.FieldsFunc(content, func(r rune) bool {
+		return r == '.' || r == '!' || r == '?' || r == '\n'
+	}) {
+		sentence = strings.TrimSpace(sentence)
+		if sentence != "" {
+			claims = append(claims, sentence)
+		}
+	}
+	return claims
+}
+
+// claimIsSupported checks whether enough of the claim's significant words
+// appear in the grounding corpus
+func claimIsSupported(claim, corpus string) bool {
+	if corpus == "" {
+		return false
+	}
+
+	var significant, found int
+	for _, word := range strings.Fields(strings.ToLower(claim)) {
+		word = strings.Trim(word, ".,;:\"'()[]")
+		if len(word) <= 3 {
+			continue
+		}
+		significant++
+		if strings.Contains(corpus, word) {
+			found++
+		}
+	}
+
+	if significant == 0 {
+		return true
+	}
+	return float64(found)/float64(significant) >= groundednessSupportThreshold
+}
@@ -0,0 +1,56 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func groundednessQuery(content, raw string) arkv1alpha1.Query {
+	return arkv1alpha1.Query{
+		Status: arkv1alpha1.QueryStatus{
+			Responses: []arkv1alpha1.Response{
+				{Content: content, Raw: raw},
+			},
+		},
+	}
+}
+
+func TestEvaluateGroundednessSupportedClaims(t *testing.T) {
+	raw := `[{"role":"tool","content":"The weather in Boston is sunny with a temperature of 72 degrees","tool_call_id":"call_1"}]`
+	query := groundednessQuery("The weather in Boston is sunny. The temperature is 72 degrees.", raw)
+
+	result := EvaluateGroundedness(query)
+	assert.Equal(t, "1.00", result.Score)
+	assert.True(t, result.Passed)
+	assert.Equal(t, "2", result.Metadata["totalClaims"])
+	assert.Equal(t, "2", result.Metadata["supportedClaims"])
+}
+
+func TestEvaluateGroundednessUnsupportedClaims(t *testing.T) {
+	raw := `[{"role":"tool","content":"The weather in Boston is sunny","tool_call_id":"call_1"}]`
+	query := groundednessQuery("The weather in Boston is sunny. Paris expects thunderstorms tomorrow evening.", raw)
+
+	result := EvaluateGroundedness(query)
+	assert.Equal(t, "0.50", result.Score)
+	assert.False(t, result.Passed)
+	assert.Contains(t, result.Metadata["unsupportedClaims"], "Paris expects thunderstorms")
+}
+
+func TestEvaluateGroundednessNoGroundingContext(t *testing.T) {
+	query := groundednessQuery("Quantum computers outperform classical machines everywhere.", "")
+
+	result := EvaluateGroundedness(query)
+	assert.False(t, result.Passed)
+	assert.Equal(t, "no tool results or retrieved context available for grounding", result.Metadata["warning"])
+}
+
+func TestEvaluateGroundednessNoResponses(t *testing.T) {
+	result := EvaluateGroundedness(arkv1alpha1.Query{})
+	assert.False(t, result.Passed)
+	assert.Equal(t, "no response content to evaluate", result.Metadata["error"])
+}
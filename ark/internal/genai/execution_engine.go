@@ -5,7 +5,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -34,6 +36,9 @@ type ExecutionEngineRequest struct {
 	History []ExecutionEngineMessage `json:"history"`
 	// Available tools
 	Tools []ToolDefinition `json:"tools,omitempty"`
+	// When true the engine may respond with an NDJSON event stream so chunks
+	// reach the query's live stream as they are produced
+	Stream bool `json:"stream,omitempty"`
 }
 
 // AgentConfig contains agent configuration for the execution engine
@@ -67,6 +72,22 @@ type ExecutionEngineResponse struct {
 	TokenUsage TokenUsage               `json:"token_usage,omitempty"`
 }
 
+const (
+	executionEngineStreamMediaType = "application/x-ndjson"
+
+	ExecutionEngineEventChunk    = "chunk"
+	ExecutionEngineEventComplete = "complete"
+)
+
+// ExecutionEngineStreamEvent is one NDJSON line of a streamed engine response.
+// Chunk events carry an OpenAI-format chunk forwarded verbatim to the query's
+// event stream; the final complete event carries the full response
+type ExecutionEngineStreamEvent struct {
+	Type     string                   `json:"type"`
+	Chunk    json.RawMessage          `json:"chunk,omitempty"`
+	Response *ExecutionEngineResponse `json:"response,omitempty"`
+}
+
 // convertToExecutionEngineMessage converts internal genai.Message to ExecutionEngineMessage format
 func convertToExecutionEngineMessage(msg Message) ExecutionEngineMessage {
 	// Handle different message types from OpenAI ChatCompletionMessageParamUnion
@@ -154,7 +175,7 @@ func NewExecutionEngineClient(k8sClient client.Client) *ExecutionEngineClient {
 }
 
 // Execute sends a request to the execution engine and returns the response messages
-func (c *ExecutionEngineClient) Execute(ctx context.Context, engineRef *arkv1alpha1.ExecutionEngineRef, agentConfig AgentConfig, userInput Message, history []Message, tools []ToolDefinition, recorder EventEmitter) ([]Message, error) {
+func (c *ExecutionEngineClient) Execute(ctx context.Context, engineRef *arkv1alpha1.ExecutionEngineRef, agentConfig AgentConfig, userInput Message, history []Message, tools []ToolDefinition, recorder EventEmitter, eventStream EventStreamInterface) ([]Message, error) {
 	// Track ExecutionEngine operation
 	engineTracker := NewOperationTracker(recorder, ctx, "Executor", engineRef.Name, map[string]string{
 		"agent":     agentConfig.Name,
@@ -180,6 +201,7 @@ func (c *ExecutionEngineClient) Execute(ctx context.Context, engineRef *arkv1alp
 		UserInput: convertedUserInput,
 		History:   convertedHistory,
 		Tools:     tools,
+		Stream:    eventStream != nil,
 	}
 
 	requestBody, err := json.Marshal(request)
@@ -197,6 +219,9 @@ func (c *ExecutionEngineClient) Execute(ctx context.Context, engineRef *arkv1alp
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if eventStream != nil {
+		req.Header.Set("Accept", executionEngineStreamMediaType)
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -216,7 +241,14 @@ func (c *ExecutionEngineClient) Execute(ctx context.Context, engineRef *arkv1alp
 	}
 
 	var response ExecutionEngineResponse
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+	if eventStream != nil && strings.HasPrefix(resp.Header.Get("Content-Type"), executionEngineStreamMediaType) {
+		streamed, err := c.readStreamedResponse(ctx, resp.Body, eventStream)
+		if err != nil {
+			engineTracker.Fail(err)
+			return nil, err
+		}
+		response = *streamed
+	} else if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
 		engineTracker.Fail(err)
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
@@ -241,6 +273,36 @@ func (c *ExecutionEngineClient) Execute(ctx context.Context, engineRef *arkv1alp
 	return convertedMessages, nil
 }
 
+// readStreamedResponse consumes an NDJSON engine response, forwarding chunk
+// events to the query's event stream and returning the final complete event
+func (c *ExecutionEngineClient) readStreamedResponse(ctx context.Context, body io.Reader, eventStream EventStreamInterface) (*ExecutionEngineResponse, error) {
+	log := logf.FromContext(ctx)
+	decoder := json.NewDecoder(body)
+	for {
+		var event ExecutionEngineStreamEvent
+		if err := decoder.Decode(&event); err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("execution engine stream ended without a complete event")
+			}
+			return nil, fmt.Errorf("failed to decode stream event: %w", err)
+		}
+
+		switch event.Type {
+		case ExecutionEngineEventChunk:
+			if err := eventStream.StreamChunk(ctx, event.Chunk); err != nil {
+				log.Error(err, "failed to forward execution engine chunk to event stream")
+			}
+		case ExecutionEngineEventComplete:
+			if event.Response == nil {
+				return nil, fmt.Errorf("execution engine complete event missing response")
+			}
+			return event.Response, nil
+		default:
+			log.V(1).Info("ignoring unknown execution engine stream event", "type", event.Type)
+		}
+	}
+}
+
 // resolveExecutionEngineAddress resolves the address of the execution engine
 func (c *ExecutionEngineClient) resolveExecutionEngineAddress(ctx context.Context, engineRef *arkv1alpha1.ExecutionEngineRef, defaultNamespace string) (string, error) {
 	// Resolve execution engine name and namespace
@@ -313,6 +375,10 @@ func buildModelConfig(model *Model) map[string]any {
 			modelConfig["openai"] = configProvider.BuildConfig()
 		case ModelTypeBedrock:
 			modelConfig["bedrock"] = configProvider.BuildConfig()
+		case ModelTypeAnthropic:
+			modelConfig["anthropic"] = configProvider.BuildConfig()
+		case ModelTypeOllama:
+			modelConfig["ollama"] = configProvider.BuildConfig()
 		}
 	}
 
@@ -0,0 +1,173 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"sync"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+// FairShareGate schedules in-flight provider calls for a model shared by
+// several namespaces. Capacity is work-conserving: any namespace may use idle
+// quota up to its burst limit, but while namespaces below their weighted
+// share are waiting, namespaces above theirs are not admitted, so batch load
+// in one namespace cannot starve interactive agents in another
+type FairShareGate struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	maxConcurrent int
+	weights       map[string]int
+	bursts        map[string]int
+
+	total    int
+	inflight map[string]int
+	waiting  map[string]int
+}
+
+var (
+	fairShareMu    sync.Mutex
+	fairShareGates = make(map[string]*FairShareGate)
+)
+
+// fairShareGateFor returns the process-wide gate for the given model,
+// creating it on first use and refreshing its limits from the spec so config
+// changes apply to calls already queued
+func fairShareGateFor(modelKey string, spec *arkv1alpha1.ModelFairShare) *FairShareGate {
+	if spec == nil {
+		return nil
+	}
+
+	fairShareMu.Lock()
+	gate, exists := fairShareGates[modelKey]
+	if !exists {
+		gate = &FairShareGate{
+			inflight: make(map[string]int),
+			waiting:  make(map[string]int),
+		}
+		gate.cond = sync.NewCond(&gate.mu)
+		fairShareGates[modelKey] = gate
+	}
+	fairShareMu.Unlock()
+
+	gate.configure(spec)
+	return gate
+}
+
+func (g *FairShareGate) configure(spec *arkv1alpha1.ModelFairShare) {
+	weights := make(map[string]int, len(spec.Namespaces))
+	bursts := make(map[string]int, len(spec.Namespaces))
+	for _, ns := range spec.Namespaces {
+		weight := 1
+		if ns.Weight != nil {
+			weight = *ns.Weight
+		}
+		weights[ns.Namespace] = weight
+		if ns.Burst != nil {
+			bursts[ns.Namespace] = *ns.Burst
+		}
+	}
+
+	g.mu.Lock()
+	g.maxConcurrent = spec.MaxConcurrent
+	g.weights = weights
+	g.bursts = bursts
+	g.mu.Unlock()
+	g.cond.Broadcast()
+}
+
+// Acquire blocks until the namespace may start a provider call, returning a
+// release function the caller must invoke when the call finishes
+func (g *FairShareGate) Acquire(ctx context.Context, namespace string) (func(), error) {
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			g.cond.Broadcast()
+		case <-stop:
+		}
+	}()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.waiting[namespace]++
+	for !g.canAdmit(namespace) {
+		if ctx.Err() != nil {
+			g.decrement(g.waiting, namespace)
+			return nil, ctx.Err()
+		}
+		g.cond.Wait()
+	}
+	g.decrement(g.waiting, namespace)
+	g.inflight[namespace]++
+	g.total++
+
+	return func() {
+		g.mu.Lock()
+		g.decrement(g.inflight, namespace)
+		g.total--
+		g.mu.Unlock()
+		g.cond.Broadcast()
+	}, nil
+}
+
+func (g *FairShareGate) canAdmit(namespace string) bool {
+	if g.total >= g.maxConcurrent {
+		return false
+	}
+	if burst, ok := g.bursts[namespace]; ok && g.inflight[namespace] >= burst {
+		return false
+	}
+	if g.inflight[namespace] < g.share(namespace) {
+		return true
+	}
+	for other, count := range g.waiting {
+		if other == namespace || count == 0 {
+			continue
+		}
+		if g.inflight[other] < g.share(other) {
+			return false
+		}
+	}
+	return true
+}
+
+// share computes the namespace's weighted portion of the quota over the
+// namespaces currently active on this gate, guaranteeing at least one slot
+func (g *FairShareGate) share(namespace string) int {
+	activeWeight := g.weight(namespace)
+	for other := range g.inflight {
+		if other != namespace && g.inflight[other] > 0 {
+			activeWeight += g.weight(other)
+		}
+	}
+	for other, count := range g.waiting {
+		if other != namespace && count > 0 && g.inflight[other] == 0 {
+			activeWeight += g.weight(other)
+		}
+	}
+
+	share := g.maxConcurrent * g.weight(namespace) / activeWeight
+	if share < 1 {
+		share = 1
+	}
+	return share
+}
+
+func (g *FairShareGate) weight(namespace string) int {
+	if weight, ok := g.weights[namespace]; ok {
+		return weight
+	}
+	return 1
+}
+
+func (g *FairShareGate) decrement(counts map[string]int, namespace string) {
+	counts[namespace]--
+	if counts[namespace] <= 0 {
+		delete(counts, namespace)
+	}
+}
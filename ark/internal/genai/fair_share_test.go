@@ -0,0 +1,136 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func intPointer(value int) *int {
+	return &value
+}
+
+func testFairShareGate(spec arkv1alpha1.ModelFairShare) *FairShareGate {
+	gate := &FairShareGate{
+		inflight: make(map[string]int),
+		waiting:  make(map[string]int),
+	}
+	gate.cond = sync.NewCond(&gate.mu)
+	gate.configure(&spec)
+	return gate
+}
+
+func mustAcquire(t *testing.T, gate *FairShareGate, namespace string) func() {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	release, err := gate.Acquire(ctx, namespace)
+	if err != nil {
+		t.Fatalf("unexpected acquire error for %s: %v", namespace, err)
+	}
+	return release
+}
+
+func TestFairShareAllowsIdleCapacity(t *testing.T) {
+	gate := testFairShareGate(arkv1alpha1.ModelFairShare{MaxConcurrent: 4})
+
+	for i := 0; i < 4; i++ {
+		defer mustAcquire(t, gate, "batch")()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := gate.Acquire(ctx, "batch"); err == nil {
+		t.Error("expected acquire beyond maxConcurrent to block until cancellation")
+	}
+}
+
+func TestFairShareEnforcesBurstLimit(t *testing.T) {
+	gate := testFairShareGate(arkv1alpha1.ModelFairShare{
+		MaxConcurrent: 4,
+		Namespaces: []arkv1alpha1.FairShareNamespace{
+			{Namespace: "batch", Burst: intPointer(2)},
+		},
+	})
+
+	defer mustAcquire(t, gate, "batch")()
+	defer mustAcquire(t, gate, "batch")()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := gate.Acquire(ctx, "batch"); err == nil {
+		t.Error("expected acquire beyond burst to block until cancellation")
+	}
+
+	defer mustAcquire(t, gate, "interactive")()
+}
+
+func TestFairShareAdmitsWaiterWhenSlotFrees(t *testing.T) {
+	gate := testFairShareGate(arkv1alpha1.ModelFairShare{MaxConcurrent: 2})
+
+	releaseFirst := mustAcquire(t, gate, "batch")
+	defer mustAcquire(t, gate, "batch")()
+
+	acquired := make(chan struct{})
+	go func() {
+		release, err := gate.Acquire(context.Background(), "interactive")
+		if err == nil {
+			release()
+		}
+		close(acquired)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	releaseFirst()
+
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("expected waiting namespace to be admitted when a slot freed")
+	}
+}
+
+func TestFairShareHoldsSaturatingNamespaceAtItsShare(t *testing.T) {
+	gate := testFairShareGate(arkv1alpha1.ModelFairShare{MaxConcurrent: 4})
+
+	releases := []func(){mustAcquire(t, gate, "batch"), mustAcquire(t, gate, "batch"), mustAcquire(t, gate, "batch")}
+	defer func() {
+		for _, release := range releases[1:] {
+			release()
+		}
+	}()
+
+	interactiveAdmitted := make(chan struct{})
+	go func() {
+		release, err := gate.Acquire(context.Background(), "interactive")
+		if err == nil {
+			defer release()
+		}
+		close(interactiveAdmitted)
+		time.Sleep(100 * time.Millisecond)
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	batchAdmitted := make(chan struct{})
+	go func() {
+		release, err := gate.Acquire(context.Background(), "batch")
+		if err == nil {
+			release()
+		}
+		close(batchAdmitted)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	releases[0]()
+
+	select {
+	case <-interactiveAdmitted:
+	case <-time.After(time.Second):
+		t.Fatal("expected the freed slot to go to the namespace below its share")
+	}
+}
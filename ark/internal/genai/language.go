@@ -0,0 +1,115 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"strings"
+	"unicode"
+)
+
+// latinStopwords maps high-frequency function words to ISO 639-1 codes for
+// Latin-script languages, where script ranges alone cannot distinguish
+var latinStopwords = map[string]map[string]bool{
+	"en": wordSet("the", "and", "is", "are", "was", "you", "that", "this", "with", "have", "what", "how"),
+	"es": wordSet("el", "la", "los", "las", "es", "una", "que", "como", "para", "por", "está", "qué"),
+	"fr": wordSet("le", "la", "les", "est", "une", "que", "et", "pour", "dans", "vous", "avec", "c'est"),
+	"de": wordSet("der", "die", "das", "ist", "und", "ein", "eine", "nicht", "mit", "für", "wie", "ich"),
+	"it": wordSet("il", "lo", "gli", "è", "una", "che", "per", "con", "come", "sono", "della", "questo"),
+	"pt": wordSet("o", "os", "as", "é", "uma", "que", "para", "com", "como", "não", "está", "você"),
+	"nl": wordSet("de", "het", "een", "en", "is", "van", "dat", "niet", "met", "voor", "hoe", "ik"),
+}
+
+func wordSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
+
+// DetectLanguage returns the ISO 639-1 code of the input's likely language,
+// or empty when detection is inconclusive. Non-Latin scripts are identified
+// by their Unicode ranges; Latin-script languages by stopword frequency. The
+// detector is heuristic and intended for routing, not linguistic accuracy
+func DetectLanguage(text string) string {
+	if code := detectByScript(text); code != "" {
+		return code
+	}
+	return detectLatinLanguage(text)
+}
+
+func detectByScript(text string) string {
+	counts := map[string]int{}
+	var letters int
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		switch {
+		case unicode.Is(unicode.Cyrillic, r):
+			counts["ru"]++
+		case unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r):
+			counts["ja"]++
+		case unicode.Is(unicode.Hangul, r):
+			counts["ko"]++
+		case unicode.Is(unicode.Han, r):
+			counts["zh"]++
+		case unicode.Is(unicode.Arabic, r):
+			counts["ar"]++
+		case unicode.Is(unicode.Devanagari, r):
+			counts["hi"]++
+		case unicode.Is(unicode.Greek, r):
+			counts["el"]++
+		case unicode.Is(unicode.Hebrew, r):
+			counts["he"]++
+		case unicode.Is(unicode.Thai, r):
+			counts["th"]++
+		}
+	}
+	if letters == 0 {
+		return ""
+	}
+
+	// Kana distinguishes Japanese from Chinese text sharing Han characters
+	if counts["ja"] > 0 && counts["zh"] > 0 {
+		counts["ja"] += counts["zh"]
+		counts["zh"] = 0
+	}
+
+	var best string
+	var bestCount int
+	for code, count := range counts {
+		if count > bestCount {
+			best, bestCount = code, count
+		}
+	}
+	if bestCount*2 > letters {
+		return best
+	}
+	return ""
+}
+
+func detectLatinLanguage(text string) string {
+	scores := map[string]int{}
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		word = strings.Trim(word, ".,!?;:\"()¿¡")
+		for code, stopwords := range latinStopwords {
+			if stopwords[word] {
+				scores[code]++
+			}
+		}
+	}
+
+	var best string
+	var bestScore int
+	for code, score := range scores {
+		if score > bestScore {
+			best, bestScore = code, score
+		}
+	}
+	if bestScore >= 2 {
+		return best
+	}
+	return ""
+}
@@ -0,0 +1,41 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import "testing"
+
+func TestDetectLanguageByScript(t *testing.T) {
+	cases := map[string]string{
+		"Какая сегодня погода в Москве?": "ru",
+		"今日の東京の天気はどうですか":                 "ja",
+		"今天北京的天气怎么样":                     "zh",
+		"서울의 날씨는 어떻습니까":                  "ko",
+		"ما هو الطقس في القاهرة اليوم":   "ar",
+	}
+	for input, want := range cases {
+		if got := DetectLanguage(input); got != want {
+			t.Errorf("DetectLanguage(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestDetectLanguageLatinStopwords(t *testing.T) {
+	cases := map[string]string{
+		"What is the weather like and how warm is it today": "en",
+		"¿Qué tiempo hace hoy en la ciudad para una visita?": "es",
+		"Quel est le temps pour la journée dans les Alpes":   "fr",
+	}
+	for input, want := range cases {
+		if got := DetectLanguage(input); got != want {
+			t.Errorf("DetectLanguage(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestDetectLanguageInconclusive(t *testing.T) {
+	for _, input := range []string{"", "12345", "xyzzy plugh"} {
+		if got := DetectLanguage(input); got != "" {
+			t.Errorf("DetectLanguage(%q) = %q, want empty", input, got)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/openai/openai-go"
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/common"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -18,12 +19,42 @@ const (
 	DefaultTimeoutSeconds = 30 // Default timeout in seconds
 	ContentTypeJSON       = "application/json"
 	MessagesEndpoint      = "/messages"
+	MetadataEndpoint      = "/metadata"
+	CapabilitiesEndpoint  = "/capabilities"
 	CompletionEndpoint    = "/stream/%s/complete"
 	MaxRetries            = 3
 	RetryDelay            = 100 * time.Millisecond
 	UserAgent             = "ark-memory-client/1.0"
+	// MemoryReadyCondition is the condition type maintained by the memory
+	// readiness probe
+	MemoryReadyCondition = "Ready"
 )
 
+// ManagedMemoryPort is the port the managed reference memory service listens on
+const ManagedMemoryPort = 8080
+
+// ManagedMemoryServiceName returns the name of the Service, Deployment and
+// volume claim the controller owns for a managed memory
+func ManagedMemoryServiceName(memoryName string) string {
+	return memoryName + "-memory"
+}
+
+// ManagedMemoryAddress returns the in-cluster address of a managed memory's
+// owned Service
+func ManagedMemoryAddress(memory *arkv1alpha1.Memory) string {
+	return fmt.Sprintf("http://%s.%s.svc.cluster.local:%d", ManagedMemoryServiceName(memory.Name), memory.Namespace, ManagedMemoryPort)
+}
+
+// ResolveMemoryAddress returns the address clients should use for a memory:
+// the owned Service for managed memories, otherwise the resolved spec.address
+func ResolveMemoryAddress(ctx context.Context, k8sClient client.Client, memory *arkv1alpha1.Memory) (string, error) {
+	if memory.Spec.Managed {
+		return ManagedMemoryAddress(memory), nil
+	}
+	resolver := common.NewValueSourceResolver(k8sClient)
+	return resolver.ResolveValueSource(ctx, memory.Spec.Address, memory.Namespace)
+}
+
 // getMemoryTimeout reads ARK_MEMORY_HTTP_TIMEOUT_SECONDS env var or returns default
 func getMemoryTimeout() time.Duration {
 	if timeoutStr := os.Getenv("ARK_MEMORY_HTTP_TIMEOUT_SECONDS"); timeoutStr != "" {
@@ -36,25 +67,70 @@ func getMemoryTimeout() time.Duration {
 }
 
 type MemoryInterface interface {
-	AddMessages(ctx context.Context, queryID string, messages []Message) error
+	AddMessages(ctx context.Context, messages []Message) error
 	GetMessages(ctx context.Context) ([]Message, error)
 	Close() error
 }
 
+// MetadataInterface is implemented by memory backends that can store
+// conversation-scoped metadata alongside messages
+type MetadataInterface interface {
+	PutMetadata(ctx context.Context, key, value string) error
+	GetMetadata(ctx context.Context, key string) (string, bool, error)
+}
+
+// MemoryContext identifies the conversation a memory client operates on.
+// Every constructor takes it so all implementations resolve sessions and
+// queries the same way.
+type MemoryContext struct {
+	SessionID      string
+	ConversationID string
+	QueryName      string
+	QueryID        string
+	// Thread subdivides the conversation, e.g. per query target; empty means
+	// the shared conversation thread
+	Thread string
+}
+
+// ThreadScoper is implemented by memory clients that can scope reads and
+// writes to a thread within the conversation
+type ThreadScoper interface {
+	WithThread(thread string) MemoryInterface
+}
+
+// ScopeMemoryToThread returns a memory handle scoped to the given thread, or
+// the memory unchanged when the client does not support thread scoping
+func ScopeMemoryToThread(memory MemoryInterface, thread string) MemoryInterface {
+	if scoper, ok := memory.(ThreadScoper); ok {
+		return scoper.WithThread(thread)
+	}
+	return memory
+}
+
 type Config struct {
 	Timeout    time.Duration
 	MaxRetries int
 	RetryDelay time.Duration
-	SessionId  string
-	QueryName  string
 }
 
 type MessagesRequest struct {
 	SessionID string                                   `json:"session_id"`
 	QueryID   string                                   `json:"query_id"`
+	Thread    string                                   `json:"thread,omitempty"`
 	Messages  []openai.ChatCompletionMessageParamUnion `json:"messages"`
 }
 
+type MetadataRequest struct {
+	SessionID string `json:"session_id"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+}
+
+type MetadataResponse struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type MessageRecord struct {
 	ID        int64           `json:"id"`
 	SessionID string          `json:"session_id"`
@@ -79,17 +155,19 @@ func DefaultConfig() Config {
 }
 
 func NewMemory(ctx context.Context, k8sClient client.Client, memoryName, namespace string, recorder EventEmitter) (MemoryInterface, error) {
-	return NewMemoryWithConfig(ctx, k8sClient, memoryName, namespace, recorder, DefaultConfig())
+	return NewMemoryWithConfig(ctx, k8sClient, memoryName, namespace, recorder, MemoryContext{}, DefaultConfig())
 }
 
-func NewMemoryWithConfig(ctx context.Context, k8sClient client.Client, memoryName, namespace string, recorder EventEmitter, config Config) (MemoryInterface, error) {
-	return NewHTTPMemory(ctx, k8sClient, memoryName, namespace, recorder, config)
+func NewMemoryWithConfig(ctx context.Context, k8sClient client.Client, memoryName, namespace string, recorder EventEmitter, memoryCtx MemoryContext, config Config) (MemoryInterface, error) {
+	return NewHTTPMemory(ctx, k8sClient, memoryName, namespace, recorder, memoryCtx, config)
 }
 
-func NewMemoryForQuery(ctx context.Context, k8sClient client.Client, memoryRef *arkv1alpha1.MemoryRef, namespace string, recorder EventEmitter, sessionId, queryName string) (MemoryInterface, error) {
+func NewMemoryForQuery(ctx context.Context, k8sClient client.Client, memoryRef *arkv1alpha1.MemoryRef, namespace string, recorder EventEmitter, memoryCtx MemoryContext, input string) (MemoryInterface, error) {
 	config := DefaultConfig()
-	config.SessionId = sessionId
-	config.QueryName = queryName
+
+	if settings := GetControllerSettings(ctx, k8sClient, namespace); settings != nil && settings.MemoryHTTPTimeout != nil {
+		config.Timeout = settings.MemoryHTTPTimeout.Duration
+	}
 
 	var memoryName, memoryNamespace string
 
@@ -106,12 +184,24 @@ func NewMemoryForQuery(ctx context.Context, k8sClient client.Client, memoryRef *
 		memoryNamespace = resolveNamespace(memoryRef.Namespace, namespace)
 	}
 
-	memory, err := NewMemoryWithConfig(ctx, k8sClient, memoryName, memoryNamespace, recorder, config)
+	memory, err := NewMemoryWithConfig(ctx, k8sClient, memoryName, memoryNamespace, recorder, memoryCtx, config)
 	if err != nil {
 		return nil, err
 	}
 
-	return memory, nil
+	memoryCRD, err := getMemoryResource(ctx, k8sClient, memoryName, memoryNamespace)
+	if err != nil {
+		return nil, err
+	}
+	if recall := memoryCRD.Spec.SemanticRecall; recall != nil && input != "" {
+		embedder, err := LoadEmbeddingProvider(ctx, k8sClient, &recall.ModelRef, memoryNamespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load semantic recall model for memory %s/%s: %w", memoryNamespace, memoryName, err)
+		}
+		memory = NewSemanticRecallMemory(memory, embedder, input, recall)
+	}
+
+	return NewBufferedMemory(memory), nil
 }
 
 func getMemoryResource(ctx context.Context, k8sClient client.Client, name, namespace string) (*arkv1alpha1.Memory, error) {
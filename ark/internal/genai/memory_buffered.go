@@ -0,0 +1,155 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"sync"
+)
+
+// memoryFlushThreshold is the number of buffered messages that triggers an
+// immediate write-through to the backend
+const memoryFlushThreshold = 20
+
+// MemoryFlusher is implemented by memory clients that buffer writes and need
+// an explicit flush at query boundaries
+type MemoryFlusher interface {
+	Flush(ctx context.Context) error
+}
+
+// FlushMemory flushes buffered writes if the memory client buffers them;
+// other clients are a no-op
+func FlushMemory(ctx context.Context, memory MemoryInterface) error {
+	if flusher, ok := memory.(MemoryFlusher); ok {
+		return flusher.Flush(ctx)
+	}
+	return nil
+}
+
+// BufferedMemory is a write-behind wrapper around a memory client. Writes
+// accumulate in memory and are sent to the backend in one batch when the
+// buffer reaches a size threshold, when messages are read back, or when the
+// query flushes at completion, cutting per-turn HTTP chatter to the memory
+// service. Reads go through a per-query cache shared across the targets of
+// the same execution and invalidated on writes, so fan-out queries fetch the
+// conversation once instead of once per target
+type BufferedMemory struct {
+	inner     MemoryInterface
+	threshold int
+
+	mu         sync.Mutex
+	pending    []Message
+	cached     []Message
+	cacheValid bool
+}
+
+func NewBufferedMemory(inner MemoryInterface) *BufferedMemory {
+	return &BufferedMemory{inner: inner, threshold: memoryFlushThreshold}
+}
+
+// AddMessages buffers the messages, flushing to the backend once the buffer
+// reaches the flush threshold
+func (b *BufferedMemory) AddMessages(ctx context.Context, messages []Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	b.pending = append(b.pending, messages...)
+	b.cached = nil
+	b.cacheValid = false
+	shouldFlush := len(b.pending) >= b.threshold
+	b.mu.Unlock()
+
+	if shouldFlush {
+		return b.Flush(ctx)
+	}
+	return nil
+}
+
+// GetMessages flushes buffered writes first so reads observe them, then
+// serves from the read cache when a previous read is still valid
+func (b *BufferedMemory) GetMessages(ctx context.Context) ([]Message, error) {
+	if err := b.Flush(ctx); err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	if b.cacheValid {
+		messages := append([]Message{}, b.cached...)
+		b.mu.Unlock()
+		return messages, nil
+	}
+	b.mu.Unlock()
+
+	messages, err := b.inner.GetMessages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	if len(b.pending) == 0 {
+		b.cached = append([]Message{}, messages...)
+		b.cacheValid = true
+	}
+	b.mu.Unlock()
+
+	return messages, nil
+}
+
+// Flush writes all buffered messages to the backend in one call. On failure
+// the messages are re-queued so a later flush or Close can retry them
+func (b *BufferedMemory) Flush(ctx context.Context) error {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = nil
+	b.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if err := b.inner.AddMessages(ctx, pending); err != nil {
+		b.mu.Lock()
+		b.pending = append(pending, b.pending...)
+		b.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Close flushes any remaining buffered messages before closing the backend
+// client. A fresh context is used so buffered writes survive cancellation on
+// error paths
+func (b *BufferedMemory) Close() error {
+	flushErr := b.Flush(context.Background())
+	if closeErr := b.inner.Close(); closeErr != nil {
+		return closeErr
+	}
+	return flushErr
+}
+
+// WithThread returns a fresh buffered wrapper scoped to the given thread,
+// with its own write buffer and read cache
+func (b *BufferedMemory) WithThread(thread string) MemoryInterface {
+	if scoper, ok := b.inner.(ThreadScoper); ok {
+		return NewBufferedMemory(scoper.WithThread(thread))
+	}
+	return b
+}
+
+// PutMetadata forwards to the backend when it supports metadata
+func (b *BufferedMemory) PutMetadata(ctx context.Context, key, value string) error {
+	if metadata, ok := b.inner.(MetadataInterface); ok {
+		return metadata.PutMetadata(ctx, key, value)
+	}
+	return nil
+}
+
+// GetMetadata forwards to the backend when it supports metadata
+func (b *BufferedMemory) GetMetadata(ctx context.Context, key string) (string, bool, error) {
+	if metadata, ok := b.inner.(MetadataInterface); ok {
+		return metadata.GetMetadata(ctx, key)
+	}
+	return "", false, nil
+}
@@ -0,0 +1,158 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeMemory struct {
+	batches  [][]Message
+	stored   []Message
+	getCalls int
+	addErr   error
+	closed   bool
+}
+
+func (f *fakeMemory) AddMessages(ctx context.Context, messages []Message) error {
+	if f.addErr != nil {
+		return f.addErr
+	}
+	f.batches = append(f.batches, messages)
+	f.stored = append(f.stored, messages...)
+	return nil
+}
+
+func (f *fakeMemory) GetMessages(ctx context.Context) ([]Message, error) {
+	f.getCalls++
+	return f.stored, nil
+}
+
+func (f *fakeMemory) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestBufferedMemoryBatchesWrites(t *testing.T) {
+	ctx := context.Background()
+	inner := &fakeMemory{}
+	memory := NewBufferedMemory(inner)
+
+	_ = memory.AddMessages(ctx, []Message{NewUserMessage("one")})
+	_ = memory.AddMessages(ctx, []Message{NewAssistantMessage("two")})
+	if len(inner.batches) != 0 {
+		t.Fatalf("expected no backend writes before flush, got %d", len(inner.batches))
+	}
+
+	if err := memory.Flush(ctx); err != nil {
+		t.Fatalf("unexpected flush error: %v", err)
+	}
+	if len(inner.batches) != 1 || len(inner.batches[0]) != 2 {
+		t.Errorf("expected one batch of 2 messages, got %v", inner.batches)
+	}
+}
+
+func TestBufferedMemoryFlushesAtThreshold(t *testing.T) {
+	ctx := context.Background()
+	inner := &fakeMemory{}
+	memory := NewBufferedMemory(inner)
+
+	messages := make([]Message, memoryFlushThreshold)
+	for i := range messages {
+		messages[i] = NewUserMessage("turn")
+	}
+	if err := memory.AddMessages(ctx, messages); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(inner.batches) != 1 {
+		t.Errorf("expected threshold to trigger a flush, got %d batches", len(inner.batches))
+	}
+}
+
+func TestBufferedMemoryGetMessagesFlushesFirst(t *testing.T) {
+	ctx := context.Background()
+	inner := &fakeMemory{}
+	memory := NewBufferedMemory(inner)
+
+	_ = memory.AddMessages(ctx, []Message{NewUserMessage("pending")})
+	messages, err := memory.GetMessages(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != 1 {
+		t.Errorf("expected read to observe buffered write, got %d messages", len(messages))
+	}
+}
+
+func TestBufferedMemoryRequeuesOnFlushFailure(t *testing.T) {
+	ctx := context.Background()
+	inner := &fakeMemory{addErr: errors.New("backend down")}
+	memory := NewBufferedMemory(inner)
+
+	_ = memory.AddMessages(ctx, []Message{NewUserMessage("keep me")})
+	if err := memory.Flush(ctx); err == nil {
+		t.Fatal("expected flush error")
+	}
+
+	inner.addErr = nil
+	if err := memory.Flush(ctx); err != nil {
+		t.Fatalf("unexpected error on retry: %v", err)
+	}
+	if len(inner.stored) != 1 {
+		t.Errorf("expected requeued message to be written, got %d", len(inner.stored))
+	}
+}
+
+func TestBufferedMemoryCachesReads(t *testing.T) {
+	ctx := context.Background()
+	inner := &fakeMemory{stored: []Message{NewUserMessage("history")}}
+	memory := NewBufferedMemory(inner)
+
+	for i := 0; i < 3; i++ {
+		if _, err := memory.GetMessages(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if inner.getCalls != 1 {
+		t.Errorf("expected one backend read for repeated gets, got %d", inner.getCalls)
+	}
+}
+
+func TestBufferedMemoryWriteInvalidatesReadCache(t *testing.T) {
+	ctx := context.Background()
+	inner := &fakeMemory{}
+	memory := NewBufferedMemory(inner)
+
+	_, _ = memory.GetMessages(ctx)
+	_ = memory.AddMessages(ctx, []Message{NewUserMessage("new turn")})
+
+	messages, err := memory.GetMessages(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != 1 {
+		t.Errorf("expected read after write to observe the new message, got %d", len(messages))
+	}
+	if inner.getCalls != 2 {
+		t.Errorf("expected cache invalidation to cause a second backend read, got %d", inner.getCalls)
+	}
+}
+
+func TestBufferedMemoryCloseFlushes(t *testing.T) {
+	inner := &fakeMemory{}
+	memory := NewBufferedMemory(inner)
+
+	_ = memory.AddMessages(context.Background(), []Message{NewUserMessage("final")})
+	if err := memory.Close(); err != nil {
+		t.Fatalf("unexpected close error: %v", err)
+	}
+	if len(inner.stored) != 1 {
+		t.Errorf("expected close to flush buffered messages, got %d", len(inner.stored))
+	}
+	if !inner.closed {
+		t.Error("expected inner memory to be closed")
+	}
+}
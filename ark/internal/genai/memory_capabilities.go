@@ -0,0 +1,75 @@
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+const (
+	memoryFeatureStreaming     = "streaming"
+	memoryFeaturePagination    = "pagination"
+	memoryFeatureDeletion      = "deletion"
+	memoryFeatureConversations = "conversations"
+	memoryFeatureSessions      = "sessions"
+)
+
+type memoryCapabilitiesResponse struct {
+	ProtocolVersion string   `json:"protocol_version"`
+	Features        []string `json:"features"`
+}
+
+// DiscoverMemoryCapabilities performs the GET /capabilities handshake against
+// a memory service. Services that predate the handshake return 404 or 405;
+// that is not an error and yields nil capabilities.
+func DiscoverMemoryCapabilities(ctx context.Context, httpClient *http.Client, baseURL string) (*arkv1alpha1.MemoryCapabilities, error) {
+	requestURL := baseURL + CapabilitiesEndpoint
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create capabilities request: %w", err)
+	}
+
+	req.Header.Set("Accept", ContentTypeJSON)
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("capabilities request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("capabilities endpoint returned HTTP status %d", resp.StatusCode)
+	}
+
+	var response memoryCapabilitiesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode capabilities response: %w", err)
+	}
+
+	capabilities := &arkv1alpha1.MemoryCapabilities{
+		ProtocolVersion: response.ProtocolVersion,
+	}
+	for _, feature := range response.Features {
+		switch feature {
+		case memoryFeatureStreaming:
+			capabilities.Streaming = true
+		case memoryFeaturePagination:
+			capabilities.Pagination = true
+		case memoryFeatureDeletion:
+			capabilities.Deletion = true
+		case memoryFeatureConversations:
+			capabilities.Conversations = true
+		case memoryFeatureSessions:
+			capabilities.Sessions = true
+		}
+	}
+
+	return capabilities, nil
+}
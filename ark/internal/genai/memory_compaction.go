@@ -0,0 +1,50 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const compactionSummaryPrompt = "Summarize the conversation below so a " +
+	"follow-up assistant can continue it. Preserve names, decisions, open " +
+	"questions and commitments. Respond with the summary only."
+
+// SummarizeMessages asks the model for a compact summary of the messages,
+// used when replacing older conversation history during session compaction
+func SummarizeMessages(ctx context.Context, model *Model, messages []Message) (string, error) {
+	transcript := renderTranscript(messages)
+	if transcript == "" {
+		return "", fmt.Errorf("no message content to summarize")
+	}
+
+	completion, err := model.ChatCompletion(ctx, []Message{
+		NewSystemMessage(compactionSummaryPrompt),
+		NewUserMessage(transcript),
+	}, nil, 1)
+	if err != nil {
+		return "", fmt.Errorf("summary model call failed: %w", err)
+	}
+	if completion == nil || len(completion.Choices) == 0 || completion.Choices[0].Message.Content == "" {
+		return "", fmt.Errorf("summary model returned no content")
+	}
+	return completion.Choices[0].Message.Content, nil
+}
+
+// NewSummaryMessage wraps a compaction summary as a system message so targets
+// treat it as context rather than user input
+func NewSummaryMessage(summary string) Message {
+	return NewSystemMessage("Summary of earlier conversation: " + summary)
+}
+
+func renderTranscript(messages []Message) string {
+	var transcript strings.Builder
+	for _, message := range messages {
+		content, role := extractMessageContent(message)
+		if content == "" {
+			continue
+		}
+		fmt.Fprintf(&transcript, "%s: %s\n", role, content)
+	}
+	return strings.TrimSpace(transcript.String())
+}
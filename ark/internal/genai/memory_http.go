@@ -10,7 +10,12 @@ import (
 	"strings"
 
 	"github.com/openai/openai-go"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
 	"mckinsey.com/ark/internal/common"
+	"mckinsey.com/ark/internal/telemetry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -20,14 +25,16 @@ type HTTPMemory struct {
 	client     client.Client
 	httpClient *http.Client
 	baseURL    string
-	sessionId  string
+	memoryCtx  MemoryContext
 	name       string
 	namespace  string
 	recorder   EventEmitter
+	strict     bool
+	quota      *arkv1alpha1.MemoryQuotaConfig
 }
 
 // NewHTTPMemory creates a new HTTP-based memory implementation
-func NewHTTPMemory(ctx context.Context, k8sClient client.Client, memoryName, namespace string, recorder EventEmitter, config Config) (MemoryInterface, error) {
+func NewHTTPMemory(ctx context.Context, k8sClient client.Client, memoryName, namespace string, recorder EventEmitter, memoryCtx MemoryContext, config Config) (MemoryInterface, error) {
 	if k8sClient == nil || memoryName == "" || namespace == "" {
 		return nil, fmt.Errorf("invalid parameters")
 	}
@@ -37,14 +44,22 @@ func NewHTTPMemory(ctx context.Context, k8sClient client.Client, memoryName, nam
 		return nil, err
 	}
 
+	// Fail fast when the readiness probe reports the service as down, rather
+	// than letting each query discover the outage via HTTP timeouts
+	if condition := meta.FindStatusCondition(memory.Status.Conditions, MemoryReadyCondition); condition != nil && condition.Status == metav1.ConditionFalse {
+		return nil, fmt.Errorf("memory %s/%s is unavailable: %s", namespace, memoryName, condition.Message)
+	}
+
 	// Use the lastResolvedAddress as our initial baseline
 	if memory.Status.LastResolvedAddress == nil || *memory.Status.LastResolvedAddress == "" {
 		return nil, fmt.Errorf("memory has no lastResolvedAddress in status")
 	}
 
-	sessionId := config.SessionId
-	if sessionId == "" {
-		sessionId = string(memory.UID)
+	if memoryCtx.SessionID == "" {
+		memoryCtx.SessionID = string(memory.UID)
+	}
+	if memoryCtx.ConversationID == "" {
+		memoryCtx.ConversationID = memoryCtx.SessionID
 	}
 
 	// Create HTTP client with timeout for memory operations
@@ -53,14 +68,21 @@ func NewHTTPMemory(ctx context.Context, k8sClient client.Client, memoryName, nam
 		httpClient.Timeout = config.Timeout
 	}
 
+	var quota *arkv1alpha1.MemoryQuotaConfig
+	if config := GetArkConfig(ctx, k8sClient, namespace); config != nil {
+		quota = config.Spec.MemoryQuota
+	}
+
 	return &HTTPMemory{
 		client:     k8sClient,
 		httpClient: httpClient,
 		baseURL:    strings.TrimSuffix(*memory.Status.LastResolvedAddress, "/"),
-		sessionId:  sessionId,
+		memoryCtx:  memoryCtx,
 		name:       memoryName,
 		namespace:  namespace,
 		recorder:   recorder,
+		strict:     memory.Spec.StrictParsing,
+		quota:      quota,
 	}, nil
 }
 
@@ -71,9 +93,7 @@ func (m *HTTPMemory) resolveAndUpdateAddress(ctx context.Context) error {
 		return fmt.Errorf("failed to get memory resource: %w", err)
 	}
 
-	// Resolve the address using ValueSourceResolver
-	resolver := common.NewValueSourceResolver(m.client)
-	resolvedAddress, err := resolver.ResolveValueSource(ctx, memory.Spec.Address, m.namespace)
+	resolvedAddress, err := ResolveMemoryAddress(ctx, m.client, memory)
 	if err != nil {
 		return fmt.Errorf("failed to resolve memory address: %w", err)
 	}
@@ -100,32 +120,51 @@ func (m *HTTPMemory) resolveAndUpdateAddress(ctx context.Context) error {
 }
 
 // AddMessages stores messages to the memory backend
-func (m *HTTPMemory) AddMessages(ctx context.Context, queryID string, messages []Message) error {
+func (m *HTTPMemory) AddMessages(ctx context.Context, messages []Message) error {
 	if len(messages) == 0 {
 		return nil
 	}
 
+	ctx, span := telemetry.NewTraceContext().StartMemorySpan(ctx, "add_messages", m.name)
+	defer span.End()
+
+	if err := m.addMessages(ctx, span, messages); err != nil {
+		telemetry.RecordError(span, err)
+		return err
+	}
+	telemetry.RecordSuccess(span)
+	return nil
+}
+
+func (m *HTTPMemory) addMessages(ctx context.Context, span trace.Span, messages []Message) error {
 	// Resolve address dynamically
 	if err := m.resolveAndUpdateAddress(ctx); err != nil {
 		return err
 	}
 
+	if err := m.enforceQuota(ctx, len(messages)); err != nil {
+		return err
+	}
+
 	tracker := NewOperationTracker(m.recorder, ctx, "MemoryAddMessages", m.name, map[string]string{
 		"namespace": m.namespace,
-		"sessionId": m.sessionId,
-		"queryId":   queryID,
+		"sessionId": m.memoryCtx.SessionID,
+		"queryId":   m.memoryCtx.QueryName,
 		"messages":  fmt.Sprintf("%d", len(messages)),
 	})
 
-	// Convert messages to the request format
-	openaiMessages := make([]openai.ChatCompletionMessageParamUnion, len(messages))
-	for i, msg := range messages {
+	// Convert messages to the request format, redacting secret-sourced values
+	// so they are never persisted
+	redactedMessages := redactMessages(ctx, messages)
+	openaiMessages := make([]openai.ChatCompletionMessageParamUnion, len(redactedMessages))
+	for i, msg := range redactedMessages {
 		openaiMessages[i] = openai.ChatCompletionMessageParamUnion(msg)
 	}
 
 	reqBody, err := json.Marshal(MessagesRequest{
-		SessionID: m.sessionId,
-		QueryID:   queryID,
+		SessionID: m.memoryCtx.SessionID,
+		QueryID:   m.memoryCtx.QueryName,
+		Thread:    m.memoryCtx.Thread,
 		Messages:  openaiMessages,
 	})
 	if err != nil {
@@ -133,6 +172,8 @@ func (m *HTTPMemory) AddMessages(ctx context.Context, queryID string, messages [
 		return fmt.Errorf("failed to serialize messages: %w", err)
 	}
 
+	telemetry.AddPayloadStats(span, len(messages), int64(len(reqBody)))
+
 	requestURL := fmt.Sprintf("%s%s", m.baseURL, MessagesEndpoint)
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, bytes.NewReader(reqBody))
 	if err != nil {
@@ -162,6 +203,19 @@ func (m *HTTPMemory) AddMessages(ctx context.Context, queryID string, messages [
 
 // GetMessages retrieves messages from the memory backend
 func (m *HTTPMemory) GetMessages(ctx context.Context) ([]Message, error) {
+	ctx, span := telemetry.NewTraceContext().StartMemorySpan(ctx, "get_messages", m.name)
+	defer span.End()
+
+	messages, err := m.getMessages(ctx, span)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return nil, err
+	}
+	telemetry.RecordSuccess(span)
+	return messages, nil
+}
+
+func (m *HTTPMemory) getMessages(ctx context.Context, span trace.Span) ([]Message, error) {
 	// Resolve address dynamically
 	if err := m.resolveAndUpdateAddress(ctx); err != nil {
 		return nil, err
@@ -169,10 +223,13 @@ func (m *HTTPMemory) GetMessages(ctx context.Context) ([]Message, error) {
 
 	tracker := NewOperationTracker(m.recorder, ctx, "MemoryGetMessages", m.name, map[string]string{
 		"namespace": m.namespace,
-		"sessionId": m.sessionId,
+		"sessionId": m.memoryCtx.SessionID,
 	})
 
-	requestURL := fmt.Sprintf("%s%s?session_id=%s", m.baseURL, MessagesEndpoint, url.QueryEscape(m.sessionId))
+	requestURL := fmt.Sprintf("%s%s?session_id=%s", m.baseURL, MessagesEndpoint, url.QueryEscape(m.memoryCtx.SessionID))
+	if m.memoryCtx.Thread != "" {
+		requestURL += "&thread=" + url.QueryEscape(m.memoryCtx.Thread)
+	}
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
 		tracker.Fail(fmt.Errorf("failed to create request: %w", err))
@@ -203,21 +260,116 @@ func (m *HTTPMemory) GetMessages(ctx context.Context) ([]Message, error) {
 
 	messages := make([]Message, 0, len(response.Messages))
 	for i, record := range response.Messages {
-		openaiMessage, err := unmarshalMessageRobust(record.Message)
+		openaiMessage, warning, err := unmarshalMessageWithMode(record.Message, m.strict)
 		if err != nil {
 			err := fmt.Errorf("failed to unmarshal message at index %d: %w", i, err)
 			tracker.Fail(err)
 			return nil, err
 		}
+		if warning != "" && m.recorder != nil {
+			m.recorder.EmitEvent(ctx, "Warning", "MemoryMessageConverted", BaseEvent{
+				Name: m.name,
+				Metadata: map[string]string{
+					"namespace": m.namespace,
+					"sessionId": m.memoryCtx.SessionID,
+					"index":     fmt.Sprintf("%d", i),
+					"warning":   warning,
+				},
+			})
+		}
 		messages = append(messages, Message(openaiMessage))
 	}
 
+	telemetry.AddPayloadStats(span, len(messages), resp.ContentLength)
+
 	// Update metadata with message count
 	tracker.metadata["messages"] = fmt.Sprintf("%d", len(messages))
 	tracker.Complete("retrieved")
 	return messages, nil
 }
 
+// PutMetadata stores a conversation-scoped metadata value on the memory backend
+func (m *HTTPMemory) PutMetadata(ctx context.Context, key, value string) error {
+	if err := m.resolveAndUpdateAddress(ctx); err != nil {
+		return err
+	}
+
+	reqBody, err := json.Marshal(MetadataRequest{
+		SessionID: m.memoryCtx.SessionID,
+		Key:       key,
+		Value:     value,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to serialize metadata: %w", err)
+	}
+
+	requestURL := fmt.Sprintf("%s%s", m.baseURL, MetadataEndpoint)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", ContentTypeJSON)
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("HTTP status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// GetMetadata retrieves a conversation-scoped metadata value. It returns
+// found=false when the backend has no value for the key or does not support
+// metadata at all
+func (m *HTTPMemory) GetMetadata(ctx context.Context, key string) (string, bool, error) {
+	if err := m.resolveAndUpdateAddress(ctx); err != nil {
+		return "", false, err
+	}
+
+	requestURL := fmt.Sprintf("%s%s?session_id=%s&key=%s", m.baseURL, MetadataEndpoint,
+		url.QueryEscape(m.memoryCtx.SessionID), url.QueryEscape(key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", ContentTypeJSON)
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return "", false, fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", false, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", false, fmt.Errorf("HTTP status %d", resp.StatusCode)
+	}
+
+	var response MetadataResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return "", false, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return response.Value, true, nil
+}
+
+// WithThread returns a copy of the client scoped to the given conversation
+// thread, sharing the underlying HTTP client
+func (m *HTTPMemory) WithThread(thread string) MemoryInterface {
+	scoped := *m
+	scoped.memoryCtx.Thread = thread
+	return &scoped
+}
+
 // Close closes the HTTP client connections
 func (m *HTTPMemory) Close() error {
 	if m.httpClient != nil {
@@ -228,41 +380,60 @@ func (m *HTTPMemory) Close() error {
 
 // unmarshalMessageRobust tries discriminated union first, then falls back to simple role/content extraction
 func unmarshalMessageRobust(rawJSON json.RawMessage) (openai.ChatCompletionMessageParamUnion, error) {
+	message, _, err := unmarshalMessageWithMode(rawJSON, false)
+	return message, err
+}
+
+// unmarshalMessageWithMode parses a stored message. In strict mode unknown
+// roles are errors; otherwise they are converted to user messages and the
+// returned warning describes the conversion so callers can surface it
+func unmarshalMessageWithMode(rawJSON json.RawMessage, strict bool) (openai.ChatCompletionMessageParamUnion, string, error) {
 	// Step 1: Try discriminated union first (the normal case)
 	var openaiMessage openai.ChatCompletionMessageParamUnion
 	if err := json.Unmarshal(rawJSON, &openaiMessage); err == nil {
-		return openaiMessage, nil
+		return openaiMessage, "", nil
 	}
 
 	// Step 2: Fallback - try to extract role/content from simple format
 	var simple simpleMessage
 	if err := json.Unmarshal(rawJSON, &simple); err != nil {
-		return openai.ChatCompletionMessageParamUnion{}, fmt.Errorf("malformed JSON: %v", err)
+		return openai.ChatCompletionMessageParamUnion{}, "", fmt.Errorf("malformed JSON: %v", err)
 	}
 
 	// Step 3: Validate role is present (any role is acceptable for future compatibility)
 	if simple.Role == "" {
-		return openai.ChatCompletionMessageParamUnion{}, fmt.Errorf("missing required 'role' field")
+		return openai.ChatCompletionMessageParamUnion{}, "", fmt.Errorf("missing required 'role' field")
 	}
 
 	// Step 4: Convert simple format to proper OpenAI message based on known roles
-	// For unknown roles, try user message as fallback (most permissive)
 	switch simple.Role {
 	case RoleUser:
-		return openai.UserMessage(simple.Content), nil
+		return openai.UserMessage(simple.Content), "", nil
 	case RoleAssistant:
-		return openai.AssistantMessage(simple.Content), nil
+		return openai.AssistantMessage(simple.Content), "", nil
 	case RoleSystem:
-		return openai.SystemMessage(simple.Content), nil
+		return openai.SystemMessage(simple.Content), "", nil
+	case RoleTool:
+		if simple.ToolCallID != "" {
+			return openai.ToolMessage(simple.Content, simple.ToolCallID), "", nil
+		}
+		if strict {
+			return openai.ChatCompletionMessageParamUnion{}, "", fmt.Errorf("tool message missing 'tool_call_id' field")
+		}
+		return openai.UserMessage(simple.Content), "tool message without 'tool_call_id' converted to user message", nil
 	default:
-		// Future-proof: accept any role by treating as user message
-		// The OpenAI SDK will handle validation of the actual role
-		return openai.UserMessage(simple.Content), nil
+		if strict {
+			return openai.ChatCompletionMessageParamUnion{}, "", fmt.Errorf("unknown message role %q", simple.Role)
+		}
+		// Permissive mode: accept any role by treating as user message so
+		// conversations survive newer storage formats, but report it
+		return openai.UserMessage(simple.Content), fmt.Sprintf("message with unknown role %q converted to user message", simple.Role), nil
 	}
 }
 
 // Simple message structure for fallback parsing
 type simpleMessage struct {
-	Role    string `json:"role"`
-	Content string `json:"content,omitempty"`
+	Role       string `json:"role"`
+	Content    string `json:"content,omitempty"`
+	ToolCallID string `json:"tool_call_id,omitempty"`
 }
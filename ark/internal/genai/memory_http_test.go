@@ -145,3 +145,74 @@ func TestUnmarshalMessageRobustFutureRoles(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalMessageStrictMode(t *testing.T) {
+	testCases := []struct {
+		name        string
+		jsonInput   string
+		expectError bool
+		wantWarning bool
+	}{
+		{
+			name:        "unknown role fails in strict mode",
+			jsonInput:   `{"role": "moderator", "content": "test"}`,
+			expectError: true,
+		},
+		{
+			name:      "known roles still parse in strict mode",
+			jsonInput: `{"role": "user", "content": "hello"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, warning, err := unmarshalMessageWithMode(json.RawMessage(tc.jsonInput), true)
+			if tc.expectError && err == nil {
+				t.Errorf("expected error, got none")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if warning != "" {
+				t.Errorf("strict mode should never warn, got %q", warning)
+			}
+		})
+	}
+}
+
+func TestUnmarshalMessagePermissiveWarnings(t *testing.T) {
+	message, warning, err := unmarshalMessageWithMode(json.RawMessage(`{"role": "moderator", "content": "test"}`), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if warning == "" {
+		t.Error("expected a warning for the unknown role conversion")
+	}
+	if message.OfUser == nil {
+		t.Error("expected conversion to a user message")
+	}
+
+	_, warning, err = unmarshalMessageWithMode(json.RawMessage(`{"role": "user", "content": "hello"}`), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if warning != "" {
+		t.Errorf("known role should not warn, got %q", warning)
+	}
+}
+
+func TestUnmarshalMessageToolFallback(t *testing.T) {
+	message, warning, err := unmarshalMessageWithMode(json.RawMessage(`{"role": "tool", "content": "result", "tool_call_id": "call-1"}`), true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if warning != "" {
+		t.Errorf("tool message with tool_call_id should not warn, got %q", warning)
+	}
+	if message.OfTool == nil {
+		t.Fatal("expected a tool message")
+	}
+	if message.OfTool.ToolCallID != "call-1" {
+		t.Errorf("expected tool_call_id call-1, got %q", message.OfTool.ToolCallID)
+	}
+}
@@ -0,0 +1,108 @@
+package genai
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type openaiThreadExport struct {
+	Data []openaiThreadMessage `json:"data"`
+}
+
+type openaiThreadMessage struct {
+	Role    string `json:"role"`
+	Content []struct {
+		Type string `json:"type"`
+		Text struct {
+			Value string `json:"value"`
+		} `json:"text"`
+	} `json:"content"`
+}
+
+// ParseImportedMessages converts bulk conversation history into chat
+// messages. It accepts an OpenAI thread export, a JSON array of chat
+// messages, or JSONL with one message per line.
+func ParseImportedMessages(data []byte) ([]Message, error) {
+	trimmed := strings.TrimSpace(string(data))
+	if trimmed == "" {
+		return nil, fmt.Errorf("import source is empty")
+	}
+
+	if trimmed[0] == '[' {
+		return parseMessageArray([]byte(trimmed))
+	}
+	if trimmed[0] == '{' && json.Valid([]byte(trimmed)) {
+		if messages, err := parseThreadExport([]byte(trimmed)); err == nil {
+			return messages, nil
+		}
+	}
+	return parseMessageLines(trimmed)
+}
+
+func parseThreadExport(data []byte) ([]Message, error) {
+	var export openaiThreadExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("failed to parse thread export: %w", err)
+	}
+	if len(export.Data) == 0 {
+		return nil, fmt.Errorf("thread export contains no messages")
+	}
+
+	messages := make([]Message, 0, len(export.Data))
+	for i, threadMessage := range export.Data {
+		if threadMessage.Role == "" {
+			return nil, fmt.Errorf("thread message at index %d is missing a role", i)
+		}
+		var text strings.Builder
+		for _, part := range threadMessage.Content {
+			if part.Type == "text" {
+				text.WriteString(part.Text.Value)
+			}
+		}
+		switch threadMessage.Role {
+		case RoleAssistant:
+			messages = append(messages, NewAssistantMessage(text.String()))
+		default:
+			messages = append(messages, NewUserMessage(text.String()))
+		}
+	}
+	return messages, nil
+}
+
+func parseMessageArray(data []byte) ([]Message, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse message array: %w", err)
+	}
+
+	messages := make([]Message, 0, len(raw))
+	for i, record := range raw {
+		message, err := unmarshalMessageRobust(record)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message at index %d: %w", i, err)
+		}
+		messages = append(messages, Message(message))
+	}
+	return messages, nil
+}
+
+func parseMessageLines(data string) ([]Message, error) {
+	lines := strings.Split(data, "\n")
+	messages := make([]Message, 0, len(lines))
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		message, err := unmarshalMessageRobust(json.RawMessage(line))
+		if err != nil {
+			return nil, fmt.Errorf("invalid message on line %d: %w", i+1, err)
+		}
+		messages = append(messages, Message(message))
+	}
+	if len(messages) == 0 {
+		return nil, fmt.Errorf("import source contains no messages")
+	}
+	return messages, nil
+}
@@ -0,0 +1,64 @@
+package genai
+
+import (
+	"testing"
+)
+
+func TestParseImportedMessagesJSONL(t *testing.T) {
+	data := `{"role": "user", "content": "hello"}
+{"role": "assistant", "content": "hi there"}
+`
+	messages, err := ParseImportedMessages([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+	if messages[0].OfUser == nil || messages[1].OfAssistant == nil {
+		t.Errorf("expected user then assistant message")
+	}
+}
+
+func TestParseImportedMessagesArray(t *testing.T) {
+	data := `[{"role": "user", "content": "hello"}, {"role": "assistant", "content": "hi"}]`
+	messages, err := ParseImportedMessages([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+}
+
+func TestParseImportedMessagesThreadExport(t *testing.T) {
+	data := `{"data": [
+		{"role": "user", "content": [{"type": "text", "text": {"value": "hello"}}]},
+		{"role": "assistant", "content": [{"type": "text", "text": {"value": "hi"}}]}
+	]}`
+	messages, err := ParseImportedMessages([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+	if messages[1].OfAssistant == nil {
+		t.Errorf("expected assistant message from thread export")
+	}
+}
+
+func TestParseImportedMessagesInvalidLine(t *testing.T) {
+	data := `{"role": "user", "content": "hello"}
+not json at all {{
+`
+	if _, err := ParseImportedMessages([]byte(data)); err == nil {
+		t.Fatal("expected error for invalid JSONL line")
+	}
+}
+
+func TestParseImportedMessagesEmpty(t *testing.T) {
+	if _, err := ParseImportedMessages([]byte("  \n ")); err == nil {
+		t.Fatal("expected error for empty import source")
+	}
+}
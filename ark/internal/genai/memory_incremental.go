@@ -0,0 +1,74 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"sync"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type incrementalWriteTrackerKey struct{}
+
+// IncrementalWriteTracker counts response messages persisted to memory while
+// a target is still executing, so the caller's final batch writes only the
+// remainder instead of duplicating records
+type IncrementalWriteTracker struct {
+	mu        sync.Mutex
+	persisted int
+}
+
+// WithIncrementalWriteTracker attaches a fresh tracker to the context and
+// returns it
+func WithIncrementalWriteTracker(ctx context.Context) (context.Context, *IncrementalWriteTracker) {
+	tracker := &IncrementalWriteTracker{}
+	return context.WithValue(ctx, incrementalWriteTrackerKey{}, tracker), tracker
+}
+
+// IncrementalWriteTrackerFromContext returns the tracker, or nil when the
+// caller did not opt in to incremental writes
+func IncrementalWriteTrackerFromContext(ctx context.Context) *IncrementalWriteTracker {
+	tracker, _ := ctx.Value(incrementalWriteTrackerKey{}).(*IncrementalWriteTracker)
+	return tracker
+}
+
+func (t *IncrementalWriteTracker) add(count int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.persisted += count
+}
+
+// Persisted returns how many response messages were written during execution
+func (t *IncrementalWriteTracker) Persisted() int {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.persisted
+}
+
+// PersistToolRound writes a completed tool round's messages to memory
+// immediately and flushes, so clients reading the conversation mid-query see
+// tool outputs as discrete records. Failures are logged and the messages are
+// left for the caller's final batch
+func PersistToolRound(ctx context.Context, memory MemoryInterface, messages []Message) {
+	tracker := IncrementalWriteTrackerFromContext(ctx)
+	if tracker == nil || memory == nil || len(messages) == 0 {
+		return
+	}
+
+	log := logf.FromContext(ctx)
+	if err := memory.AddMessages(ctx, messages); err != nil {
+		StreamHealthFromContext(ctx).RecordMemoryWriteError(err)
+		log.V(1).Info("incremental memory write failed, deferring to final batch", "error", err.Error())
+		return
+	}
+	tracker.add(len(messages))
+
+	if err := FlushMemory(ctx, memory); err != nil {
+		StreamHealthFromContext(ctx).RecordMemoryWriteError(err)
+		log.V(1).Info("incremental memory flush failed, messages remain buffered", "error", err.Error())
+	}
+}
@@ -0,0 +1,44 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestPersistToolRoundWritesAndCounts(t *testing.T) {
+	memory := &fakeMemory{}
+	ctx, tracker := WithIncrementalWriteTracker(context.Background())
+
+	messages := []Message{NewUserMessage("call"), ToolMessage("result", "call-1")}
+	PersistToolRound(ctx, memory, messages)
+
+	if tracker.Persisted() != 2 {
+		t.Errorf("expected 2 persisted messages, got %d", tracker.Persisted())
+	}
+	if len(memory.stored) != 2 {
+		t.Errorf("expected 2 stored messages, got %d", len(memory.stored))
+	}
+}
+
+func TestPersistToolRoundNoTracker(t *testing.T) {
+	memory := &fakeMemory{}
+	PersistToolRound(context.Background(), memory, []Message{NewUserMessage("call")})
+
+	if len(memory.stored) != 0 {
+		t.Errorf("expected no writes without a tracker, got %d", len(memory.stored))
+	}
+}
+
+func TestPersistToolRoundWriteFailureDefersToFinalBatch(t *testing.T) {
+	memory := &fakeMemory{addErr: errors.New("backend down")}
+	ctx, tracker := WithIncrementalWriteTracker(context.Background())
+
+	PersistToolRound(ctx, memory, []Message{NewUserMessage("call")})
+
+	if tracker.Persisted() != 0 {
+		t.Errorf("failed writes must not count as persisted, got %d", tracker.Persisted())
+	}
+}
@@ -12,8 +12,8 @@ func NewNoopMemory() MemoryInterface {
 	return &NoopMemory{}
 }
 
-func (n *NoopMemory) AddMessages(ctx context.Context, queryID string, messages []Message) error {
-	logf.FromContext(ctx).V(2).Info("NoopMemory: AddMessages called - messages discarded", "queryId", queryID, "count", len(messages))
+func (n *NoopMemory) AddMessages(ctx context.Context, messages []Message) error {
+	logf.FromContext(ctx).V(2).Info("NoopMemory: AddMessages called - messages discarded", "count", len(messages))
 	return nil
 }
 
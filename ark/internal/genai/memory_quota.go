@@ -0,0 +1,143 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"mckinsey.com/ark/internal/telemetry"
+)
+
+// UsageEndpoint reports stored message volume on memory services with the
+// usage capability
+const UsageEndpoint = "/usage"
+
+// Memory quota policy constants
+const (
+	MemoryQuotaPolicyReject      = "reject"
+	MemoryQuotaPolicyEvictOldest = "evict-oldest"
+)
+
+// MemoryUsage is the stored volume reported by the memory service
+type MemoryUsage struct {
+	Messages int64 `json:"messages"`
+}
+
+// GetMemoryUsage fetches the stored message volume from a memory service.
+// Services without the endpoint return 404 or 405; that yields nil usage,
+// not an error
+func GetMemoryUsage(ctx context.Context, httpClient *http.Client, baseURL string) (*MemoryUsage, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+UsageEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create usage request: %w", err)
+	}
+
+	req.Header.Set("Accept", ContentTypeJSON)
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("usage request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("usage endpoint returned HTTP status %d", resp.StatusCode)
+	}
+
+	var usage MemoryUsage
+	if err := json.NewDecoder(resp.Body).Decode(&usage); err != nil {
+		return nil, fmt.Errorf("failed to decode usage response: %w", err)
+	}
+	return &usage, nil
+}
+
+// enforceQuota checks the namespace quota before a write of incoming
+// messages, evicting the least recently active sessions when the policy
+// allows. Services that do not report usage are not limited
+func (m *HTTPMemory) enforceQuota(ctx context.Context, incoming int) error {
+	if m.quota == nil {
+		return nil
+	}
+
+	usage, err := GetMemoryUsage(ctx, m.httpClient, m.baseURL)
+	if err != nil {
+		return fmt.Errorf("failed to read memory usage: %w", err)
+	}
+	if usage == nil {
+		return nil
+	}
+
+	projected := usage.Messages + int64(incoming)
+	if projected <= m.quota.MaxMessages {
+		return nil
+	}
+
+	if m.quota.Policy == MemoryQuotaPolicyEvictOldest {
+		return m.evictOldestSessions(ctx, int64(incoming))
+	}
+
+	m.emitQuotaEvent(ctx, "MemoryQuotaExceeded", usage.Messages)
+	telemetry.RecordMemoryQuotaEnforcement(ctx, m.namespace, MemoryQuotaPolicyReject)
+	return fmt.Errorf("memory quota exceeded: %d of %d messages stored", usage.Messages, m.quota.MaxMessages)
+}
+
+// evictOldestSessions deletes other sessions in last-activity order until the
+// write fits, failing when the quota cannot be met even with everything else
+// evicted
+func (m *HTTPMemory) evictOldestSessions(ctx context.Context, incoming int64) error {
+	sessions, err := ListMemorySessions(ctx, m.httpClient, m.baseURL)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions for quota eviction: %w", err)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastActivity < sessions[j].LastActivity
+	})
+
+	for _, session := range sessions {
+		if session.SessionID == m.memoryCtx.SessionID {
+			continue
+		}
+		if err := DeleteMemorySession(ctx, m.httpClient, m.baseURL, session.SessionID); err != nil {
+			return fmt.Errorf("failed to evict session %s: %w", session.SessionID, err)
+		}
+		m.emitQuotaEvent(ctx, "MemoryQuotaEvictedSession", 0)
+		telemetry.RecordMemoryQuotaEnforcement(ctx, m.namespace, MemoryQuotaPolicyEvictOldest)
+
+		usage, err := GetMemoryUsage(ctx, m.httpClient, m.baseURL)
+		if err != nil {
+			return fmt.Errorf("failed to read memory usage: %w", err)
+		}
+		if usage == nil || usage.Messages+incoming <= m.quota.MaxMessages {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("memory quota exceeded: limit of %d messages cannot fit the write even after evicting other sessions", m.quota.MaxMessages)
+}
+
+func (m *HTTPMemory) emitQuotaEvent(ctx context.Context, reason string, stored int64) {
+	if m.recorder == nil {
+		return
+	}
+	metadata := map[string]string{
+		"namespace": m.namespace,
+		"sessionId": m.memoryCtx.SessionID,
+		"limit":     fmt.Sprintf("%d", m.quota.MaxMessages),
+		"policy":    m.quota.Policy,
+	}
+	if stored > 0 {
+		metadata["stored"] = fmt.Sprintf("%d", stored)
+	}
+	m.recorder.EmitEvent(ctx, "Warning", reason, BaseEvent{
+		Name:     m.name,
+		Metadata: metadata,
+	})
+}
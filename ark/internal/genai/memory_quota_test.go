@@ -0,0 +1,124 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+type quotaTestBackend struct {
+	mu       sync.Mutex
+	messages int64
+	sessions []MemorySessionRecord
+	evicted  []string
+}
+
+func (b *quotaTestBackend) server(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		switch {
+		case r.URL.Path == UsageEndpoint:
+			_, _ = w.Write([]byte(`{"messages":` + strconv.FormatInt(b.messages, 10) + `}`))
+		case r.URL.Path == SessionsEndpoint && r.Method == http.MethodGet:
+			var entries []string
+			for _, session := range b.sessions {
+				entries = append(entries, `{"session_id":"`+session.SessionID+`","last_activity":"`+session.LastActivity+`"}`)
+			}
+			_, _ = w.Write([]byte(`{"sessions":[` + strings.Join(entries, ",") + `]}`))
+		case r.URL.Path == SessionsEndpoint && r.Method == http.MethodDelete:
+			sessionID := r.URL.Query().Get("session_id")
+			b.evicted = append(b.evicted, sessionID)
+			b.messages -= 5
+			remaining := b.sessions[:0]
+			for _, session := range b.sessions {
+				if session.SessionID != sessionID {
+					remaining = append(remaining, session)
+				}
+			}
+			b.sessions = remaining
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func quotaMemory(backend *quotaTestBackend, t *testing.T, quota *arkv1alpha1.MemoryQuotaConfig) *HTTPMemory {
+	server := backend.server(t)
+	return &HTTPMemory{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+		memoryCtx:  MemoryContext{SessionID: "current"},
+		name:       "default",
+		namespace:  "default",
+		quota:      quota,
+	}
+}
+
+func TestEnforceQuotaAllowsWritesUnderLimit(t *testing.T) {
+	backend := &quotaTestBackend{messages: 5}
+	memory := quotaMemory(backend, t, &arkv1alpha1.MemoryQuotaConfig{MaxMessages: 10})
+
+	if err := memory.enforceQuota(context.Background(), 3); err != nil {
+		t.Fatalf("expected write under quota to pass, got %v", err)
+	}
+}
+
+func TestEnforceQuotaRejectsPastLimit(t *testing.T) {
+	backend := &quotaTestBackend{messages: 9}
+	memory := quotaMemory(backend, t, &arkv1alpha1.MemoryQuotaConfig{MaxMessages: 10, Policy: MemoryQuotaPolicyReject})
+
+	err := memory.enforceQuota(context.Background(), 3)
+	if err == nil {
+		t.Fatal("expected write past quota to be rejected")
+	}
+	if !strings.Contains(err.Error(), "memory quota exceeded") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestEnforceQuotaEvictsOldestSessionsFirst(t *testing.T) {
+	backend := &quotaTestBackend{
+		messages: 10,
+		sessions: []MemorySessionRecord{
+			{SessionID: "current", LastActivity: "2026-01-01T00:00:00Z"},
+			{SessionID: "recent", LastActivity: "2026-03-01T00:00:00Z"},
+			{SessionID: "stale", LastActivity: "2026-02-01T00:00:00Z"},
+		},
+	}
+	memory := quotaMemory(backend, t, &arkv1alpha1.MemoryQuotaConfig{MaxMessages: 10, Policy: MemoryQuotaPolicyEvictOldest})
+
+	if err := memory.enforceQuota(context.Background(), 3); err != nil {
+		t.Fatalf("expected eviction to make room, got %v", err)
+	}
+	if len(backend.evicted) != 1 || backend.evicted[0] != "stale" {
+		t.Fatalf("expected the stale session evicted first, got %v", backend.evicted)
+	}
+}
+
+func TestEnforceQuotaSkipsServicesWithoutUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}))
+	t.Cleanup(server.Close)
+	memory := &HTTPMemory{
+		httpClient: server.Client(),
+		baseURL:    server.URL,
+		quota:      &arkv1alpha1.MemoryQuotaConfig{MaxMessages: 1},
+	}
+
+	if err := memory.enforceQuota(context.Background(), 100); err != nil {
+		t.Fatalf("expected services without usage reporting to be unlimited, got %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"sort"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+const (
+	semanticRecallDefaultTopK         = 5
+	semanticRecallDefaultRecentWindow = 20
+	semanticRecallVectorsKey          = "semantic-recall-vectors"
+)
+
+// SemanticRecallMemory wraps a memory client so GetMessages returns the most
+// recent window plus the older messages most similar to the current input,
+// ranked by embedding cosine similarity. Vectors are cached through the
+// backend's metadata store when it offers one, so each message is embedded
+// once per conversation
+type SemanticRecallMemory struct {
+	inner        MemoryInterface
+	embedder     EmbeddingProvider
+	input        string
+	topK         int
+	recentWindow int
+}
+
+func NewSemanticRecallMemory(inner MemoryInterface, embedder EmbeddingProvider, input string, spec *arkv1alpha1.MemorySemanticRecall) *SemanticRecallMemory {
+	topK := semanticRecallDefaultTopK
+	if spec.TopK != nil {
+		topK = *spec.TopK
+	}
+	recentWindow := semanticRecallDefaultRecentWindow
+	if spec.RecentWindow != nil {
+		recentWindow = *spec.RecentWindow
+	}
+	return &SemanticRecallMemory{inner: inner, embedder: embedder, input: input, topK: topK, recentWindow: recentWindow}
+}
+
+func (m *SemanticRecallMemory) AddMessages(ctx context.Context, messages []Message) error {
+	return m.inner.AddMessages(ctx, messages)
+}
+
+// GetMessages returns the recent window verbatim, preceded by the top-K older
+// messages most relevant to the input in their original order. On any
+// embedding failure the full history is returned unchanged
+func (m *SemanticRecallMemory) GetMessages(ctx context.Context) ([]Message, error) {
+	messages, err := m.inner.GetMessages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if m.input == "" || len(messages) <= m.recentWindow {
+		return messages, nil
+	}
+
+	older := messages[:len(messages)-m.recentWindow]
+	recent := messages[len(messages)-m.recentWindow:]
+	if len(older) <= m.topK {
+		return messages, nil
+	}
+
+	selected, err := m.rankOlderMessages(ctx, older)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "semantic recall failed, returning full history")
+		return messages, nil
+	}
+
+	result := make([]Message, 0, len(selected)+len(recent))
+	for _, index := range selected {
+		result = append(result, older[index])
+	}
+	return append(result, recent...), nil
+}
+
+func (m *SemanticRecallMemory) Close() error {
+	return m.inner.Close()
+}
+
+// WithThread rewraps the thread-scoped backend so recall operates on the
+// thread's own history, or returns the wrapper unchanged when the backend
+// cannot scope
+func (m *SemanticRecallMemory) WithThread(thread string) MemoryInterface {
+	if scoper, ok := m.inner.(ThreadScoper); ok {
+		scoped := *m
+		scoped.inner = scoper.WithThread(thread)
+		return &scoped
+	}
+	return m
+}
+
+// PutMetadata forwards to the backend when it supports metadata
+func (m *SemanticRecallMemory) PutMetadata(ctx context.Context, key, value string) error {
+	if metadata, ok := m.inner.(MetadataInterface); ok {
+		return metadata.PutMetadata(ctx, key, value)
+	}
+	return nil
+}
+
+// GetMetadata forwards to the backend when it supports metadata
+func (m *SemanticRecallMemory) GetMetadata(ctx context.Context, key string) (string, bool, error) {
+	if metadata, ok := m.inner.(MetadataInterface); ok {
+		return metadata.GetMetadata(ctx, key)
+	}
+	return "", false, nil
+}
+
+// rankOlderMessages returns the indexes of the top-K older messages by cosine
+// similarity to the input, sorted back into chronological order
+func (m *SemanticRecallMemory) rankOlderMessages(ctx context.Context, older []Message) ([]int, error) {
+	vectors, err := m.olderVectors(ctx, older)
+	if err != nil {
+		return nil, err
+	}
+	inputVectors, err := m.embedder.Embed(ctx, []string{m.input})
+	if err != nil {
+		return nil, err
+	}
+	inputVector := inputVectors[0]
+
+	indexes := make([]int, len(older))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return cosineSimilarity(vectors[indexes[a]], inputVector) > cosineSimilarity(vectors[indexes[b]], inputVector)
+	})
+
+	selected := indexes[:m.topK]
+	sort.Ints(selected)
+	return selected, nil
+}
+
+// olderVectors returns one vector per older message, embedding only messages
+// past the cached prefix and persisting the extended cache when the backend
+// supports metadata
+func (m *SemanticRecallMemory) olderVectors(ctx context.Context, older []Message) ([][]float64, error) {
+	var cached [][]float64
+	store, hasStore := m.inner.(MetadataInterface)
+	if hasStore {
+		if value, found, err := store.GetMetadata(ctx, semanticRecallVectorsKey); err == nil && found {
+			if err := json.Unmarshal([]byte(value), &cached); err != nil {
+				cached = nil
+			}
+		}
+	}
+	if len(cached) >= len(older) {
+		return cached[:len(older)], nil
+	}
+
+	texts := make([]string, 0, len(older)-len(cached))
+	for _, message := range older[len(cached):] {
+		content, role := extractMessageContent(message)
+		if content == "" {
+			content = role
+		}
+		texts = append(texts, content)
+	}
+	embedded, err := m.embedder.Embed(ctx, texts)
+	if err != nil {
+		return nil, err
+	}
+	vectors := append(cached, embedded...)
+
+	if hasStore {
+		if encoded, err := json.Marshal(vectors); err == nil {
+			if err := store.PutMetadata(ctx, semanticRecallVectorsKey, string(encoded)); err != nil {
+				logf.FromContext(ctx).V(1).Info("failed to cache semantic recall vectors", "error", err.Error())
+			}
+		}
+	}
+	return vectors, nil
+}
+
+func cosineSimilarity(a, b []float64) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,125 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+type fakeEmbedder struct {
+	vectors map[string][]float64
+	calls   int
+	err     error
+}
+
+func (f *fakeEmbedder) Embed(ctx context.Context, inputs []string) ([][]float64, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	result := make([][]float64, len(inputs))
+	for i, input := range inputs {
+		vector, ok := f.vectors[input]
+		if !ok {
+			vector = []float64{0, 1}
+		}
+		result[i] = vector
+	}
+	return result, nil
+}
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func TestSemanticRecallSelectsRelevantOlderMessages(t *testing.T) {
+	ctx := context.Background()
+	inner := &fakeMemory{stored: []Message{
+		NewUserMessage("cats are great"),
+		NewUserMessage("the weather is dull"),
+		NewUserMessage("my cat is asleep"),
+		NewUserMessage("stock prices fell"),
+		NewUserMessage("recent one"),
+		NewUserMessage("recent two"),
+	}}
+	embedder := &fakeEmbedder{vectors: map[string][]float64{
+		"tell me about cats": {1, 0},
+		"cats are great":     {1, 0},
+		"my cat is asleep":   {0.9, 0.1},
+	}}
+	spec := &arkv1alpha1.MemorySemanticRecall{TopK: intPtr(2), RecentWindow: intPtr(2)}
+	memory := NewSemanticRecallMemory(inner, embedder, "tell me about cats", spec)
+
+	messages, err := memory.GetMessages(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != 4 {
+		t.Fatalf("expected 4 messages, got %d", len(messages))
+	}
+	contents := make([]string, len(messages))
+	for i, message := range messages {
+		contents[i], _ = extractMessageContent(message)
+	}
+	expected := []string{"cats are great", "my cat is asleep", "recent one", "recent two"}
+	for i, want := range expected {
+		if contents[i] != want {
+			t.Errorf("message %d: expected %q, got %q", i, want, contents[i])
+		}
+	}
+}
+
+func TestSemanticRecallShortHistoryUnchanged(t *testing.T) {
+	ctx := context.Background()
+	inner := &fakeMemory{stored: []Message{NewUserMessage("one"), NewUserMessage("two")}}
+	embedder := &fakeEmbedder{}
+	spec := &arkv1alpha1.MemorySemanticRecall{}
+	memory := NewSemanticRecallMemory(inner, embedder, "anything", spec)
+
+	messages, err := memory.GetMessages(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+	if embedder.calls != 0 {
+		t.Errorf("expected no embedding calls, got %d", embedder.calls)
+	}
+}
+
+func TestSemanticRecallEmbeddingFailureReturnsFullHistory(t *testing.T) {
+	ctx := context.Background()
+	stored := make([]Message, 8)
+	for i := range stored {
+		stored[i] = NewUserMessage("message")
+	}
+	inner := &fakeMemory{stored: stored}
+	embedder := &fakeEmbedder{err: errors.New("endpoint down")}
+	spec := &arkv1alpha1.MemorySemanticRecall{TopK: intPtr(2), RecentWindow: intPtr(2)}
+	memory := NewSemanticRecallMemory(inner, embedder, "input", spec)
+
+	messages, err := memory.GetMessages(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != len(stored) {
+		t.Fatalf("expected full history of %d messages, got %d", len(stored), len(messages))
+	}
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	if got := cosineSimilarity([]float64{1, 0}, []float64{1, 0}); got < 0.999 {
+		t.Errorf("expected identical vectors to score 1, got %f", got)
+	}
+	if got := cosineSimilarity([]float64{1, 0}, []float64{0, 1}); got != 0 {
+		t.Errorf("expected orthogonal vectors to score 0, got %f", got)
+	}
+	if got := cosineSimilarity([]float64{1, 0}, []float64{1}); got != 0 {
+		t.Errorf("expected mismatched lengths to score 0, got %f", got)
+	}
+}
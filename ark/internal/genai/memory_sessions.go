@@ -0,0 +1,84 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SessionsEndpoint lists and deletes sessions on memory services with the
+// sessions capability
+const SessionsEndpoint = "/sessions"
+
+// MemorySessionRecord is one session as reported by the memory service
+type MemorySessionRecord struct {
+	SessionID string `json:"session_id"`
+	// +optional RFC3339 timestamp of the session's last write
+	LastActivity string `json:"last_activity,omitempty"`
+}
+
+type memorySessionsResponse struct {
+	Sessions []MemorySessionRecord `json:"sessions"`
+}
+
+// ListMemorySessions fetches the sessions a memory service holds. Services
+// without the endpoint return 404 or 405; that yields nil sessions, not an
+// error
+func ListMemorySessions(ctx context.Context, httpClient *http.Client, baseURL string) ([]MemorySessionRecord, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+SessionsEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sessions request: %w", err)
+	}
+
+	req.Header.Set("Accept", ContentTypeJSON)
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sessions request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		return nil, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("sessions endpoint returned HTTP status %d", resp.StatusCode)
+	}
+
+	var response memorySessionsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode sessions response: %w", err)
+	}
+	return response.Sessions, nil
+}
+
+// DeleteMemorySession removes a session and its messages from the memory
+// service
+func DeleteMemorySession(ctx context.Context, httpClient *http.Client, baseURL, sessionID string) error {
+	requestURL := fmt.Sprintf("%s%s?session_id=%s", baseURL, SessionsEndpoint, url.QueryEscape(sessionID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create session delete request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("session delete request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("session delete returned HTTP status %d", resp.StatusCode)
+	}
+	return nil
+}
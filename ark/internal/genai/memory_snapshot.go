@@ -0,0 +1,119 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// snapshotMetadataPrefix namespaces snapshot payloads in conversation
+// metadata
+const snapshotMetadataPrefix = "snapshot-"
+
+// SnapshotInterface is implemented by memory clients that can capture a
+// conversation at a point in time and restore it later, so destructive
+// experiments on a conversation can be rolled back
+type SnapshotInterface interface {
+	TakeSnapshot(ctx context.Context) (string, error)
+	RestoreSnapshot(ctx context.Context, snapshotID string) error
+}
+
+// SnapshotMemory captures a point-in-time snapshot if the memory client
+// supports it
+func SnapshotMemory(ctx context.Context, memory MemoryInterface) (string, error) {
+	if snapshotter, ok := memory.(SnapshotInterface); ok {
+		return snapshotter.TakeSnapshot(ctx)
+	}
+	return "", fmt.Errorf("memory does not support snapshots")
+}
+
+// RestoreMemorySnapshot rewinds the conversation to a snapshot if the memory
+// client supports it
+func RestoreMemorySnapshot(ctx context.Context, memory MemoryInterface, snapshotID string) error {
+	if snapshotter, ok := memory.(SnapshotInterface); ok {
+		return snapshotter.RestoreSnapshot(ctx, snapshotID)
+	}
+	return fmt.Errorf("memory does not support snapshots")
+}
+
+// TakeSnapshot stores the conversation's current messages under a time-based
+// snapshot id in conversation metadata
+func (m *HTTPMemory) TakeSnapshot(ctx context.Context) (string, error) {
+	messages, err := m.GetMessages(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to read conversation for snapshot: %w", err)
+	}
+	payload, err := json.Marshal(messages)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize snapshot: %w", err)
+	}
+
+	snapshotID := time.Now().UTC().Format("20060102T150405.000000000Z")
+	if err := m.PutMetadata(ctx, snapshotMetadataPrefix+snapshotID, string(payload)); err != nil {
+		return "", fmt.Errorf("failed to store snapshot: %w", err)
+	}
+	return snapshotID, nil
+}
+
+// RestoreSnapshot rewrites the conversation to the state captured under the
+// snapshot id, discarding everything written since
+func (m *HTTPMemory) RestoreSnapshot(ctx context.Context, snapshotID string) error {
+	value, found, err := m.GetMetadata(ctx, snapshotMetadataPrefix+snapshotID)
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	if !found {
+		return fmt.Errorf("snapshot %q not found", snapshotID)
+	}
+
+	var messages []Message
+	if err := json.Unmarshal([]byte(value), &messages); err != nil {
+		return fmt.Errorf("failed to parse snapshot: %w", err)
+	}
+
+	if err := DeleteMemorySession(ctx, m.httpClient, m.baseURL, m.memoryCtx.SessionID); err != nil {
+		return fmt.Errorf("failed to clear conversation: %w", err)
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return m.AddMessages(ctx, messages)
+}
+
+// TakeSnapshot flushes buffered writes so the snapshot observes them, then
+// forwards to the backend when it supports snapshots
+func (b *BufferedMemory) TakeSnapshot(ctx context.Context) (string, error) {
+	if err := b.Flush(ctx); err != nil {
+		return "", err
+	}
+	return SnapshotMemory(ctx, b.inner)
+}
+
+// RestoreSnapshot forwards to the backend and invalidates the read cache so
+// later reads observe the restored conversation
+func (b *BufferedMemory) RestoreSnapshot(ctx context.Context, snapshotID string) error {
+	if err := b.Flush(ctx); err != nil {
+		return err
+	}
+	if err := RestoreMemorySnapshot(ctx, b.inner, snapshotID); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.cached = nil
+	b.cacheValid = false
+	b.mu.Unlock()
+	return nil
+}
+
+// TakeSnapshot forwards to the wrapped backend
+func (m *SemanticRecallMemory) TakeSnapshot(ctx context.Context) (string, error) {
+	return SnapshotMemory(ctx, m.inner)
+}
+
+// RestoreSnapshot forwards to the wrapped backend
+func (m *SemanticRecallMemory) RestoreSnapshot(ctx context.Context, snapshotID string) error {
+	return RestoreMemorySnapshot(ctx, m.inner, snapshotID)
+}
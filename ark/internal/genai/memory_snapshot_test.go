@@ -0,0 +1,84 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"testing"
+)
+
+type fakeSnapshotMemory struct {
+	fakeMemory
+	snapshots map[string][]Message
+	takes     int
+	restores  int
+}
+
+func (f *fakeSnapshotMemory) TakeSnapshot(ctx context.Context) (string, error) {
+	f.takes++
+	if f.snapshots == nil {
+		f.snapshots = map[string][]Message{}
+	}
+	id := "snap"
+	f.snapshots[id] = append([]Message{}, f.stored...)
+	return id, nil
+}
+
+func (f *fakeSnapshotMemory) RestoreSnapshot(ctx context.Context, snapshotID string) error {
+	f.restores++
+	f.stored = append([]Message{}, f.snapshots[snapshotID]...)
+	return nil
+}
+
+func TestSnapshotMemoryRequiresSupport(t *testing.T) {
+	ctx := context.Background()
+	if _, err := SnapshotMemory(ctx, &fakeMemory{}); err == nil {
+		t.Error("expected error for memory without snapshot support")
+	}
+	if err := RestoreMemorySnapshot(ctx, &fakeMemory{}, "snap"); err == nil {
+		t.Error("expected error for memory without snapshot support")
+	}
+}
+
+func TestBufferedMemoryFlushesBeforeSnapshot(t *testing.T) {
+	ctx := context.Background()
+	inner := &fakeSnapshotMemory{}
+	memory := NewBufferedMemory(inner)
+
+	_ = memory.AddMessages(ctx, []Message{NewUserMessage("keep")})
+	snapshotID, err := memory.TakeSnapshot(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(inner.snapshots[snapshotID]) != 1 {
+		t.Errorf("expected snapshot to observe the buffered write, got %d messages", len(inner.snapshots[snapshotID]))
+	}
+}
+
+func TestBufferedMemoryRestoreInvalidatesReadCache(t *testing.T) {
+	ctx := context.Background()
+	inner := &fakeSnapshotMemory{}
+	memory := NewBufferedMemory(inner)
+
+	_ = memory.AddMessages(ctx, []Message{NewUserMessage("before")})
+	snapshotID, err := memory.TakeSnapshot(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_ = memory.AddMessages(ctx, []Message{NewUserMessage("experiment")})
+	if _, err := memory.GetMessages(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := memory.RestoreSnapshot(ctx, snapshotID); err != nil {
+		t.Fatalf("unexpected restore error: %v", err)
+	}
+	messages, err := memory.GetMessages(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(messages) != 1 {
+		t.Errorf("expected restored conversation of 1 message, got %d", len(messages))
+	}
+}
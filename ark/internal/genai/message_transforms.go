@@ -0,0 +1,132 @@
+package genai
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+const (
+	TransformDropStaleToolMessages   = "drop-stale-tool-messages"
+	TransformCollapseSystemMessages  = "collapse-system-messages"
+	TransformNormalizeLegacyMessages = "normalize-legacy-messages"
+)
+
+// MessageTransformFunc rewrites conversation history before provider calls
+type MessageTransformFunc func(messages []Message, transform arkv1alpha1.MessageTransform) []Message
+
+var (
+	messageTransformsMu sync.RWMutex
+	messageTransforms   = map[string]MessageTransformFunc{
+		TransformDropStaleToolMessages:   dropStaleToolMessages,
+		TransformCollapseSystemMessages:  collapseSystemMessages,
+		TransformNormalizeLegacyMessages: normalizeLegacyMessages,
+	}
+)
+
+// RegisterMessageTransform adds a named transform so embedders can extend the
+// built-in pipeline
+func RegisterMessageTransform(name string, fn MessageTransformFunc) {
+	messageTransformsMu.Lock()
+	defer messageTransformsMu.Unlock()
+	messageTransforms[name] = fn
+}
+
+// KnownMessageTransform reports whether a transform with the given name is registered
+func KnownMessageTransform(name string) bool {
+	messageTransformsMu.RLock()
+	defer messageTransformsMu.RUnlock()
+	_, ok := messageTransforms[name]
+	return ok
+}
+
+// ApplyMessageTransforms runs the named transforms in order over the messages
+func ApplyMessageTransforms(transforms []arkv1alpha1.MessageTransform, messages []Message) ([]Message, error) {
+	for _, transform := range transforms {
+		messageTransformsMu.RLock()
+		fn, ok := messageTransforms[transform.Name]
+		messageTransformsMu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("unknown message transform: %s", transform.Name)
+		}
+		messages = fn(messages, transform)
+	}
+	return messages, nil
+}
+
+// dropStaleToolMessages removes tool exchanges that happened before the most
+// recent N user turns, keeping long histories within reason
+func dropStaleToolMessages(messages []Message, transform arkv1alpha1.MessageTransform) []Message {
+	turns := 1
+	if transform.Turns != nil {
+		turns = *transform.Turns
+	}
+
+	boundary := -1
+	seen := 0
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].OfUser != nil {
+			seen++
+			if seen == turns {
+				boundary = i
+				break
+			}
+		}
+	}
+	if boundary < 0 {
+		return messages
+	}
+
+	result := make([]Message, 0, len(messages))
+	for i, message := range messages {
+		if i < boundary && isToolExchangeMessage(message) {
+			continue
+		}
+		result = append(result, message)
+	}
+	return result
+}
+
+func isToolExchangeMessage(message Message) bool {
+	if message.OfTool != nil {
+		return true
+	}
+	return message.OfAssistant != nil && len(message.OfAssistant.ToolCalls) > 0 && !message.OfAssistant.Content.OfString.Valid()
+}
+
+// collapseSystemMessages drops system messages whose content repeats an
+// earlier system message
+func collapseSystemMessages(messages []Message, _ arkv1alpha1.MessageTransform) []Message {
+	seen := map[string]bool{}
+	result := make([]Message, 0, len(messages))
+	for _, message := range messages {
+		if message.OfSystem != nil {
+			data, err := json.Marshal(message.OfSystem.Content)
+			if err == nil {
+				key := string(data)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+			}
+		}
+		result = append(result, message)
+	}
+	return result
+}
+
+// normalizeLegacyMessages converts deprecated function messages into tool
+// messages so older persisted histories work with current providers
+func normalizeLegacyMessages(messages []Message, _ arkv1alpha1.MessageTransform) []Message {
+	result := make([]Message, 0, len(messages))
+	for _, message := range messages {
+		if message.OfFunction != nil {
+			result = append(result, ToolMessage(message.OfFunction.Content.Value, message.OfFunction.Name))
+			continue
+		}
+		result = append(result, message)
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+package genai
+
+import (
+	"testing"
+
+	"github.com/openai/openai-go"
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func TestDropStaleToolMessages(t *testing.T) {
+	turns := 1
+	messages := []Message{
+		NewUserMessage("first question"),
+		Message(openai.ToolMessage("old tool result", "call-1")),
+		NewAssistantMessage("first answer"),
+		NewUserMessage("second question"),
+		Message(openai.ToolMessage("recent tool result", "call-2")),
+	}
+
+	result, err := ApplyMessageTransforms([]arkv1alpha1.MessageTransform{
+		{Name: TransformDropStaleToolMessages, Turns: &turns},
+	}, messages)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 4 {
+		t.Fatalf("expected 4 messages, got %d", len(result))
+	}
+	for _, message := range result[:3] {
+		if message.OfTool != nil {
+			t.Errorf("expected stale tool message to be dropped")
+		}
+	}
+	if result[3].OfTool == nil {
+		t.Errorf("expected recent tool message to be kept")
+	}
+}
+
+func TestCollapseSystemMessages(t *testing.T) {
+	messages := []Message{
+		NewSystemMessage("you are helpful"),
+		NewUserMessage("question"),
+		NewSystemMessage("you are helpful"),
+		NewSystemMessage("extra instructions"),
+	}
+
+	result, err := ApplyMessageTransforms([]arkv1alpha1.MessageTransform{
+		{Name: TransformCollapseSystemMessages},
+	}, messages)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(result))
+	}
+}
+
+func TestNormalizeLegacyMessages(t *testing.T) {
+	messages := []Message{
+		Message(openai.ChatCompletionMessageParamOfFunction("legacy result", "get_weather")),
+	}
+
+	result, err := ApplyMessageTransforms([]arkv1alpha1.MessageTransform{
+		{Name: TransformNormalizeLegacyMessages},
+	}, messages)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result) != 1 || result[0].OfTool == nil {
+		t.Fatalf("expected function message to be converted to a tool message")
+	}
+}
+
+func TestApplyMessageTransformsUnknownName(t *testing.T) {
+	_, err := ApplyMessageTransforms([]arkv1alpha1.MessageTransform{
+		{Name: "no-such-transform"},
+	}, nil)
+	if err == nil {
+		t.Fatal("expected error for unknown transform")
+	}
+}
@@ -60,8 +60,29 @@ func LoadModel(ctx context.Context, k8sClient client.Client, modelSpec interface
 	}
 
 	modelInstance := &Model{
-		Model: model,
-		Type:  modelCRD.Spec.Type,
+		Model:               model,
+		Type:                modelCRD.Spec.Type,
+		OverflowModelRef:    modelCRD.Spec.OverflowModelRef,
+		ContentFilterPolicy: modelCRD.Spec.ContentFilterPolicy,
+	}
+	if modelCRD.Spec.ContextWindow != nil {
+		modelInstance.ContextWindow = *modelCRD.Spec.ContextWindow
+	}
+	modelInstance.Capabilities = modelCRD.Spec.Capabilities
+	if modelInstance.ContextWindow == 0 && modelInstance.Capabilities != nil && modelInstance.Capabilities.MaxContext != nil {
+		modelInstance.ContextWindow = *modelInstance.Capabilities.MaxContext
+	}
+	modelInstance.DebugLogger = NewModelDebugLoggerFromAnnotations(modelCRD.Annotations, model)
+	modelInstance.fairShare = fairShareGateFor(namespace+"/"+modelName, modelCRD.Spec.FairShare)
+	modelInstance.callerNamespace = defaultNamespace
+	modelInstance.retries = modelCRD.Spec.Retries
+	modelInstance.rateLimiter = rateLimiterFor(namespace+"/"+modelName, modelCRD.Spec.RateLimits)
+	modelInstance.breaker = CircuitBreakerFor(namespace + "/" + modelName)
+
+	if len(modelCRD.Spec.Endpoints) > 0 {
+		endpointIndex := selectModelEndpoint(namespace+"/"+modelName, modelCRD.Spec.Endpoints)
+		applyModelEndpoint(modelCRD, endpointIndex)
+		modelInstance.breaker = CircuitBreakerFor(endpointKey(namespace+"/"+modelName, endpointIndex))
 	}
 
 	switch modelCRD.Spec.Type {
@@ -77,10 +98,28 @@ func LoadModel(ctx context.Context, k8sClient client.Client, modelSpec interface
 		if err := loadBedrockConfig(ctx, resolver, modelCRD.Spec.Config.Bedrock, namespace, model, modelInstance); err != nil {
 			return nil, err
 		}
+	case ModelTypeAnthropic:
+		if err := loadAnthropicConfig(ctx, resolver, modelCRD.Spec.Config.Anthropic, namespace, modelInstance); err != nil {
+			return nil, err
+		}
+	case ModelTypeOllama:
+		if err := loadOllamaConfig(ctx, resolver, modelCRD.Spec.Config.Ollama, namespace, modelInstance); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, fmt.Errorf("unsupported model type: %s", modelCRD.Spec.Type)
 	}
 
+	QueryOverridesFromContext(ctx).ApplyToModel(modelInstance)
+
+	if configProvider, ok := modelInstance.Provider.(ConfigProvider); ok {
+		if baseURL, ok := configProvider.BuildConfig()["baseUrl"].(string); ok {
+			if err := CheckModelEgress(ctx, k8sClient, namespace, baseURL); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return modelInstance, nil
 }
 
@@ -4,7 +4,11 @@ import (
 	"context"
 
 	"github.com/openai/openai-go"
+	"go.opentelemetry.io/otel/attribute"
 	"k8s.io/apimachinery/pkg/runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
 	"mckinsey.com/ark/internal/telemetry"
 )
 
@@ -12,6 +16,17 @@ type ChatCompletionProvider interface {
 	ChatCompletion(ctx context.Context, messages []Message, n int64, tools ...[]openai.ChatCompletionToolParam) (*openai.ChatCompletion, error)
 	ChatCompletionStream(ctx context.Context, messages []Message, n int64, streamFunc func(*openai.ChatCompletionChunk) error, tools ...[]openai.ChatCompletionToolParam) (*openai.ChatCompletion, error)
 	SetOutputSchema(schema *runtime.RawExtension, schemaName string)
+	SetParallelToolCalls(parallel *bool)
+	SetToolChoice(choice string)
+	SetGenerationOptions(options *GenerationOptions)
+}
+
+// GenerationOptions carries per-agent generation limits that override the
+// shared model property strings.
+type GenerationOptions struct {
+	MaxOutputTokens *int64
+	Stop            []string
+	LogitBias       map[string]int64
 }
 
 type ConfigProvider interface {
@@ -19,12 +34,30 @@ type ConfigProvider interface {
 }
 
 type Model struct {
-	Model        string
-	Type         string
-	Properties   map[string]string
-	Provider     ChatCompletionProvider
-	OutputSchema *runtime.RawExtension
-	SchemaName   string
+	Model             string
+	Type              string
+	Properties        map[string]string
+	Provider          ChatCompletionProvider
+	OutputSchema      *runtime.RawExtension
+	SchemaName        string
+	ParallelToolCalls *bool
+	ToolChoice        string
+	GenerationOptions *GenerationOptions
+	ContextWindow     int64
+	OverflowModelRef  *arkv1alpha1.AgentModelRef
+	DebugLogger       *ModelDebugLogger
+	Capabilities      *arkv1alpha1.ModelCapabilities
+
+	ContentFilterPolicy *arkv1alpha1.ContentFilterPolicy
+
+	// Recorder receives retry events when set; nil is allowed
+	Recorder EventEmitter
+
+	fairShare       *FairShareGate
+	callerNamespace string
+	retries         *arkv1alpha1.ModelRetries
+	rateLimiter     *ModelRateLimiter
+	breaker         *CircuitBreaker
 }
 
 func (m *Model) ChatCompletion(ctx context.Context, messages []Message, eventStream EventStreamInterface, n int64, tools ...[]openai.ChatCompletionToolParam) (*openai.ChatCompletion, error) {
@@ -41,13 +74,47 @@ func (m *Model) ChatCompletion(ctx context.Context, messages []Message, eventStr
 	ctx, span := tracer.StartSpan(ctx, spanType)
 	defer span.End()
 
-	// Set input and model details
-	otelMessages := make([]openai.ChatCompletionMessageParamUnion, len(messages))
-	for i, msg := range messages {
+	// Set input and model details, with secret-sourced values redacted
+	redactedMessages := redactMessages(ctx, messages)
+	otelMessages := make([]openai.ChatCompletionMessageParamUnion, len(redactedMessages))
+	for i, msg := range redactedMessages {
 		otelMessages[i] = openai.ChatCompletionMessageParamUnion(msg)
 	}
 	telemetry.SetLLMCompletionInput(span, otelMessages)
 	telemetry.AddModelDetails(span, m.Model, m.Type, telemetry.ExtractProviderFromType(m.Type), m.Properties)
+	for key, value := range ChargebackLabelsFromContext(ctx) {
+		span.SetAttributes(attribute.String("chargeback."+key, value))
+	}
+
+	hasTools := len(tools) > 0 && len(tools[0]) > 0
+	if err := m.checkCapabilities(messages, hasTools, eventStream != nil); err != nil {
+		telemetry.RecordError(span, err)
+		return nil, err
+	}
+
+	if m.breaker != nil {
+		if breakerErr := m.breaker.Allow(m.Model); breakerErr != nil {
+			telemetry.RecordError(span, breakerErr)
+			return nil, breakerErr
+		}
+	}
+
+	if m.fairShare != nil {
+		release, acquireErr := m.fairShare.Acquire(ctx, m.callerNamespace)
+		if acquireErr != nil {
+			telemetry.RecordError(span, acquireErr)
+			return nil, acquireErr
+		}
+		defer release()
+	}
+
+	if m.rateLimiter != nil {
+		estimatedTokens := EstimateMessageTokens(messages) + ToolSchemaTokensFromContext(ctx)
+		if waitErr := m.rateLimiter.Wait(ctx, m.Model, estimatedTokens); waitErr != nil {
+			telemetry.RecordError(span, waitErr)
+			return nil, waitErr
+		}
+	}
 
 	var response *openai.ChatCompletion
 	var err error
@@ -57,22 +124,80 @@ func (m *Model) ChatCompletion(ctx context.Context, messages []Message, eventStr
 		m.Provider.SetOutputSchema(m.OutputSchema, m.SchemaName)
 	}
 
+	if m.ParallelToolCalls != nil {
+		m.Provider.SetParallelToolCalls(m.ParallelToolCalls)
+	}
+
+	if m.ToolChoice != "" {
+		m.Provider.SetToolChoice(m.ToolChoice)
+	}
+
+	if m.GenerationOptions != nil {
+		m.Provider.SetGenerationOptions(m.GenerationOptions)
+	}
+
 	// Use streaming if event stream is provided
 	if eventStream != nil {
-		response, err = m.Provider.ChatCompletionStream(ctx, messages, n, func(chunk *openai.ChatCompletionChunk) error {
-			// Wrap chunk with ARK metadata
-			chunkWithMeta := WrapChunkWithMetadata(ctx, chunk, m.Model)
-			return eventStream.StreamChunk(ctx, chunkWithMeta)
-		}, tools...)
+		toolCallTracker := NewToolCallStreamTracker(m.Model)
+		timingTracker := TimingTrackerFromContext(ctx)
+		streamStats := telemetry.NewStreamStats()
+		chunksDelivered := false
+		response, err = m.withRetries(ctx, span, func() (*openai.ChatCompletion, error) {
+			return m.Provider.ChatCompletionStream(ctx, messages, n, func(chunk *openai.ChatCompletionChunk) error {
+				chunksDelivered = true
+				streamStats.ObserveChunk()
+				if timingTracker != nil {
+					timingTracker.MarkFirstToken()
+				}
+				if err := toolCallTracker.Observe(ctx, chunk, eventStream); err != nil {
+					return err
+				}
+				// Wrap chunk with ARK metadata
+				chunkWithMeta := WrapChunkWithMetadata(ctx, chunk, m.Model)
+				return eventStream.StreamChunk(ctx, chunkWithMeta)
+			}, tools...)
+		}, func() bool { return !chunksDelivered })
+		agentName, _ := GetExecutionMetadata(ctx)["agent"].(string)
+		streamStats.Finish(ctx, span, m.Model, agentName)
 	} else {
-		response, err = m.Provider.ChatCompletion(ctx, messages, n, tools...)
+		response, err = m.withRetries(ctx, span, func() (*openai.ChatCompletion, error) {
+			return m.Provider.ChatCompletion(ctx, messages, n, tools...)
+		}, nil)
+	}
+
+	if m.DebugLogger != nil {
+		m.DebugLogger.Record(ctx, redactedMessages, response, err)
+	}
+
+	if m.breaker != nil {
+		if err == nil {
+			m.breaker.RecordSuccess()
+		} else if ctx.Err() == nil {
+			m.breaker.RecordFailure()
+		}
 	}
 
 	if err != nil {
+		err = classifyContentFilterError(err)
 		telemetry.RecordError(span, err)
 		return nil, err
 	}
 
+	if filterErr := contentFilterResponseError(response); filterErr != nil {
+		telemetry.RecordError(span, filterErr)
+		return nil, filterErr
+	}
+
+	if eventStream != nil {
+		if frameErr := EmitFinalMessageFrame(ctx, eventStream, m.Model, response); frameErr != nil {
+			logf.FromContext(ctx).Error(frameErr, "failed to emit final stream frame", "model", m.Model)
+		}
+	}
+
+	if response != nil {
+		RecordAlternativeChoices(ctx, response.Choices)
+	}
+
 	// Set output and token usage
 	telemetry.SetLLMCompletionOutput(span, response)
 	telemetry.AddLLMTokenUsage(span, response.Usage.PromptTokens, response.Usage.CompletionTokens, response.Usage.TotalTokens)
@@ -0,0 +1,47 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/common"
+)
+
+func loadOllamaConfig(ctx context.Context, resolver *common.ValueSourceResolver, config *arkv1alpha1.OllamaModelConfig, namespace string, model *Model) error {
+	if config == nil {
+		return fmt.Errorf("ollama configuration is required for ollama model type")
+	}
+
+	baseURL, err := resolver.ResolveValueSource(ctx, config.BaseURL, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to resolve Ollama baseURL: %w", err)
+	}
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	var properties map[string]string
+	if config.Properties != nil {
+		properties = make(map[string]string)
+		for key, valueSource := range config.Properties {
+			value, err := resolver.ResolveValueSource(ctx, valueSource, namespace)
+			if err != nil {
+				return fmt.Errorf("failed to resolve Ollama property %s: %w", key, err)
+			}
+			properties[key] = value
+		}
+	}
+
+	model.Provider = &OllamaProvider{
+		OpenAIProvider: OpenAIProvider{
+			Model:      model.Model,
+			BaseURL:    baseURL + "/v1",
+			APIKey:     ollamaPlaceholderAPIKey,
+			Properties: properties,
+		},
+		ServerURL: baseURL,
+	}
+	model.Properties = properties
+
+	return nil
+}
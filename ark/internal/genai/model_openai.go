@@ -36,11 +36,33 @@ func loadOpenAIConfig(ctx context.Context, resolver *common.ValueSourceResolver,
 	}
 
 	openaiProvider := &OpenAIProvider{
-		Model:      model.Model,
-		BaseURL:    baseURL,
-		APIKey:     apiKey,
-		Properties: properties,
+		Model:          model.Model,
+		BaseURL:        baseURL,
+		APIKey:         apiKey,
+		Properties:     properties,
+		CompletionMode: config.CompletionMode,
+		PromptTemplate: config.PromptTemplate,
 	}
+
+	if config.Endpoint != nil {
+		endpoint := &OpenAIEndpointOverrides{
+			PathTemplate:     config.Endpoint.PathTemplate,
+			AuthHeaderName:   config.Endpoint.AuthHeaderName,
+			AuthHeaderFormat: config.Endpoint.AuthHeaderFormat,
+		}
+		if config.Endpoint.QueryParams != nil {
+			endpoint.QueryParams = make(map[string]string)
+			for key, valueSource := range config.Endpoint.QueryParams {
+				value, err := resolver.ResolveValueSource(ctx, valueSource, namespace)
+				if err != nil {
+					return fmt.Errorf("failed to resolve OpenAI endpoint query param %s: %w", key, err)
+				}
+				endpoint.QueryParams[key] = value
+			}
+		}
+		openaiProvider.Endpoint = endpoint
+	}
+
 	model.Provider = openaiProvider
 	model.Properties = properties
 
@@ -0,0 +1,74 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// charsPerToken is the rough character-to-token ratio used for the context
+// window pre-check. Exact tokenization is provider-specific; the estimate only
+// needs to be close enough to catch clear overflows before paying for a
+// provider round trip.
+const charsPerToken = 4
+
+// EstimateMessageTokens returns an approximate token count for a message list.
+func EstimateMessageTokens(messages []Message) int64 {
+	var chars int64
+	for i := range messages {
+		chars += int64(len(messageToContentString(messages[i])))
+	}
+	return chars / charsPerToken
+}
+
+func messageToContentString(message Message) string {
+	switch {
+	case message.OfSystem != nil:
+		return message.OfSystem.Content.OfString.Value
+	case message.OfUser != nil:
+		return message.OfUser.Content.OfString.Value
+	case message.OfAssistant != nil:
+		return message.OfAssistant.Content.OfString.Value
+	case message.OfTool != nil:
+		return message.OfTool.Content.OfString.Value
+	default:
+		return ""
+	}
+}
+
+// EnsureContextFits checks the estimated prompt size against the model's
+// declared context window. When the prompt overflows and the model declares an
+// overflowModelRef, the larger model is loaded and returned; otherwise the
+// original model is returned, with an error if a declared window is exceeded
+// and no upgrade is available. Models without a declared context window are
+// never rejected.
+func EnsureContextFits(ctx context.Context, k8sClient client.Client, model *Model, messages []Message, namespace string) (*Model, error) {
+	log := logf.FromContext(ctx)
+	estimated := EstimateMessageTokens(messages)
+
+	// Bounded upgrade chain so misconfigured overflow references cannot loop
+	for range [3]struct{}{} {
+		if model.ContextWindow <= 0 || estimated <= model.ContextWindow {
+			return model, nil
+		}
+
+		if model.OverflowModelRef == nil {
+			return model, fmt.Errorf("estimated prompt of %d tokens exceeds model %s context window of %d tokens", estimated, model.Model, model.ContextWindow)
+		}
+
+		log.Info("upgrading model for context window overflow", "model", model.Model, "estimatedTokens", estimated, "contextWindow", model.ContextWindow, "overflowModel", model.OverflowModelRef.Name)
+
+		upgraded, err := LoadModel(ctx, k8sClient, model.OverflowModelRef, namespace)
+		if err != nil {
+			return model, fmt.Errorf("failed to load overflow model %s: %w", model.OverflowModelRef.Name, err)
+		}
+		model = upgraded
+	}
+
+	if model.ContextWindow <= 0 || estimated <= model.ContextWindow {
+		return model, nil
+	}
+	return model, fmt.Errorf("estimated prompt of %d tokens exceeds context window of all models in the overflow chain", estimated)
+}
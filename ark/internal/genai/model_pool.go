@@ -0,0 +1,83 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"fmt"
+	"math/rand"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+// endpointKey identifies one backend of a model for its circuit breaker, so
+// health is tracked per endpoint rather than per model
+func endpointKey(modelKey string, index int) string {
+	return fmt.Sprintf("%s#%d", modelKey, index)
+}
+
+// selectModelEndpoint picks an endpoint by weighted random selection over the
+// endpoints whose circuit breaker is closed, spreading load by weight while
+// skipping backends that are failing. When every endpoint is unhealthy the
+// selection falls back to all of them, so a regional recovery is noticed
+func selectModelEndpoint(modelKey string, endpoints []arkv1alpha1.ModelEndpoint) int {
+	healthy := make([]int, 0, len(endpoints))
+	for index := range endpoints {
+		if !CircuitBreakerFor(endpointKey(modelKey, index)).State().Open {
+			healthy = append(healthy, index)
+		}
+	}
+	if len(healthy) == 0 {
+		healthy = make([]int, len(endpoints))
+		for index := range endpoints {
+			healthy[index] = index
+		}
+	}
+
+	var totalWeight int64
+	for _, index := range healthy {
+		totalWeight += endpointWeight(endpoints[index])
+	}
+	pick := rand.Int63n(totalWeight)
+	for _, index := range healthy {
+		pick -= endpointWeight(endpoints[index])
+		if pick < 0 {
+			return index
+		}
+	}
+	return healthy[len(healthy)-1]
+}
+
+func endpointWeight(endpoint arkv1alpha1.ModelEndpoint) int64 {
+	if endpoint.Weight == nil || *endpoint.Weight < 1 {
+		return 1
+	}
+	return int64(*endpoint.Weight)
+}
+
+// applyModelEndpoint points the provider configuration at the chosen
+// endpoint's address before the provider is loaded
+func applyModelEndpoint(modelCRD *arkv1alpha1.Model, index int) {
+	baseURL := modelCRD.Spec.Endpoints[index].BaseURL
+	switch modelCRD.Spec.Type {
+	case ModelTypeAzure:
+		if config := modelCRD.Spec.Config.Azure; config != nil {
+			config.BaseURL = baseURL
+		}
+	case ModelTypeOpenAI:
+		if config := modelCRD.Spec.Config.OpenAI; config != nil {
+			config.BaseURL = baseURL
+		}
+	case ModelTypeBedrock:
+		if config := modelCRD.Spec.Config.Bedrock; config != nil {
+			config.BaseURL = &baseURL
+		}
+	case ModelTypeAnthropic:
+		if config := modelCRD.Spec.Config.Anthropic; config != nil {
+			config.BaseURL = &baseURL
+		}
+	case ModelTypeOllama:
+		if config := modelCRD.Spec.Config.Ollama; config != nil {
+			config.BaseURL = baseURL
+		}
+	}
+}
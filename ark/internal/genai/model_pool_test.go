@@ -0,0 +1,106 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"testing"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func poolEndpoints(weights ...int32) []arkv1alpha1.ModelEndpoint {
+	endpoints := make([]arkv1alpha1.ModelEndpoint, len(weights))
+	for i, weight := range weights {
+		endpoints[i] = arkv1alpha1.ModelEndpoint{
+			BaseURL: arkv1alpha1.ValueSource{Value: "https://endpoint"},
+			Weight:  int32Ptr(weight),
+		}
+	}
+	return endpoints
+}
+
+func TestSelectModelEndpointFollowsWeights(t *testing.T) {
+	endpoints := poolEndpoints(1, 100000)
+
+	picks := make([]int, len(endpoints))
+	for range 100 {
+		picks[selectModelEndpoint("default/weighted-pool", endpoints)]++
+	}
+	if picks[1] < 90 {
+		t.Fatalf("expected the heavy endpoint to dominate, got %v", picks)
+	}
+}
+
+func TestSelectModelEndpointSkipsUnhealthy(t *testing.T) {
+	endpoints := poolEndpoints(1, 1)
+
+	breaker := CircuitBreakerFor(endpointKey("default/failover-pool", 0))
+	for range defaultBreakerFailureThreshold {
+		breaker.RecordFailure()
+	}
+
+	for range 20 {
+		if index := selectModelEndpoint("default/failover-pool", endpoints); index != 1 {
+			t.Fatalf("expected the healthy endpoint, got %d", index)
+		}
+	}
+
+	breaker.RecordSuccess()
+	picks := make([]int, len(endpoints))
+	for range 200 {
+		picks[selectModelEndpoint("default/failover-pool", endpoints)]++
+	}
+	if picks[0] == 0 {
+		t.Fatal("expected the recovered endpoint back in rotation")
+	}
+}
+
+func TestSelectModelEndpointFallsBackWhenAllUnhealthy(t *testing.T) {
+	endpoints := poolEndpoints(1, 1)
+
+	for index := range endpoints {
+		breaker := CircuitBreakerFor(endpointKey("default/dark-pool", index))
+		for range defaultBreakerFailureThreshold {
+			breaker.RecordFailure()
+		}
+	}
+
+	if index := selectModelEndpoint("default/dark-pool", endpoints); index < 0 || index >= len(endpoints) {
+		t.Fatalf("expected a valid endpoint index, got %d", index)
+	}
+}
+
+func TestEndpointWeightDefaults(t *testing.T) {
+	if weight := endpointWeight(arkv1alpha1.ModelEndpoint{}); weight != 1 {
+		t.Fatalf("expected default weight 1, got %d", weight)
+	}
+	if weight := endpointWeight(arkv1alpha1.ModelEndpoint{Weight: int32Ptr(5)}); weight != 5 {
+		t.Fatalf("expected weight 5, got %d", weight)
+	}
+}
+
+func TestApplyModelEndpoint(t *testing.T) {
+	modelCRD := &arkv1alpha1.Model{
+		Spec: arkv1alpha1.ModelSpec{
+			Type: ModelTypeAzure,
+			Config: arkv1alpha1.ModelConfig{
+				Azure: &arkv1alpha1.AzureModelConfig{
+					BaseURL: arkv1alpha1.ValueSource{Value: "https://primary"},
+				},
+			},
+			Endpoints: []arkv1alpha1.ModelEndpoint{
+				{BaseURL: arkv1alpha1.ValueSource{Value: "https://secondary"}},
+			},
+		},
+	}
+
+	applyModelEndpoint(modelCRD, 0)
+	if modelCRD.Spec.Config.Azure.BaseURL.Value != "https://secondary" {
+		t.Fatalf("expected azure base URL overridden, got %q", modelCRD.Spec.Config.Azure.BaseURL.Value)
+	}
+}
+
@@ -20,6 +20,12 @@ type ProbeResult struct {
 	DetailedError error  // Full error for logging
 }
 
+// modelProber is implemented by providers with a cheaper or more precise
+// readiness check than a test completion, such as Ollama's /api/tags
+type modelProber interface {
+	Probe(ctx context.Context) error
+}
+
 // ProbeModel tests if a model is available
 func ProbeModel(ctx context.Context, model *Model) ProbeResult {
 	// Create probe context with 30s timeout
@@ -27,6 +33,25 @@ func ProbeModel(ctx context.Context, model *Model) ProbeResult {
 	probeCtx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
+	if prober, ok := model.Provider.(modelProber); ok {
+		if err := prober.Probe(probeCtx); err != nil {
+			message := err.Error()
+			if errors.Is(err, context.DeadlineExceeded) {
+				message = fmt.Sprintf("Probe failed (timeout after %d seconds)", int(timeout.Seconds()))
+			}
+			return ProbeResult{
+				Available:     false,
+				Message:       message,
+				DetailedError: err,
+			}
+		}
+		return ProbeResult{
+			Available:     true,
+			Message:       "Model is available",
+			DetailedError: nil,
+		}
+	}
+
 	// Simple test message
 	testMessages := []Message{NewUserMessage("Hello")}
 
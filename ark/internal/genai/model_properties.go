@@ -1,7 +1,9 @@
 package genai
 
 import (
+	"context"
 	"encoding/json"
+	"sort"
 	"strconv"
 
 	"github.com/openai/openai-go"
@@ -74,6 +76,85 @@ func getIntProperty(properties map[string]string, key string, defaultValue int)
 	return defaultValue
 }
 
+// applyParallelToolCallsToParams applies the parallel_tool_calls flag; only
+// valid when the request contains tools
+func applyParallelToolCallsToParams(parallel *bool, params *openai.ChatCompletionNewParams) {
+	if parallel != nil {
+		params.ParallelToolCalls = openai.Bool(*parallel)
+	}
+}
+
+// applyToolChoiceToParams applies the tool_choice parameter; only valid when
+// the request contains tools
+func applyToolChoiceToParams(choice string, params *openai.ChatCompletionNewParams) {
+	if choice != "" {
+		params.ToolChoice = toolChoiceParam(choice)
+	}
+}
+
+// applyChargebackToParams sets the provider user field from the chargeback
+// labels on the context, so provider-side usage reports can be grouped per
+// team or customer. Without chargeback labels it falls back to the
+// originating user identity for per-end-user attribution
+func applyChargebackToParams(ctx context.Context, params *openai.ChatCompletionNewParams) {
+	if labels := ChargebackLabelsFromContext(ctx); len(labels) > 0 {
+		params.User = openai.String(formatChargebackUser(labels))
+		return
+	}
+	if user := UserIdentityFromContext(ctx); user != "" {
+		params.User = openai.String(user)
+	}
+}
+
+// applyGenerationOptionsToParams applies per-agent generation limits on top of
+// whatever the model property strings configured
+func applyGenerationOptionsToParams(options *GenerationOptions, params *openai.ChatCompletionNewParams) {
+	if options == nil {
+		return
+	}
+	if options.MaxOutputTokens != nil {
+		params.MaxCompletionTokens = openai.Int(*options.MaxOutputTokens)
+	}
+	if len(options.Stop) > 0 {
+		params.Stop = openai.ChatCompletionNewParamsStopUnion{OfStringArray: options.Stop}
+	}
+	if len(options.LogitBias) > 0 {
+		params.LogitBias = options.LogitBias
+	}
+}
+
+// applyStrictToolSchemas enables strict function calling on each tool and
+// tightens schemas to meet strict mode requirements: additionalProperties must
+// be false and every property must be required
+func applyStrictToolSchemas(tools []openai.ChatCompletionToolParam) {
+	for i := range tools {
+		tools[i].Function.Strict = openai.Bool(true)
+		tools[i].Function.Parameters = tightenSchema(tools[i].Function.Parameters)
+	}
+}
+
+func tightenSchema(schema map[string]any) map[string]any {
+	if schema == nil {
+		schema = map[string]any{"type": "object", "properties": map[string]any{}}
+	}
+	schema["additionalProperties"] = false
+
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return schema
+	}
+	required := make([]string, 0, len(properties))
+	for name := range properties {
+		required = append(required, name)
+		if nested, ok := properties[name].(map[string]any); ok && nested["type"] == "object" {
+			properties[name] = tightenSchema(nested)
+		}
+	}
+	sort.Strings(required)
+	schema["required"] = required
+	return schema
+}
+
 // applyStructuredOutputToParams applies structured output schema to OpenAI parameters
 func applyStructuredOutputToParams(outputSchema *runtime.RawExtension, schemaName string, params *openai.ChatCompletionNewParams) {
 	if outputSchema != nil && outputSchema.Raw != nil {
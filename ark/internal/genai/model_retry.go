@@ -0,0 +1,116 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/openai/openai-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const defaultRetryBackoff = time.Second
+
+// ProviderHTTPError is a non-2xx provider response, carrying the status code
+// so the retry subsystem can tell transient failures from permanent ones
+type ProviderHTTPError struct {
+	Provider   string
+	StatusCode int
+	Body       string
+}
+
+func (e *ProviderHTTPError) Error() string {
+	return fmt.Sprintf("%s API returned %d: %s", e.Provider, e.StatusCode, e.Body)
+}
+
+// withRetries runs the provider call per the model's retry config. canRetry
+// gates retries that are no longer safe, such as a stream that has already
+// delivered chunks
+func (m *Model) withRetries(ctx context.Context, span trace.Span, call func() (*openai.ChatCompletion, error), canRetry func() bool) (*openai.ChatCompletion, error) {
+	if m.retries == nil {
+		return call()
+	}
+
+	backoff := defaultRetryBackoff
+	if m.retries.Backoff != nil {
+		backoff = m.retries.Backoff.Duration
+	}
+
+	for attempt := 1; ; attempt++ {
+		response, err := call()
+		if err == nil || attempt >= m.retries.MaxAttempts || !m.isRetryable(err) {
+			return response, err
+		}
+		if canRetry != nil && !canRetry() {
+			return response, err
+		}
+
+		delay := retryDelay(backoff, attempt)
+		span.AddEvent("retry", trace.WithAttributes(
+			attribute.Int("attempt", attempt),
+			attribute.String("delay", delay.String()),
+			attribute.String("error", err.Error())))
+		logf.FromContext(ctx).Info("retrying provider call",
+			"model", m.Model, "attempt", attempt, "delay", delay.String(), "error", err.Error())
+		if m.Recorder != nil {
+			m.Recorder.EmitEvent(ctx, "Warning", "ModelRetry", BaseEvent{
+				Name: m.Model,
+				Metadata: map[string]string{
+					"attempt": fmt.Sprintf("%d", attempt),
+					"delay":   delay.String(),
+					"error":   err.Error(),
+				},
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// retryDelay doubles the base per attempt and adds up to half again as
+// jitter, so synchronized clients spread their retries
+func retryDelay(base time.Duration, attempt int) time.Duration {
+	delay := base << (attempt - 1)
+	return delay + time.Duration(rand.Int63n(int64(delay)/2+1))
+}
+
+func (m *Model) isRetryable(err error) bool {
+	status := providerStatusCode(err)
+	if status == 0 {
+		return false
+	}
+	if len(m.retries.RetryableStatusCodes) > 0 {
+		return slices.Contains(m.retries.RetryableStatusCodes, status)
+	}
+	return status == 429 || status >= 500
+}
+
+// providerStatusCode extracts the HTTP status from the error shapes the
+// providers return, or 0 when the error has no status
+func providerStatusCode(err error) int {
+	var openaiErr *openai.Error
+	if errors.As(err, &openaiErr) {
+		return openaiErr.StatusCode
+	}
+	var smithyErr *smithyhttp.ResponseError
+	if errors.As(err, &smithyErr) {
+		return smithyErr.HTTPStatusCode()
+	}
+	var providerErr *ProviderHTTPError
+	if errors.As(err, &providerErr) {
+		return providerErr.StatusCode
+	}
+	return 0
+}
@@ -0,0 +1,93 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openai/openai-go"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func retryTestModel(maxAttempts int, statusCodes ...int) *Model {
+	return &Model{
+		Model: "test-model",
+		retries: &arkv1alpha1.ModelRetries{
+			MaxAttempts:          maxAttempts,
+			Backoff:              &metav1.Duration{Duration: time.Millisecond},
+			RetryableStatusCodes: statusCodes,
+		},
+	}
+}
+
+func noopSpan() trace.Span {
+	return trace.SpanFromContext(context.Background())
+}
+
+func TestWithRetriesRecoversFromTransientError(t *testing.T) {
+	model := retryTestModel(3)
+	attempts := 0
+	response, err := model.withRetries(context.Background(), noopSpan(), func() (*openai.ChatCompletion, error) {
+		attempts++
+		if attempts < 3 {
+			return nil, &ProviderHTTPError{Provider: "test", StatusCode: 429}
+		}
+		return &openai.ChatCompletion{ID: "ok"}, nil
+	}, nil)
+	if err != nil {
+		t.Fatalf("expected recovery, got %v", err)
+	}
+	if attempts != 3 || response.ID != "ok" {
+		t.Fatalf("expected 3 attempts with response, got %d attempts", attempts)
+	}
+}
+
+func TestWithRetriesStopsOnPermanentError(t *testing.T) {
+	model := retryTestModel(3)
+	attempts := 0
+	_, err := model.withRetries(context.Background(), noopSpan(), func() (*openai.ChatCompletion, error) {
+		attempts++
+		return nil, &ProviderHTTPError{Provider: "test", StatusCode: 400}
+	}, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected no retries for a 400, got %d attempts", attempts)
+	}
+}
+
+func TestWithRetriesHonorsConfiguredStatusCodes(t *testing.T) {
+	model := retryTestModel(2, 503)
+	attempts := 0
+	_, err := model.withRetries(context.Background(), noopSpan(), func() (*openai.ChatCompletion, error) {
+		attempts++
+		return nil, &ProviderHTTPError{Provider: "test", StatusCode: 429}
+	}, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 429 not retried when only 503 is configured, got %d attempts", attempts)
+	}
+}
+
+func TestWithRetriesStopsWhenStreamDeliveredChunks(t *testing.T) {
+	model := retryTestModel(3)
+	attempts := 0
+	_, err := model.withRetries(context.Background(), noopSpan(), func() (*openai.ChatCompletion, error) {
+		attempts++
+		return nil, &ProviderHTTPError{Provider: "test", StatusCode: 429}
+	}, func() bool { return false })
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if attempts != 1 {
+		t.Fatalf("expected no retry once chunks were delivered, got %d attempts", attempts)
+	}
+}
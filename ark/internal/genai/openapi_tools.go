@@ -0,0 +1,174 @@
+package genai
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// OpenAPIToolDefinition describes a Tool generated from a single OpenAPI
+// operation
+type OpenAPIToolDefinition struct {
+	Name        string
+	Description string
+	Method      string
+	URL         string
+	InputSchema map[string]any
+}
+
+type openAPIDocument struct {
+	Servers []openAPIServer                        `json:"servers"`
+	Paths   map[string]map[string]openAPIOperation `json:"paths"`
+}
+
+type openAPIServer struct {
+	URL string `json:"url"`
+}
+
+type openAPIOperation struct {
+	OperationID string             `json:"operationId"`
+	Summary     string             `json:"summary"`
+	Description string             `json:"description"`
+	Parameters  []openAPIParameter `json:"parameters"`
+	RequestBody *openAPIBody       `json:"requestBody"`
+}
+
+type openAPIParameter struct {
+	Name        string          `json:"name"`
+	In          string          `json:"in"`
+	Required    bool            `json:"required"`
+	Description string          `json:"description"`
+	Schema      json.RawMessage `json:"schema"`
+}
+
+type openAPIBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]openAPIBodyMedia `json:"content"`
+}
+
+type openAPIBodyMedia struct {
+	Schema json.RawMessage `json:"schema"`
+}
+
+var openAPIMethods = []string{"get", "post", "put", "delete", "patch"}
+
+// GenerateToolsFromOpenAPI parses an OpenAPI document (JSON or YAML) and
+// returns one tool definition per selected operation. Operations without an
+// operationId are skipped; an empty operations filter selects everything
+func GenerateToolsFromOpenAPI(document []byte, operations []string) ([]OpenAPIToolDefinition, error) {
+	jsonDocument, err := yaml.YAMLToJSON(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI document: %w", err)
+	}
+
+	var doc openAPIDocument
+	if err := json.Unmarshal(jsonDocument, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI document: %w", err)
+	}
+	if len(doc.Paths) == 0 {
+		return nil, fmt.Errorf("OpenAPI document has no paths")
+	}
+
+	server := ""
+	if len(doc.Servers) > 0 {
+		server = strings.TrimSuffix(doc.Servers[0].URL, "/")
+	}
+
+	selected := make(map[string]bool, len(operations))
+	for _, operation := range operations {
+		selected[operation] = true
+	}
+
+	var tools []OpenAPIToolDefinition
+	for path, methods := range doc.Paths {
+		for _, method := range openAPIMethods {
+			operation, exists := methods[method]
+			if !exists || operation.OperationID == "" {
+				continue
+			}
+			if len(selected) > 0 && !selected[operation.OperationID] {
+				continue
+			}
+			tools = append(tools, buildOpenAPITool(server, path, method, operation))
+		}
+	}
+
+	return tools, nil
+}
+
+func buildOpenAPITool(server, path, method string, operation openAPIOperation) OpenAPIToolDefinition {
+	description := operation.Description
+	if description == "" {
+		description = operation.Summary
+	}
+	if description == "" {
+		description = fmt.Sprintf("%s %s", strings.ToUpper(method), path)
+	}
+
+	properties := map[string]any{}
+	var required []string
+	var queryParams []string
+
+	for _, parameter := range operation.Parameters {
+		schema := schemaToMap(parameter.Schema)
+		if parameter.Description != "" {
+			schema["description"] = parameter.Description
+		}
+		properties[parameter.Name] = schema
+		if parameter.Required {
+			required = append(required, parameter.Name)
+		}
+		if parameter.In == "query" {
+			queryParams = append(queryParams, parameter.Name)
+		}
+	}
+
+	if operation.RequestBody != nil {
+		if media, exists := operation.RequestBody.Content[ContentTypeJSON]; exists {
+			properties["body"] = schemaToMap(media.Schema)
+			if operation.RequestBody.Required {
+				required = append(required, "body")
+			}
+		}
+	}
+
+	inputSchema := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		inputSchema["required"] = required
+	}
+
+	url := server + path
+	if len(queryParams) > 0 {
+		pairs := make([]string, len(queryParams))
+		for i, name := range queryParams {
+			pairs[i] = fmt.Sprintf("%s={%s}", name, name)
+		}
+		url += "?" + strings.Join(pairs, "&")
+	}
+
+	return OpenAPIToolDefinition{
+		Name:        operation.OperationID,
+		Description: description,
+		Method:      strings.ToUpper(method),
+		URL:         url,
+		InputSchema: inputSchema,
+	}
+}
+
+func schemaToMap(raw json.RawMessage) map[string]any {
+	schema := map[string]any{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &schema); err != nil {
+			schema = map[string]any{}
+		}
+	}
+	if _, exists := schema["type"]; !exists {
+		schema["type"] = "string"
+	}
+	return schema
+}
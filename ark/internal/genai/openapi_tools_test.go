@@ -0,0 +1,89 @@
+package genai
+
+import (
+	"testing"
+)
+
+const sampleOpenAPIDoc = `{
+  "openapi": "3.0.0",
+  "servers": [{"url": "https://api.example.com/v1"}],
+  "paths": {
+    "/pets/{petId}": {
+      "get": {
+        "operationId": "getPet",
+        "summary": "Get a pet by id",
+        "parameters": [
+          {"name": "petId", "in": "path", "required": true, "schema": {"type": "integer"}},
+          {"name": "verbose", "in": "query", "schema": {"type": "boolean"}}
+        ]
+      }
+    },
+    "/pets": {
+      "post": {
+        "operationId": "createPet",
+        "requestBody": {
+          "required": true,
+          "content": {"application/json": {"schema": {"type": "object"}}}
+        }
+      }
+    }
+  }
+}`
+
+func TestGenerateToolsFromOpenAPI(t *testing.T) {
+	tools, err := GenerateToolsFromOpenAPI([]byte(sampleOpenAPIDoc), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tools) != 2 {
+		t.Fatalf("expected 2 tools, got %d", len(tools))
+	}
+
+	byName := map[string]OpenAPIToolDefinition{}
+	for _, tool := range tools {
+		byName[tool.Name] = tool
+	}
+
+	getPet, exists := byName["getPet"]
+	if !exists {
+		t.Fatal("expected getPet tool")
+	}
+	if getPet.Method != "GET" {
+		t.Errorf("expected GET, got %s", getPet.Method)
+	}
+	if getPet.URL != "https://api.example.com/v1/pets/{petId}?verbose={verbose}" {
+		t.Errorf("unexpected URL: %s", getPet.URL)
+	}
+	if getPet.Description != "Get a pet by id" {
+		t.Errorf("unexpected description: %s", getPet.Description)
+	}
+	properties := getPet.InputSchema["properties"].(map[string]any)
+	if _, exists := properties["petId"]; !exists {
+		t.Error("expected petId property")
+	}
+
+	createPet, exists := byName["createPet"]
+	if !exists {
+		t.Fatal("expected createPet tool")
+	}
+	properties = createPet.InputSchema["properties"].(map[string]any)
+	if _, exists := properties["body"]; !exists {
+		t.Error("expected body property for requestBody")
+	}
+}
+
+func TestGenerateToolsFromOpenAPIOperationFilter(t *testing.T) {
+	tools, err := GenerateToolsFromOpenAPI([]byte(sampleOpenAPIDoc), []string{"createPet"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tools) != 1 || tools[0].Name != "createPet" {
+		t.Errorf("expected only createPet, got %v", tools)
+	}
+}
+
+func TestGenerateToolsFromOpenAPIRejectsEmptyDocument(t *testing.T) {
+	if _, err := GenerateToolsFromOpenAPI([]byte(`{"openapi": "3.0.0"}`), nil); err == nil {
+		t.Error("expected error for document without paths")
+	}
+}
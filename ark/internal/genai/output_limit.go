@@ -0,0 +1,56 @@
+package genai
+
+import (
+	"context"
+)
+
+type maxOutputTokensOverrideKey struct{}
+
+// WithMaxOutputTokensOverride stores a per-query output token cap that
+// tightens the generation options of every model call under this context
+func WithMaxOutputTokensOverride(ctx context.Context, tokens int64) context.Context {
+	return context.WithValue(ctx, maxOutputTokensOverrideKey{}, tokens)
+}
+
+// MaxOutputTokensFromContext returns the query-level output token cap, or nil
+// when the query does not set one
+func MaxOutputTokensFromContext(ctx context.Context) *int64 {
+	if tokens, ok := ctx.Value(maxOutputTokensOverrideKey{}).(int64); ok {
+		return &tokens
+	}
+	return nil
+}
+
+// ApplyMaxOutputTokensOverride lowers the generation options' output token
+// limit to the query-level cap, keeping the agent's own limit when it is
+// already stricter
+func ApplyMaxOutputTokensOverride(ctx context.Context, options *GenerationOptions) *GenerationOptions {
+	override := MaxOutputTokensFromContext(ctx)
+	if override == nil {
+		return options
+	}
+	if options == nil {
+		return &GenerationOptions{MaxOutputTokens: override}
+	}
+	if options.MaxOutputTokens != nil && *options.MaxOutputTokens <= *override {
+		return options
+	}
+	copied := *options
+	copied.MaxOutputTokens = override
+	return &copied
+}
+
+// TruncateToTokenLimit cuts content down to the approximate character budget
+// for the given token limit, using the same character-to-token ratio as the
+// context window pre-check. Returns whether anything was removed
+func TruncateToTokenLimit(content string, limit int64) (string, bool) {
+	maxChars := limit * charsPerToken
+	if int64(len(content)) <= maxChars {
+		return content, false
+	}
+	runes := []rune(content)
+	if int64(len(runes)) <= maxChars {
+		return content, false
+	}
+	return string(runes[:maxChars]), true
+}
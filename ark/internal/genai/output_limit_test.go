@@ -0,0 +1,52 @@
+package genai
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func int64Ptr(value int64) *int64 {
+	return &value
+}
+
+func TestApplyMaxOutputTokensOverride(t *testing.T) {
+	ctx := WithMaxOutputTokensOverride(context.Background(), 100)
+
+	options := ApplyMaxOutputTokensOverride(ctx, nil)
+	if options == nil || options.MaxOutputTokens == nil || *options.MaxOutputTokens != 100 {
+		t.Fatalf("expected override applied to nil options, got %+v", options)
+	}
+
+	looser := ApplyMaxOutputTokensOverride(ctx, &GenerationOptions{MaxOutputTokens: int64Ptr(500)})
+	if *looser.MaxOutputTokens != 100 {
+		t.Errorf("expected query cap to lower agent limit, got %d", *looser.MaxOutputTokens)
+	}
+
+	stricter := ApplyMaxOutputTokensOverride(ctx, &GenerationOptions{MaxOutputTokens: int64Ptr(50)})
+	if *stricter.MaxOutputTokens != 50 {
+		t.Errorf("expected stricter agent limit kept, got %d", *stricter.MaxOutputTokens)
+	}
+
+	unchanged := ApplyMaxOutputTokensOverride(context.Background(), nil)
+	if unchanged != nil {
+		t.Errorf("expected nil options without override, got %+v", unchanged)
+	}
+}
+
+func TestTruncateToTokenLimit(t *testing.T) {
+	content := strings.Repeat("abcd", 100)
+
+	truncated, changed := TruncateToTokenLimit(content, 10)
+	if !changed {
+		t.Fatal("expected truncation")
+	}
+	if len(truncated) != 10*charsPerToken {
+		t.Errorf("expected %d chars, got %d", 10*charsPerToken, len(truncated))
+	}
+
+	kept, changed := TruncateToTokenLimit("short", 10)
+	if changed || kept != "short" {
+		t.Errorf("expected short content unchanged, got %q changed=%v", kept, changed)
+	}
+}
@@ -0,0 +1,114 @@
+package genai
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// maxSchemaRepairTurns bounds how many times an agent is asked to fix output
+// that does not match its output schema before the error is surfaced
+const maxSchemaRepairTurns = 2
+
+// validateOutputAgainstSchema checks the final assistant content against the
+// agent's output schema, covering the structural checks providers do not
+// guarantee: value types, required properties, and nested objects and arrays.
+func validateOutputAgainstSchema(schema *runtime.RawExtension, content string) error {
+	if schema == nil || schema.Raw == nil {
+		return nil
+	}
+
+	var schemaObj map[string]any
+	if err := json.Unmarshal(schema.Raw, &schemaObj); err != nil {
+		return nil
+	}
+
+	var value any
+	if err := json.Unmarshal([]byte(content), &value); err != nil {
+		return fmt.Errorf("output is not valid JSON: %w", err)
+	}
+
+	return checkSchemaValue(value, schemaObj, "$")
+}
+
+func checkSchemaValue(value any, schema map[string]any, path string) error {
+	expectedType, ok := schema["type"].(string)
+	if !ok {
+		return nil
+	}
+
+	switch expectedType {
+	case "object":
+		return checkSchemaObject(value, schema, path)
+	case "array":
+		return checkSchemaArray(value, schema, path)
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s: expected string, got %T", path, value)
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return fmt.Errorf("%s: expected number, got %T", path, value)
+		}
+	case "integer":
+		number, ok := value.(float64)
+		if !ok || number != float64(int64(number)) {
+			return fmt.Errorf("%s: expected integer, got %v", path, value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: expected boolean, got %T", path, value)
+		}
+	}
+	return nil
+}
+
+func checkSchemaObject(value any, schema map[string]any, path string) error {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("%s: expected object, got %T", path, value)
+	}
+
+	if required, ok := schema["required"].([]any); ok {
+		for _, name := range required {
+			requiredName, ok := name.(string)
+			if !ok {
+				continue
+			}
+			if _, present := obj[requiredName]; !present {
+				return fmt.Errorf("%s: missing required property %q", path, requiredName)
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	for name, propertyValue := range obj {
+		propertySchema, ok := properties[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		if err := checkSchemaValue(propertyValue, propertySchema, path+"."+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkSchemaArray(value any, schema map[string]any, path string) error {
+	items, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("%s: expected array, got %T", path, value)
+	}
+
+	itemSchema, ok := schema["items"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	for i, item := range items {
+		if err := checkSchemaValue(item, itemSchema, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package genai
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func personSchema() *runtime.RawExtension {
+	return &runtime.RawExtension{Raw: []byte(`{
+		"type": "object",
+		"required": ["name", "age"],
+		"properties": {
+			"name": {"type": "string"},
+			"age": {"type": "integer"},
+			"tags": {"type": "array", "items": {"type": "string"}}
+		}
+	}`)}
+}
+
+func TestValidateOutputAgainstSchemaValid(t *testing.T) {
+	if err := validateOutputAgainstSchema(personSchema(), `{"name": "Ada", "age": 36, "tags": ["pioneer"]}`); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateOutputAgainstSchemaMissingRequired(t *testing.T) {
+	err := validateOutputAgainstSchema(personSchema(), `{"name": "Ada"}`)
+	if err == nil {
+		t.Fatal("expected error for missing required property")
+	}
+}
+
+func TestValidateOutputAgainstSchemaWrongType(t *testing.T) {
+	err := validateOutputAgainstSchema(personSchema(), `{"name": "Ada", "age": "thirty-six"}`)
+	if err == nil {
+		t.Fatal("expected error for wrong property type")
+	}
+}
+
+func TestValidateOutputAgainstSchemaInvalidJSON(t *testing.T) {
+	err := validateOutputAgainstSchema(personSchema(), "not json")
+	if err == nil {
+		t.Fatal("expected error for non-JSON output")
+	}
+}
+
+func TestValidateOutputAgainstSchemaNoSchema(t *testing.T) {
+	if err := validateOutputAgainstSchema(nil, "free text"); err != nil {
+		t.Fatalf("unexpected error without schema: %v", err)
+	}
+}
@@ -0,0 +1,520 @@
+package genai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/openai/openai-go"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	anthropicDefaultBaseURL    = "https://api.anthropic.com"
+	anthropicDefaultAPIVersion = "2023-06-01"
+	anthropicDefaultMaxTokens  = 4096
+)
+
+// AnthropicProvider calls the Anthropic Messages API directly, mapping
+// OpenAI-format messages and tools to Claude's content blocks. Tool calls
+// stream natively as tool-use blocks instead of going through an
+// OpenAI-compat shim
+type AnthropicProvider struct {
+	Model             string
+	BaseURL           string
+	APIKey            string
+	APIVersion        string
+	Properties        map[string]string
+	outputSchema      *runtime.RawExtension
+	schemaName        string
+	toolChoice        string
+	generationOptions *GenerationOptions
+}
+
+type anthropicContentBlock struct {
+	Type      string          `json:"type"`
+	Text      string          `json:"text,omitempty"`
+	ID        string          `json:"id,omitempty"`
+	Name      string          `json:"name,omitempty"`
+	Input     json.RawMessage `json:"input,omitempty"`
+	ToolUseID string          `json:"tool_use_id,omitempty"`
+	Content   string          `json:"content,omitempty"`
+}
+
+type anthropicMessage struct {
+	Role    string                  `json:"role"`
+	Content []anthropicContentBlock `json:"content"`
+}
+
+type anthropicTool struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	InputSchema map[string]interface{} `json:"input_schema"`
+}
+
+type anthropicToolChoice struct {
+	Type string `json:"type"`
+}
+
+type anthropicRequest struct {
+	Model         string               `json:"model"`
+	Messages      []anthropicMessage   `json:"messages"`
+	MaxTokens     int                  `json:"max_tokens"`
+	Temperature   float64              `json:"temperature"`
+	System        string               `json:"system,omitempty"`
+	StopSequences []string             `json:"stop_sequences,omitempty"`
+	Tools         []anthropicTool      `json:"tools,omitempty"`
+	ToolChoice    *anthropicToolChoice `json:"tool_choice,omitempty"`
+	Stream        bool                 `json:"stream,omitempty"`
+}
+
+type anthropicUsage struct {
+	InputTokens  int64 `json:"input_tokens"`
+	OutputTokens int64 `json:"output_tokens"`
+}
+
+type anthropicResponse struct {
+	ID         string                  `json:"id"`
+	Model      string                  `json:"model"`
+	StopReason string                  `json:"stop_reason"`
+	Content    []anthropicContentBlock `json:"content"`
+	Usage      anthropicUsage          `json:"usage"`
+}
+
+type anthropicStreamEvent struct {
+	Type         string                 `json:"type"`
+	Index        int64                  `json:"index"`
+	Message      *anthropicResponse     `json:"message,omitempty"`
+	ContentBlock *anthropicContentBlock `json:"content_block,omitempty"`
+	Delta        struct {
+		Type        string `json:"type"`
+		Text        string `json:"text"`
+		PartialJSON string `json:"partial_json"`
+		StopReason  string `json:"stop_reason"`
+	} `json:"delta"`
+	Usage *anthropicUsage `json:"usage,omitempty"`
+	Error *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// SetOutputSchema is a no-op: the Messages API has no JSON schema response
+// format; structured output relies on prompt-level instructions.
+func (ap *AnthropicProvider) SetOutputSchema(schema *runtime.RawExtension, schemaName string) {
+	ap.outputSchema = schema
+	ap.schemaName = schemaName
+}
+
+// SetParallelToolCalls is a no-op: the Messages API has no equivalent of the
+// OpenAI parallel_tool_calls flag.
+func (ap *AnthropicProvider) SetParallelToolCalls(parallel *bool) {
+}
+
+func (ap *AnthropicProvider) SetToolChoice(choice string) {
+	ap.toolChoice = choice
+}
+
+func (ap *AnthropicProvider) SetGenerationOptions(options *GenerationOptions) {
+	ap.generationOptions = options
+}
+
+func (ap *AnthropicProvider) ChatCompletion(ctx context.Context, messages []Message, n int64, tools ...[]openai.ChatCompletionToolParam) (*openai.ChatCompletion, error) {
+	request := ap.buildRequest(messages, firstToolSet(tools), false)
+
+	resp, err := ap.send(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var response anthropicResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode Anthropic response: %w", err)
+	}
+
+	return ap.convertResponse(response), nil
+}
+
+func (ap *AnthropicProvider) ChatCompletionStream(ctx context.Context, messages []Message, n int64, streamFunc func(*openai.ChatCompletionChunk) error, tools ...[]openai.ChatCompletionToolParam) (*openai.ChatCompletion, error) {
+	request := ap.buildRequest(messages, firstToolSet(tools), true)
+
+	resp, err := ap.send(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	return ap.consumeStream(resp.Body, streamFunc)
+}
+
+// consumeStream translates Anthropic SSE events into OpenAI chunks, emitting
+// each through streamFunc and accumulating the full response. Tool-use blocks
+// stream as tool call deltas: content_block_start carries the id and name,
+// input_json_delta events carry argument fragments
+func (ap *AnthropicProvider) consumeStream(body io.Reader, streamFunc func(*openai.ChatCompletionChunk) error) (*openai.ChatCompletion, error) {
+	var fullResponse *openai.ChatCompletion
+	toolCallsMap := make(map[int64]*openai.ChatCompletionMessageToolCall)
+
+	var usage anthropicUsage
+	blockToTool := make(map[int64]int64)
+	nextToolIndex := int64(0)
+	emit := func(chunk *openai.ChatCompletionChunk) error {
+		if err := streamFunc(chunk); err != nil {
+			return err
+		}
+		accumulateStreamChunk(chunk, &fullResponse, toolCallsMap)
+		return nil
+	}
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+
+		var event anthropicStreamEvent
+		if err := json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, "data:"))), &event); err != nil {
+			continue
+		}
+		if event.Error != nil {
+			return nil, fmt.Errorf("anthropic stream error: %s: %s", event.Error.Type, event.Error.Message)
+		}
+
+		switch event.Type {
+		case "message_start":
+			if event.Message != nil {
+				usage.InputTokens = event.Message.Usage.InputTokens
+				if err := emit(ap.chunk(event.Message.ID, openai.ChatCompletionChunkChoiceDelta{Role: "assistant"}, "")); err != nil {
+					return nil, err
+				}
+			}
+		case "content_block_start":
+			if event.ContentBlock != nil && event.ContentBlock.Type == "tool_use" {
+				blockToTool[event.Index] = nextToolIndex
+				delta := openai.ChatCompletionChunkChoiceDelta{ToolCalls: []openai.ChatCompletionChunkChoiceDeltaToolCall{{
+					Index:    nextToolIndex,
+					ID:       event.ContentBlock.ID,
+					Type:     "function",
+					Function: openai.ChatCompletionChunkChoiceDeltaToolCallFunction{Name: event.ContentBlock.Name},
+				}}}
+				nextToolIndex++
+				if err := emit(ap.chunk("", delta, "")); err != nil {
+					return nil, err
+				}
+			}
+		case "content_block_delta":
+			if err := ap.emitBlockDelta(event, blockToTool, emit); err != nil {
+				return nil, err
+			}
+		case "message_delta":
+			if event.Usage != nil {
+				usage.OutputTokens = event.Usage.OutputTokens
+			}
+			if event.Delta.StopReason != "" {
+				if err := emit(ap.chunk("", openai.ChatCompletionChunkChoiceDelta{}, convertAnthropicStopReason(event.Delta.StopReason))); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read Anthropic stream: %w", err)
+	}
+
+	if fullResponse == nil {
+		return nil, fmt.Errorf("streaming completed but no response was accumulated")
+	}
+
+	// Add accumulated tool calls to the response in index order
+	if len(toolCallsMap) > 0 && len(fullResponse.Choices) > 0 {
+		toolCalls := make([]openai.ChatCompletionMessageToolCall, 0, len(toolCallsMap))
+		for i := int64(0); i < nextToolIndex; i++ {
+			if toolCall, exists := toolCallsMap[i]; exists {
+				toolCalls = append(toolCalls, *toolCall)
+			}
+		}
+		fullResponse.Choices[0].Message.ToolCalls = toolCalls
+	}
+
+	fullResponse.Model = ap.Model
+	fullResponse.Usage = openai.CompletionUsage{
+		PromptTokens:     usage.InputTokens,
+		CompletionTokens: usage.OutputTokens,
+		TotalTokens:      usage.InputTokens + usage.OutputTokens,
+	}
+	return fullResponse, nil
+}
+
+func (ap *AnthropicProvider) emitBlockDelta(event anthropicStreamEvent, blockToTool map[int64]int64, emit func(*openai.ChatCompletionChunk) error) error {
+	switch event.Delta.Type {
+	case "text_delta":
+		if event.Delta.Text != "" {
+			return emit(ap.chunk("", openai.ChatCompletionChunkChoiceDelta{Content: event.Delta.Text}, ""))
+		}
+	case "input_json_delta":
+		toolIndex, ok := blockToTool[event.Index]
+		if !ok || event.Delta.PartialJSON == "" {
+			return nil
+		}
+		delta := openai.ChatCompletionChunkChoiceDelta{ToolCalls: []openai.ChatCompletionChunkChoiceDeltaToolCall{{
+			Index:    toolIndex,
+			Function: openai.ChatCompletionChunkChoiceDeltaToolCallFunction{Arguments: event.Delta.PartialJSON},
+		}}}
+		return emit(ap.chunk("", delta, ""))
+	}
+	return nil
+}
+
+func (ap *AnthropicProvider) chunk(id string, delta openai.ChatCompletionChunkChoiceDelta, finishReason string) *openai.ChatCompletionChunk {
+	return &openai.ChatCompletionChunk{
+		ID:     id,
+		Object: "chat.completion.chunk",
+		Model:  ap.Model,
+		Choices: []openai.ChatCompletionChunkChoice{
+			{
+				Index:        0,
+				Delta:        delta,
+				FinishReason: finishReason,
+			},
+		},
+	}
+}
+
+func (ap *AnthropicProvider) send(ctx context.Context, request anthropicRequest) (*http.Response, error) {
+	requestBody, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(ap.BaseURL, "/")+"/v1/messages", bytes.NewReader(requestBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", ap.APIKey)
+	req.Header.Set("anthropic-version", ap.APIVersion)
+	if user := UserIdentityFromContext(ctx); user != "" {
+		req.Header.Set(UserIdentityHeader, user)
+	}
+
+	resp, err := sharedProviderHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call Anthropic API: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		_ = resp.Body.Close()
+		return nil, &ProviderHTTPError{Provider: "anthropic", StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	return resp, nil
+}
+
+func (ap *AnthropicProvider) buildRequest(messages []Message, tools []openai.ChatCompletionToolParam, stream bool) anthropicRequest {
+	anthropicMessages, systemPrompt := ap.convertMessages(messages)
+
+	temperature := getFloatProperty(ap.Properties, "temperature", 1.0)
+	maxTokens := getIntProperty(ap.Properties, "max_tokens", anthropicDefaultMaxTokens)
+
+	var stopSequences []string
+	if ap.generationOptions != nil {
+		if ap.generationOptions.MaxOutputTokens != nil {
+			maxTokens = int(*ap.generationOptions.MaxOutputTokens)
+		}
+		stopSequences = ap.generationOptions.Stop
+	}
+
+	request := anthropicRequest{
+		Model:         ap.Model,
+		Messages:      anthropicMessages,
+		MaxTokens:     maxTokens,
+		Temperature:   temperature,
+		System:        systemPrompt,
+		StopSequences: stopSequences,
+		Tools:         ap.convertTools(tools),
+		Stream:        stream,
+	}
+
+	if ap.toolChoice != "" && len(request.Tools) > 0 {
+		request.ToolChoice = convertAnthropicToolChoice(ap.toolChoice)
+	}
+
+	return request
+}
+
+// convertMessages maps OpenAI-format messages to Anthropic messages: system
+// content becomes the system prompt, assistant tool calls become tool_use
+// blocks, and tool results become tool_result blocks on a user message.
+// Consecutive same-role messages are merged since the API requires
+// alternating roles
+func (ap *AnthropicProvider) convertMessages(messages []Message) ([]anthropicMessage, string) {
+	var anthropicMessages []anthropicMessage
+	var systemPrompt string
+
+	appendBlocks := func(role string, blocks []anthropicContentBlock) {
+		if len(blocks) == 0 {
+			return
+		}
+		if last := len(anthropicMessages) - 1; last >= 0 && anthropicMessages[last].Role == role {
+			anthropicMessages[last].Content = append(anthropicMessages[last].Content, blocks...)
+			return
+		}
+		anthropicMessages = append(anthropicMessages, anthropicMessage{Role: role, Content: blocks})
+	}
+
+	for _, msg := range messages {
+		openaiMsg := openai.ChatCompletionMessageParamUnion(msg)
+
+		switch {
+		case openaiMsg.OfSystem != nil:
+			if content := openaiMsg.OfSystem.Content.OfString; content.Value != "" {
+				systemPrompt = content.Value
+			}
+		case openaiMsg.OfUser != nil:
+			if content := openaiMsg.OfUser.Content.OfString; content.Value != "" {
+				appendBlocks("user", []anthropicContentBlock{{Type: "text", Text: content.Value}})
+			}
+		case openaiMsg.OfAssistant != nil:
+			appendBlocks("assistant", convertAssistantBlocks(openaiMsg.OfAssistant))
+		case openaiMsg.OfTool != nil:
+			block := anthropicContentBlock{Type: "tool_result", ToolUseID: openaiMsg.OfTool.ToolCallID}
+			if content := openaiMsg.OfTool.Content.OfString; content.Value != "" {
+				block.Content = content.Value
+			}
+			appendBlocks("user", []anthropicContentBlock{block})
+		}
+	}
+
+	return anthropicMessages, systemPrompt
+}
+
+func convertAssistantBlocks(assistant *openai.ChatCompletionAssistantMessageParam) []anthropicContentBlock {
+	var blocks []anthropicContentBlock
+	if content := assistant.Content.OfString; content.Value != "" {
+		blocks = append(blocks, anthropicContentBlock{Type: "text", Text: content.Value})
+	}
+	for _, toolCall := range assistant.ToolCalls {
+		input := json.RawMessage(toolCall.Function.Arguments)
+		if len(input) == 0 {
+			input = json.RawMessage("{}")
+		}
+		blocks = append(blocks, anthropicContentBlock{
+			Type:  "tool_use",
+			ID:    toolCall.ID,
+			Name:  toolCall.Function.Name,
+			Input: input,
+		})
+	}
+	return blocks
+}
+
+func (ap *AnthropicProvider) convertTools(tools []openai.ChatCompletionToolParam) []anthropicTool {
+	var anthropicTools []anthropicTool
+	for _, tool := range tools {
+		if tool.Type != "function" {
+			continue
+		}
+		converted := anthropicTool{Name: tool.Function.Name}
+		if tool.Function.Description.Value != "" {
+			converted.Description = tool.Function.Description.Value
+		}
+		if tool.Function.Parameters != nil {
+			converted.InputSchema = map[string]interface{}(tool.Function.Parameters)
+		}
+		anthropicTools = append(anthropicTools, converted)
+	}
+	return anthropicTools
+}
+
+func (ap *AnthropicProvider) convertResponse(response anthropicResponse) *openai.ChatCompletion {
+	var content string
+	var toolCalls []openai.ChatCompletionMessageToolCall
+
+	for _, block := range response.Content {
+		switch block.Type {
+		case "text":
+			content += block.Text
+		case "tool_use":
+			arguments := string(block.Input)
+			if arguments == "" {
+				arguments = "{}"
+			}
+			toolCalls = append(toolCalls, openai.ChatCompletionMessageToolCall{
+				ID:   block.ID,
+				Type: "function",
+				Function: openai.ChatCompletionMessageToolCallFunction{
+					Name:      block.Name,
+					Arguments: arguments,
+				},
+			})
+		}
+	}
+
+	message := openai.ChatCompletionMessage{
+		Role:    "assistant",
+		Content: content,
+	}
+	if len(toolCalls) > 0 {
+		message.ToolCalls = toolCalls
+	}
+
+	return &openai.ChatCompletion{
+		ID:     response.ID,
+		Object: "chat.completion",
+		Model:  response.Model,
+		Choices: []openai.ChatCompletionChoice{
+			{
+				Index:        0,
+				Message:      message,
+				FinishReason: convertAnthropicStopReason(response.StopReason),
+			},
+		},
+		Usage: openai.CompletionUsage{
+			PromptTokens:     response.Usage.InputTokens,
+			CompletionTokens: response.Usage.OutputTokens,
+			TotalTokens:      response.Usage.InputTokens + response.Usage.OutputTokens,
+		},
+	}
+}
+
+func convertAnthropicStopReason(stopReason string) string {
+	switch stopReason {
+	case "max_tokens":
+		return "length"
+	case "tool_use":
+		return "tool_calls"
+	default:
+		return "stop"
+	}
+}
+
+func convertAnthropicToolChoice(choice string) *anthropicToolChoice {
+	switch choice {
+	case "required":
+		return &anthropicToolChoice{Type: "any"}
+	case "none":
+		return &anthropicToolChoice{Type: "none"}
+	default:
+		return &anthropicToolChoice{Type: "auto"}
+	}
+}
+
+func (ap *AnthropicProvider) BuildConfig() map[string]any {
+	config := map[string]any{
+		"baseUrl": ap.BaseURL,
+	}
+	if ap.APIKey != "" {
+		config["apiKey"] = ap.APIKey
+	}
+	if ap.APIVersion != "" {
+		config["apiVersion"] = ap.APIVersion
+	}
+	return config
+}
@@ -0,0 +1,113 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openai/openai-go"
+)
+
+func TestAnthropicConvertMessagesMapsToolRounds(t *testing.T) {
+	provider := &AnthropicProvider{Model: "claude-3-5-sonnet"}
+
+	assistant := openai.ChatCompletionMessageParamUnion{
+		OfAssistant: &openai.ChatCompletionAssistantMessageParam{
+			ToolCalls: []openai.ChatCompletionMessageToolCallParam{{
+				ID: "toolu_1",
+				Function: openai.ChatCompletionMessageToolCallFunctionParam{
+					Name:      "get_weather",
+					Arguments: `{"city":"Boston"}`,
+				},
+			}},
+		},
+	}
+
+	messages := []Message{
+		NewSystemMessage("be brief"),
+		NewUserMessage("weather in Boston?"),
+		Message(assistant),
+		ToolMessage("72F", "toolu_1"),
+	}
+
+	converted, systemPrompt := provider.convertMessages(messages)
+	if systemPrompt != "be brief" {
+		t.Errorf("expected system prompt, got %q", systemPrompt)
+	}
+	if len(converted) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(converted))
+	}
+	if converted[1].Role != "assistant" || converted[1].Content[0].Type != "tool_use" {
+		t.Errorf("expected assistant tool_use block, got %+v", converted[1])
+	}
+	if converted[2].Role != "user" || converted[2].Content[0].Type != "tool_result" || converted[2].Content[0].ToolUseID != "toolu_1" {
+		t.Errorf("expected user tool_result block, got %+v", converted[2])
+	}
+}
+
+func TestAnthropicConvertResponseMapsToolUse(t *testing.T) {
+	provider := &AnthropicProvider{Model: "claude-3-5-sonnet"}
+
+	completion := provider.convertResponse(anthropicResponse{
+		ID:         "msg_1",
+		StopReason: "tool_use",
+		Content: []anthropicContentBlock{
+			{Type: "text", Text: "checking"},
+			{Type: "tool_use", ID: "toolu_1", Name: "get_weather", Input: []byte(`{"city":"Boston"}`)},
+		},
+		Usage: anthropicUsage{InputTokens: 10, OutputTokens: 5},
+	})
+
+	choice := completion.Choices[0]
+	if choice.FinishReason != "tool_calls" {
+		t.Errorf("expected tool_calls finish reason, got %q", choice.FinishReason)
+	}
+	if len(choice.Message.ToolCalls) != 1 || choice.Message.ToolCalls[0].Function.Name != "get_weather" {
+		t.Errorf("expected one get_weather tool call, got %+v", choice.Message.ToolCalls)
+	}
+	if completion.Usage.TotalTokens != 15 {
+		t.Errorf("expected 15 total tokens, got %d", completion.Usage.TotalTokens)
+	}
+}
+
+func TestAnthropicConsumeStreamAccumulatesToolCalls(t *testing.T) {
+	provider := &AnthropicProvider{Model: "claude-3-5-sonnet"}
+
+	events := strings.Join([]string{
+		`data: {"type":"message_start","message":{"id":"msg_1","usage":{"input_tokens":12}}}`,
+		`data: {"type":"content_block_start","index":0,"content_block":{"type":"text"}}`,
+		`data: {"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"checking"}}`,
+		`data: {"type":"content_block_start","index":1,"content_block":{"type":"tool_use","id":"toolu_1","name":"get_weather"}}`,
+		`data: {"type":"content_block_delta","index":1,"delta":{"type":"input_json_delta","partial_json":"{\"city\":"}}`,
+		`data: {"type":"content_block_delta","index":1,"delta":{"type":"input_json_delta","partial_json":"\"Boston\"}"}}`,
+		`data: {"type":"message_delta","delta":{"stop_reason":"tool_use"},"usage":{"output_tokens":7}}`,
+		`data: {"type":"message_stop"}`,
+	}, "\n\n") + "\n"
+
+	var chunks int
+	completion, err := provider.consumeStream(strings.NewReader(events), func(chunk *openai.ChatCompletionChunk) error {
+		chunks++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if chunks == 0 {
+		t.Error("expected chunks to be streamed")
+	}
+
+	choice := completion.Choices[0]
+	if choice.Message.Content != "checking" {
+		t.Errorf("expected accumulated content, got %q", choice.Message.Content)
+	}
+	if len(choice.Message.ToolCalls) != 1 {
+		t.Fatalf("expected one tool call, got %d", len(choice.Message.ToolCalls))
+	}
+	if choice.Message.ToolCalls[0].Function.Arguments != `{"city":"Boston"}` {
+		t.Errorf("expected reassembled arguments, got %q", choice.Message.ToolCalls[0].Function.Arguments)
+	}
+	if completion.Usage.TotalTokens != 19 {
+		t.Errorf("expected 19 total tokens, got %d", completion.Usage.TotalTokens)
+	}
+}
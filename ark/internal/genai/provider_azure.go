@@ -7,17 +7,19 @@ import (
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/option"
 	"k8s.io/apimachinery/pkg/runtime"
-	"mckinsey.com/ark/internal/common"
 )
 
 type AzureProvider struct {
-	Model        string
-	BaseURL      string
-	APIVersion   string
-	APIKey       string
-	Properties   map[string]string
-	outputSchema *runtime.RawExtension
-	schemaName   string
+	Model             string
+	BaseURL           string
+	APIVersion        string
+	APIKey            string
+	Properties        map[string]string
+	outputSchema      *runtime.RawExtension
+	schemaName        string
+	parallelToolCalls *bool
+	toolChoice        string
+	generationOptions *GenerationOptions
 }
 
 func (ap *AzureProvider) SetOutputSchema(schema *runtime.RawExtension, schemaName string) {
@@ -25,6 +27,18 @@ func (ap *AzureProvider) SetOutputSchema(schema *runtime.RawExtension, schemaNam
 	ap.schemaName = schemaName
 }
 
+func (ap *AzureProvider) SetParallelToolCalls(parallel *bool) {
+	ap.parallelToolCalls = parallel
+}
+
+func (ap *AzureProvider) SetToolChoice(choice string) {
+	ap.toolChoice = choice
+}
+
+func (ap *AzureProvider) SetGenerationOptions(options *GenerationOptions) {
+	ap.generationOptions = options
+}
+
 func (ap *AzureProvider) ChatCompletion(ctx context.Context, messages []Message, n int64, tools ...[]openai.ChatCompletionToolParam) (*openai.ChatCompletion, error) {
 	openaiMessages := make([]openai.ChatCompletionMessageParamUnion, len(messages))
 	for i, msg := range messages {
@@ -38,9 +52,13 @@ func (ap *AzureProvider) ChatCompletion(ctx context.Context, messages []Message,
 	}
 
 	applyPropertiesToParams(ap.Properties, &params)
+	applyGenerationOptionsToParams(ap.generationOptions, &params)
+	applyChargebackToParams(ctx, &params)
 
 	if len(tools) > 0 && len(tools[0]) > 0 {
 		params.Tools = tools[0]
+		applyParallelToolCallsToParams(ap.parallelToolCalls, &params)
+		applyToolChoiceToParams(ap.toolChoice, &params)
 	}
 
 	// Apply structured output schema if provided
@@ -51,7 +69,7 @@ func (ap *AzureProvider) ChatCompletion(ctx context.Context, messages []Message,
 }
 
 // prepareStreamParams prepares the parameters for streaming chat completion
-func (ap *AzureProvider) prepareStreamParams(messages []Message, n int64, tools ...[]openai.ChatCompletionToolParam) openai.ChatCompletionNewParams {
+func (ap *AzureProvider) prepareStreamParams(ctx context.Context, messages []Message, n int64, tools ...[]openai.ChatCompletionToolParam) openai.ChatCompletionNewParams {
 	openaiMessages := make([]openai.ChatCompletionMessageParamUnion, len(messages))
 	for i, msg := range messages {
 		openaiMessages[i] = openai.ChatCompletionMessageParamUnion(msg)
@@ -64,9 +82,13 @@ func (ap *AzureProvider) prepareStreamParams(messages []Message, n int64, tools
 	}
 
 	applyPropertiesToParams(ap.Properties, &params)
+	applyGenerationOptionsToParams(ap.generationOptions, &params)
+	applyChargebackToParams(ctx, &params)
 
 	if len(tools) > 0 && len(tools[0]) > 0 {
 		params.Tools = tools[0]
+		applyParallelToolCallsToParams(ap.parallelToolCalls, &params)
+		applyToolChoiceToParams(ap.toolChoice, &params)
 	}
 
 	// Apply structured output schema if provided
@@ -76,7 +98,7 @@ func (ap *AzureProvider) prepareStreamParams(messages []Message, n int64, tools
 }
 
 func (ap *AzureProvider) ChatCompletionStream(ctx context.Context, messages []Message, n int64, streamFunc func(*openai.ChatCompletionChunk) error, tools ...[]openai.ChatCompletionToolParam) (*openai.ChatCompletion, error) {
-	params := ap.prepareStreamParams(messages, n, tools...)
+	params := ap.prepareStreamParams(ctx, messages, n, tools...)
 	client := ap.createClient(ctx)
 	stream := client.Chat.Completions.NewStreaming(ctx, params)
 	defer func() { _ = stream.Close() }()
@@ -136,16 +158,17 @@ func (ap *AzureProvider) ChatCompletionStream(ctx context.Context, messages []Me
 }
 
 func (ap *AzureProvider) createClient(ctx context.Context) openai.Client {
-	httpClient := common.NewHTTPClientWithLogging(ctx)
-
-	deploymentURL := fmt.Sprintf("%s/openai/deployments/%s", ap.BaseURL, ap.Model)
-	return openai.NewClient(
-		option.WithBaseURL(deploymentURL),
-		option.WithHeader("api-key", ap.APIKey),
-		option.WithAPIKey(ap.APIKey),
-		option.WithHTTPClient(httpClient),
-		option.WithQueryAdd("api-version", ap.APIVersion),
-	)
+	client := cachedProviderClient(clientFingerprint("azure", ap.BaseURL, ap.Model, ap.APIKey, ap.APIVersion), func() openai.Client {
+		deploymentURL := fmt.Sprintf("%s/openai/deployments/%s", ap.BaseURL, ap.Model)
+		return openai.NewClient(
+			option.WithBaseURL(deploymentURL),
+			option.WithHeader("api-key", ap.APIKey),
+			option.WithAPIKey(ap.APIKey),
+			option.WithHTTPClient(sharedProviderHTTPClient),
+			option.WithQueryAdd("api-version", ap.APIVersion),
+		)
+	})
+	return withRequestOptions(client, perRequestOptions(ctx))
 }
 
 func (ap *AzureProvider) BuildConfig() map[string]any {
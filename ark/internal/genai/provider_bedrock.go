@@ -23,9 +23,10 @@ type BedrockModel struct {
 	SessionToken    string
 	ModelArn        string
 	Properties      map[string]string
-	client          *bedrockruntime.Client
-	outputSchema    *runtime.RawExtension
-	schemaName      string
+	client            *bedrockruntime.Client
+	outputSchema      *runtime.RawExtension
+	schemaName        string
+	generationOptions *GenerationOptions
 }
 
 type bedrockMessage struct {
@@ -38,6 +39,7 @@ type bedrockRequest struct {
 	MaxTokens        int              `json:"max_tokens"`
 	Temperature      float64          `json:"temperature"`
 	SystemPrompt     string           `json:"system,omitempty"`
+	StopSequences    []string         `json:"stop_sequences,omitempty"`
 	AnthropicVersion string           `json:"anthropic_version,omitempty"`
 	Tools            []bedrockTool    `json:"tools,omitempty"`
 }
@@ -113,6 +115,22 @@ func (bm *BedrockModel) SetOutputSchema(schema *runtime.RawExtension, schemaName
 	bm.schemaName = schemaName
 }
 
+// SetParallelToolCalls is a no-op: the Bedrock Converse API has no equivalent
+// of the OpenAI parallel_tool_calls flag.
+func (bm *BedrockModel) SetParallelToolCalls(parallel *bool) {
+}
+
+// SetToolChoice is a no-op: tool choice is not mapped into the invoke request
+// format used here.
+func (bm *BedrockModel) SetToolChoice(choice string) {
+}
+
+// SetGenerationOptions applies max output tokens and stop sequences; logit bias
+// has no Bedrock equivalent and is ignored.
+func (bm *BedrockModel) SetGenerationOptions(options *GenerationOptions) {
+	bm.generationOptions = options
+}
+
 func (bm *BedrockModel) ChatCompletion(ctx context.Context, messages []Message, n int64, tools ...[]openai.ChatCompletionToolParam) (*openai.ChatCompletion, error) {
 	var toolsParam []openai.ChatCompletionToolParam
 	if len(tools) > 0 {
@@ -207,12 +225,21 @@ func (bm *BedrockModel) buildRequest(messages []bedrockMessage, systemPrompt str
 	temperature := getFloatProperty(bm.Properties, "temperature", 1.0)
 	maxTokens := getIntProperty(bm.Properties, "max_tokens", 4096)
 
+	var stopSequences []string
+	if bm.generationOptions != nil {
+		if bm.generationOptions.MaxOutputTokens != nil {
+			maxTokens = int(*bm.generationOptions.MaxOutputTokens)
+		}
+		stopSequences = bm.generationOptions.Stop
+	}
+
 	return bedrockRequest{
-		Messages:     messages,
-		MaxTokens:    maxTokens,
-		Temperature:  temperature,
-		SystemPrompt: systemPrompt,
-		Tools:        tools,
+		Messages:      messages,
+		MaxTokens:     maxTokens,
+		Temperature:   temperature,
+		SystemPrompt:  systemPrompt,
+		StopSequences: stopSequences,
+		Tools:         tools,
 	}
 }
 
@@ -0,0 +1,70 @@
+package genai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	"mckinsey.com/ark/internal/common"
+)
+
+// sharedProviderHTTPClient is reused across all provider clients so TCP
+// connections and TLS sessions are pooled rather than re-established per call
+var sharedProviderHTTPClient = common.NewHTTPClientWithLogging(context.Background())
+
+var providerClients = struct {
+	sync.RWMutex
+	entries map[string]openai.Client
+}{entries: map[string]openai.Client{}}
+
+// cachedProviderClient returns the client for the given config fingerprint,
+// building and caching it on first use. Fingerprints cover credentials and
+// endpoint config, so a rotated secret or changed spec yields a fresh client
+func cachedProviderClient(fingerprint string, build func() openai.Client) openai.Client {
+	providerClients.RLock()
+	client, ok := providerClients.entries[fingerprint]
+	providerClients.RUnlock()
+	if ok {
+		return client
+	}
+
+	providerClients.Lock()
+	defer providerClients.Unlock()
+	if client, ok := providerClients.entries[fingerprint]; ok {
+		return client
+	}
+	client = build()
+	providerClients.entries[fingerprint] = client
+	return client
+}
+
+// clientFingerprint hashes the identifying parts of a provider config into a
+// cache key
+func clientFingerprint(parts ...string) string {
+	digest := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(digest[:])
+}
+
+// perRequestOptions collects the context-derived options - chargeback headers
+// and the originating user - that cannot be baked into a cached client
+func perRequestOptions(ctx context.Context) []option.RequestOption {
+	options := chargebackHeaderOptions(ctx)
+	return append(options, userIdentityHeaderOptions(ctx)...)
+}
+
+// withRequestOptions returns the client unchanged when there are no
+// per-request options, otherwise a derived client carrying them; the derived
+// client still shares the pooled transport
+func withRequestOptions(client openai.Client, options []option.RequestOption) openai.Client {
+	if len(options) == 0 {
+		return client
+	}
+	merged := make([]option.RequestOption, 0, len(client.Options)+len(options))
+	merged = append(merged, client.Options...)
+	merged = append(merged, options...)
+	return openai.NewClient(merged...)
+}
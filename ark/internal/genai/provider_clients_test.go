@@ -0,0 +1,50 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"testing"
+
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+)
+
+func TestCachedProviderClientBuildsOncePerFingerprint(t *testing.T) {
+	builds := 0
+	build := func() openai.Client {
+		builds++
+		return openai.NewClient(option.WithAPIKey("key"))
+	}
+
+	fingerprint := clientFingerprint("test", "https://api.example.com", "key-one")
+	cachedProviderClient(fingerprint, build)
+	cachedProviderClient(fingerprint, build)
+	if builds != 1 {
+		t.Errorf("expected 1 build for repeated fingerprint, got %d", builds)
+	}
+
+	rotated := clientFingerprint("test", "https://api.example.com", "key-two")
+	cachedProviderClient(rotated, build)
+	if builds != 2 {
+		t.Errorf("expected rotated credentials to build a new client, got %d builds", builds)
+	}
+}
+
+func TestClientFingerprintDistinguishesParts(t *testing.T) {
+	if clientFingerprint("a", "bc") == clientFingerprint("ab", "c") {
+		t.Error("expected part boundaries to affect the fingerprint")
+	}
+}
+
+func TestWithRequestOptionsReturnsBaseWhenEmpty(t *testing.T) {
+	base := openai.NewClient(option.WithAPIKey("key"))
+	derived := withRequestOptions(base, nil)
+	if len(derived.Options) != len(base.Options) {
+		t.Error("expected base client unchanged when no per-request options")
+	}
+
+	derived = withRequestOptions(base, []option.RequestOption{option.WithHeader("X-Ark-team", "core")})
+	if len(derived.Options) <= len(base.Options) {
+		t.Error("expected derived client to carry the per-request options")
+	}
+}
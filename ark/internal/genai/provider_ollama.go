@@ -0,0 +1,65 @@
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const ollamaPlaceholderAPIKey = "ollama"
+
+// OllamaProvider serves chat completions through the Ollama server's
+// OpenAI-compatible endpoint, and adds a readiness probe against the native
+// API so the Model controller can report when the target model is not pulled
+type OllamaProvider struct {
+	OpenAIProvider
+	ServerURL string
+}
+
+type ollamaTagsResponse struct {
+	Models []struct {
+		Name string `json:"name"`
+	} `json:"models"`
+}
+
+// Probe checks /api/tags on the Ollama server and fails when the server is
+// unreachable or the configured model has not been pulled
+func (op *OllamaProvider) Probe(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, op.ServerURL+"/api/tags", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create ollama tags request: %w", err)
+	}
+
+	resp, err := sharedProviderHTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("ollama server unreachable: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("ollama tags request failed (%d)", resp.StatusCode)
+	}
+
+	var tags ollamaTagsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tags); err != nil {
+		return fmt.Errorf("failed to decode ollama tags response: %w", err)
+	}
+
+	for _, served := range tags.Models {
+		if ollamaModelMatches(served.Name, op.Model) {
+			return nil
+		}
+	}
+	return fmt.Errorf("model '%s' is not pulled on the ollama server", op.Model)
+}
+
+// ollamaModelMatches treats a missing tag as :latest, so "llama3" matches a
+// served "llama3:latest"
+func ollamaModelMatches(served, want string) bool {
+	if served == want {
+		return true
+	}
+	return strings.TrimSuffix(served, ":latest") == strings.TrimSuffix(want, ":latest")
+}
@@ -0,0 +1,57 @@
+package genai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func ollamaTagsServer(t *testing.T, models ...string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/tags" {
+			http.NotFound(w, r)
+			return
+		}
+		var entries []string
+		for _, model := range models {
+			entries = append(entries, `{"name":"`+model+`"}`)
+		}
+		_, _ = w.Write([]byte(`{"models":[` + strings.Join(entries, ",") + `]}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestOllamaProbeSucceedsWhenModelPulled(t *testing.T) {
+	server := ollamaTagsServer(t, "llama3:latest", "mistral:7b")
+
+	provider := &OllamaProvider{OpenAIProvider: OpenAIProvider{Model: "llama3"}, ServerURL: server.URL}
+	if err := provider.Probe(context.Background()); err != nil {
+		t.Fatalf("expected probe to succeed, got %v", err)
+	}
+}
+
+func TestOllamaProbeFailsWhenModelNotPulled(t *testing.T) {
+	server := ollamaTagsServer(t, "mistral:7b")
+
+	provider := &OllamaProvider{OpenAIProvider: OpenAIProvider{Model: "llama3"}, ServerURL: server.URL}
+	err := provider.Probe(context.Background())
+	if err == nil {
+		t.Fatal("expected probe to fail for missing model")
+	}
+	if !strings.Contains(err.Error(), "not pulled") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestOllamaModelMatchesDefaultsToLatestTag(t *testing.T) {
+	if !ollamaModelMatches("llama3:latest", "llama3") {
+		t.Error("expected llama3 to match llama3:latest")
+	}
+	if ollamaModelMatches("llama3:8b", "llama3") {
+		t.Error("expected llama3 not to match llama3:8b")
+	}
+}
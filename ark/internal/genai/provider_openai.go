@@ -3,22 +3,39 @@ package genai
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/openai/openai-go"
 	"github.com/openai/openai-go/option"
 	"github.com/openai/openai-go/shared/constant"
 	"k8s.io/apimachinery/pkg/runtime"
-	"mckinsey.com/ark/internal/common"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 type OpenAIProvider struct {
-	Model        string
-	BaseURL      string
-	APIKey       string
-	Properties   map[string]string
-	outputSchema *runtime.RawExtension
-	schemaName   string
+	Model             string
+	BaseURL           string
+	APIKey            string
+	Properties        map[string]string
+	Endpoint          *OpenAIEndpointOverrides
+	CompletionMode    string
+	PromptTemplate    string
+	outputSchema      *runtime.RawExtension
+	schemaName        string
+	parallelToolCalls *bool
+	toolChoice        string
+	generationOptions *GenerationOptions
+}
+
+// OpenAIEndpointOverrides adapts requests to OpenAI-compatible gateways with
+// non-standard path or auth conventions, resolved from the Model config
+type OpenAIEndpointOverrides struct {
+	PathTemplate     string
+	AuthHeaderName   string
+	AuthHeaderFormat string
+	QueryParams      map[string]string
 }
 
 func (op *OpenAIProvider) SetOutputSchema(schema *runtime.RawExtension, schemaName string) {
@@ -26,7 +43,23 @@ func (op *OpenAIProvider) SetOutputSchema(schema *runtime.RawExtension, schemaNa
 	op.schemaName = schemaName
 }
 
+func (op *OpenAIProvider) SetParallelToolCalls(parallel *bool) {
+	op.parallelToolCalls = parallel
+}
+
+func (op *OpenAIProvider) SetToolChoice(choice string) {
+	op.toolChoice = choice
+}
+
+func (op *OpenAIProvider) SetGenerationOptions(options *GenerationOptions) {
+	op.generationOptions = options
+}
+
 func (op *OpenAIProvider) ChatCompletion(ctx context.Context, messages []Message, n int64, tools ...[]openai.ChatCompletionToolParam) (*openai.ChatCompletion, error) {
+	if op.CompletionMode == CompletionModeText {
+		return op.textCompletion(ctx, messages, n, firstToolSet(tools))
+	}
+
 	openaiMessages := make([]openai.ChatCompletionMessageParamUnion, len(messages))
 	for i, msg := range messages {
 		openaiMessages[i] = openai.ChatCompletionMessageParamUnion(msg)
@@ -39,9 +72,13 @@ func (op *OpenAIProvider) ChatCompletion(ctx context.Context, messages []Message
 	}
 
 	applyPropertiesToParams(op.Properties, &params)
+	applyGenerationOptionsToParams(op.generationOptions, &params)
+	applyChargebackToParams(ctx, &params)
 
 	if len(tools) > 0 && len(tools[0]) > 0 {
 		params.Tools = tools[0]
+		applyParallelToolCallsToParams(op.parallelToolCalls, &params)
+		applyToolChoiceToParams(op.toolChoice, &params)
 	}
 
 	// Apply structured output schema if provided
@@ -185,7 +222,7 @@ func (op *OpenAIProvider) sendFinalToolCallChunk(fullResponse *openai.ChatComple
 }
 
 // prepareStreamParams prepares the parameters for streaming chat completion
-func (op *OpenAIProvider) prepareStreamParams(messages []Message, n int64, tools ...[]openai.ChatCompletionToolParam) openai.ChatCompletionNewParams {
+func (op *OpenAIProvider) prepareStreamParams(ctx context.Context, messages []Message, n int64, tools ...[]openai.ChatCompletionToolParam) openai.ChatCompletionNewParams {
 	openaiMessages := make([]openai.ChatCompletionMessageParamUnion, len(messages))
 	for i, msg := range messages {
 		openaiMessages[i] = openai.ChatCompletionMessageParamUnion(msg)
@@ -198,9 +235,13 @@ func (op *OpenAIProvider) prepareStreamParams(messages []Message, n int64, tools
 	}
 
 	applyPropertiesToParams(op.Properties, &params)
+	applyGenerationOptionsToParams(op.generationOptions, &params)
+	applyChargebackToParams(ctx, &params)
 
 	if len(tools) > 0 && len(tools[0]) > 0 {
 		params.Tools = tools[0]
+		applyParallelToolCallsToParams(op.parallelToolCalls, &params)
+		applyToolChoiceToParams(op.toolChoice, &params)
 	}
 
 	// Apply structured output schema if provided
@@ -212,7 +253,11 @@ func (op *OpenAIProvider) prepareStreamParams(messages []Message, n int64, tools
 func (op *OpenAIProvider) ChatCompletionStream(ctx context.Context, messages []Message, n int64, streamFunc func(*openai.ChatCompletionChunk) error, tools ...[]openai.ChatCompletionToolParam) (*openai.ChatCompletion, error) {
 	logf.Log.Info("OpenAIProvider.ChatCompletionStream called", "messageCount", len(messages), "toolCount", len(tools))
 
-	params := op.prepareStreamParams(messages, n, tools...)
+	if op.CompletionMode == CompletionModeText {
+		return op.textCompletionStream(ctx, messages, n, streamFunc, firstToolSet(tools))
+	}
+
+	params := op.prepareStreamParams(ctx, messages, n, tools...)
 
 	client := op.createClient(ctx)
 	stream := client.Chat.Completions.NewStreaming(ctx, params)
@@ -259,13 +304,67 @@ func (op *OpenAIProvider) ChatCompletionStream(ctx context.Context, messages []M
 }
 
 func (op *OpenAIProvider) createClient(ctx context.Context) openai.Client {
-	httpClient := common.NewHTTPClientWithLogging(ctx)
+	client := cachedProviderClient(op.clientFingerprint(), func() openai.Client {
+		options := []option.RequestOption{
+			option.WithBaseURL(op.BaseURL),
+			option.WithHTTPClient(sharedProviderHTTPClient),
+		}
+		options = append(options, op.endpointOptions()...)
+		return openai.NewClient(options...)
+	})
+	return withRequestOptions(client, perRequestOptions(ctx))
+}
+
+func (op *OpenAIProvider) clientFingerprint() string {
+	parts := []string{"openai", op.BaseURL, op.APIKey, op.Model}
+	if op.Endpoint != nil {
+		parts = append(parts, op.Endpoint.PathTemplate, op.Endpoint.AuthHeaderName, op.Endpoint.AuthHeaderFormat)
+		keys := make([]string, 0, len(op.Endpoint.QueryParams))
+		for key := range op.Endpoint.QueryParams {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			parts = append(parts, key, op.Endpoint.QueryParams[key])
+		}
+	}
+	return clientFingerprint(parts...)
+}
+
+// endpointOptions applies the gateway overrides - custom auth header, extra
+// query params and request path - falling back to standard OpenAI auth
+func (op *OpenAIProvider) endpointOptions() []option.RequestOption {
+	if op.Endpoint == nil {
+		return []option.RequestOption{option.WithAPIKey(op.APIKey)}
+	}
+
+	var options []option.RequestOption
+	if op.Endpoint.AuthHeaderName != "" {
+		value := op.APIKey
+		if op.Endpoint.AuthHeaderFormat != "" {
+			value = fmt.Sprintf(op.Endpoint.AuthHeaderFormat, op.APIKey)
+		}
+		options = append(options, option.WithHeader(op.Endpoint.AuthHeaderName, value))
+	} else {
+		options = append(options, option.WithAPIKey(op.APIKey))
+	}
+
+	for key, value := range op.Endpoint.QueryParams {
+		options = append(options, option.WithQuery(key, value))
+	}
+
+	if template := op.Endpoint.PathTemplate; template != "" {
+		path := strings.ReplaceAll(template, "{model}", op.Model)
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		options = append(options, option.WithMiddleware(func(req *http.Request, next option.MiddlewareNext) (*http.Response, error) {
+			req.URL.Path = path
+			return next(req)
+		}))
+	}
 
-	return openai.NewClient(
-		option.WithBaseURL(op.BaseURL),
-		option.WithAPIKey(op.APIKey),
-		option.WithHTTPClient(httpClient),
-	)
+	return options
 }
 
 func (op *OpenAIProvider) BuildConfig() map[string]any {
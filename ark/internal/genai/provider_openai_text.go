@@ -0,0 +1,169 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/shared/constant"
+)
+
+const (
+	CompletionModeChat = "chat"
+	CompletionModeText = "text"
+)
+
+// defaultPromptTemplate renders the conversation as a role-prefixed
+// transcript with a trailing assistant cue, a safe default for base models
+// without a chat template
+const defaultPromptTemplate = `{{range .Messages}}{{.Role}}: {{.Content}}
+
+{{end}}assistant:`
+
+type promptMessage struct {
+	Role    string
+	Content string
+}
+
+func firstToolSet(tools [][]openai.ChatCompletionToolParam) []openai.ChatCompletionToolParam {
+	if len(tools) > 0 {
+		return tools[0]
+	}
+	return nil
+}
+
+// renderPrompt flattens chat messages into a single text prompt using the
+// configured template, or the default transcript template when none is set
+func renderPrompt(messages []Message, templateText string) (string, error) {
+	if templateText == "" {
+		templateText = defaultPromptTemplate
+	}
+	tmpl, err := template.New("prompt").Parse(templateText)
+	if err != nil {
+		return "", fmt.Errorf("invalid prompt template: %w", err)
+	}
+
+	rendered := make([]promptMessage, 0, len(messages))
+	for _, message := range messages {
+		role, content := messageRoleAndContent(message)
+		rendered = append(rendered, promptMessage{Role: role, Content: content})
+	}
+
+	var prompt strings.Builder
+	if err := tmpl.Execute(&prompt, map[string]any{"Messages": rendered}); err != nil {
+		return "", fmt.Errorf("failed to render prompt template: %w", err)
+	}
+	return prompt.String(), nil
+}
+
+func messageRoleAndContent(message Message) (string, string) {
+	switch {
+	case message.OfSystem != nil:
+		return "system", message.OfSystem.Content.OfString.Value
+	case message.OfUser != nil:
+		return "user", message.OfUser.Content.OfString.Value
+	case message.OfAssistant != nil:
+		return "assistant", message.OfAssistant.Content.OfString.Value
+	case message.OfTool != nil:
+		return "tool", message.OfTool.Content.OfString.Value
+	default:
+		return "user", ""
+	}
+}
+
+// textCompletion serves chat requests for text-completion-only models by
+// rendering the conversation into a prompt and converting the legacy
+// completion response back into chat shape. Tools are not supported in text
+// mode
+func (op *OpenAIProvider) textCompletion(ctx context.Context, messages []Message, n int64, tools []openai.ChatCompletionToolParam) (*openai.ChatCompletion, error) {
+	if len(tools) > 0 {
+		return nil, fmt.Errorf("model %s uses text completion mode, which does not support tools", op.Model)
+	}
+
+	prompt, err := renderPrompt(messages, op.PromptTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	params := openai.CompletionNewParams{
+		Model:  openai.CompletionNewParamsModel(op.Model),
+		Prompt: openai.CompletionNewParamsPromptUnion{OfString: openai.String(prompt)},
+		N:      openai.Int(n),
+	}
+	applyGenerationOptionsToCompletionParams(op.generationOptions, &params)
+
+	client := op.createClient(ctx)
+	completion, err := client.Completions.New(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return convertTextCompletion(completion), nil
+}
+
+// textCompletionStream satisfies streaming requests in text mode by sending
+// the complete response as a single chunk, per the OpenAI fallback spec
+func (op *OpenAIProvider) textCompletionStream(ctx context.Context, messages []Message, n int64, streamFunc func(*openai.ChatCompletionChunk) error, tools []openai.ChatCompletionToolParam) (*openai.ChatCompletion, error) {
+	completion, err := op.textCompletion(ctx, messages, n, tools)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, choice := range completion.Choices {
+		chunk := &openai.ChatCompletionChunk{
+			ID:      completion.ID,
+			Object:  "chat.completion.chunk",
+			Created: completion.Created,
+			Model:   completion.Model,
+			Choices: []openai.ChatCompletionChunkChoice{
+				{
+					Index: choice.Index,
+					Delta: openai.ChatCompletionChunkChoiceDelta{
+						Content: choice.Message.Content,
+						Role:    "assistant",
+					},
+					FinishReason: choice.FinishReason,
+				},
+			},
+		}
+		if err := streamFunc(chunk); err != nil {
+			return nil, err
+		}
+	}
+
+	return completion, nil
+}
+
+func applyGenerationOptionsToCompletionParams(options *GenerationOptions, params *openai.CompletionNewParams) {
+	if options == nil {
+		return
+	}
+	if options.MaxOutputTokens != nil {
+		params.MaxTokens = openai.Int(*options.MaxOutputTokens)
+	}
+	if len(options.Stop) > 0 {
+		params.Stop = openai.CompletionNewParamsStopUnion{OfStringArray: options.Stop}
+	}
+}
+
+func convertTextCompletion(completion *openai.Completion) *openai.ChatCompletion {
+	response := &openai.ChatCompletion{
+		ID:      completion.ID,
+		Object:  "chat.completion",
+		Created: completion.Created,
+		Model:   completion.Model,
+		Usage:   completion.Usage,
+	}
+	for _, choice := range completion.Choices {
+		response.Choices = append(response.Choices, openai.ChatCompletionChoice{
+			Index:        choice.Index,
+			FinishReason: string(choice.FinishReason),
+			Message: openai.ChatCompletionMessage{
+				Role:    constant.Assistant("assistant"),
+				Content: choice.Text,
+			},
+		})
+	}
+	return response
+}
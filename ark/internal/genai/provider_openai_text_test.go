@@ -0,0 +1,60 @@
+package genai
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/openai/openai-go"
+)
+
+func TestRenderPromptDefaultTemplate(t *testing.T) {
+	messages := []Message{
+		NewSystemMessage("You are helpful."),
+		NewUserMessage("Hello"),
+	}
+
+	prompt, err := renderPrompt(messages, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(prompt, "system: You are helpful.") {
+		t.Errorf("expected system line in prompt, got %q", prompt)
+	}
+	if !strings.Contains(prompt, "user: Hello") {
+		t.Errorf("expected user line in prompt, got %q", prompt)
+	}
+	if !strings.HasSuffix(prompt, "assistant:") {
+		t.Errorf("expected assistant cue at end of prompt, got %q", prompt)
+	}
+}
+
+func TestRenderPromptCustomTemplate(t *testing.T) {
+	messages := []Message{NewUserMessage("Hi")}
+
+	prompt, err := renderPrompt(messages, `{{range .Messages}}<|{{.Role}}|>{{.Content}}{{end}}<|assistant|>`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if prompt != "<|user|>Hi<|assistant|>" {
+		t.Errorf("unexpected rendered prompt: %q", prompt)
+	}
+}
+
+func TestRenderPromptInvalidTemplate(t *testing.T) {
+	if _, err := renderPrompt(nil, "{{range"); err == nil {
+		t.Error("expected error for invalid template")
+	}
+}
+
+func TestTextCompletionRejectsTools(t *testing.T) {
+	provider := &OpenAIProvider{Model: "base-model", CompletionMode: CompletionModeText}
+	tools := []openai.ChatCompletionToolParam{{}}
+
+	_, err := provider.textCompletion(context.Background(), nil, 1, tools)
+	if err == nil || !strings.Contains(err.Error(), "does not support tools") {
+		t.Errorf("expected tools rejection, got %v", err)
+	}
+}
@@ -3,6 +3,10 @@
 package genai
 
 import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
 	"mckinsey.com/ark/internal/annotations"
 )
@@ -11,3 +15,21 @@ import (
 func IsStreamingEnabled(query arkv1alpha1.Query) bool {
 	return query.GetAnnotations() != nil && query.GetAnnotations()[annotations.StreamingEnabled] == TrueString
 }
+
+// ParseQueryMessages converts spec.messages into chat messages, validating
+// that each entry is a well-formed OpenAI message with a role
+func ParseQueryMessages(raw []runtime.RawExtension) ([]Message, error) {
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("messages must not be empty")
+	}
+
+	messages := make([]Message, 0, len(raw))
+	for i, record := range raw {
+		message, err := unmarshalMessageRobust(record.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message at index %d: %w", i, err)
+		}
+		messages = append(messages, Message(message))
+	}
+	return messages, nil
+}
@@ -0,0 +1,140 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"mckinsey.com/ark/internal/annotations"
+)
+
+type queryOverridesKey struct{}
+
+// QueryOverrides carries per-query parameter overrides parsed from
+// ark.mckinsey.com/override.* annotations, letting developers iterate on
+// agent parameters per query without editing the Agent
+type QueryOverrides struct {
+	Temperature        *float64
+	TopP               *float64
+	SystemPromptSuffix string
+
+	// Applied echoes every parsed override for the query status
+	Applied map[string]string
+}
+
+// ParseQueryOverrides reads override annotations from a query, returning nil
+// when none are set and an error for unknown keys or out-of-range values
+func ParseQueryOverrides(queryAnnotations map[string]string) (*QueryOverrides, error) {
+	var overrides *QueryOverrides
+	for key, value := range queryAnnotations {
+		name, ok := strings.CutPrefix(key, annotations.OverridePrefix)
+		if !ok {
+			continue
+		}
+		if overrides == nil {
+			overrides = &QueryOverrides{Applied: make(map[string]string)}
+		}
+		if err := overrides.set(name, value); err != nil {
+			return nil, fmt.Errorf("invalid override annotation '%s': %w", key, err)
+		}
+		overrides.Applied[name] = value
+	}
+	return overrides, nil
+}
+
+func (o *QueryOverrides) set(name, value string) error {
+	switch name {
+	case "temperature":
+		return parseBoundedFloat(value, 0, 2, &o.Temperature)
+	case "topP":
+		return parseBoundedFloat(value, 0, 1, &o.TopP)
+	case "systemPromptSuffix":
+		if value == "" {
+			return fmt.Errorf("must not be empty")
+		}
+		o.SystemPromptSuffix = value
+		return nil
+	default:
+		return fmt.Errorf("unsupported override")
+	}
+}
+
+func parseBoundedFloat(value string, minimum, maximum float64, target **float64) error {
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("must be a number")
+	}
+	if parsed < minimum || parsed > maximum {
+		return fmt.Errorf("must be between %g and %g", minimum, maximum)
+	}
+	*target = &parsed
+	return nil
+}
+
+// WithQueryOverrides installs the overrides on the context for one query
+// execution
+func WithQueryOverrides(ctx context.Context, overrides *QueryOverrides) context.Context {
+	if overrides == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, queryOverridesKey{}, overrides)
+}
+
+func QueryOverridesFromContext(ctx context.Context) *QueryOverrides {
+	if overrides, ok := ctx.Value(queryOverridesKey{}).(*QueryOverrides); ok {
+		return overrides
+	}
+	return nil
+}
+
+// ApplyToModel writes sampling overrides into the model's properties, which
+// providers read ahead of their defaults. The provider holds its own copy of
+// the properties, so the merged map is pushed onto both
+func (o *QueryOverrides) ApplyToModel(model *Model) {
+	if o == nil || model == nil {
+		return
+	}
+	if o.Temperature == nil && o.TopP == nil {
+		return
+	}
+	properties := make(map[string]string, len(model.Properties)+2)
+	for key, value := range model.Properties {
+		properties[key] = value
+	}
+	if o.Temperature != nil {
+		properties["temperature"] = strconv.FormatFloat(*o.Temperature, 'f', -1, 64)
+	}
+	if o.TopP != nil {
+		properties["top_p"] = strconv.FormatFloat(*o.TopP, 'f', -1, 64)
+	}
+	model.Properties = properties
+
+	switch provider := model.Provider.(type) {
+	case *OllamaProvider:
+		provider.Properties = properties
+	case *OpenAIProvider:
+		provider.Properties = properties
+	case *AzureProvider:
+		provider.Properties = properties
+	case *BedrockModel:
+		provider.Properties = properties
+	case *AnthropicProvider:
+		provider.Properties = properties
+	}
+}
+
+// applySystemPromptOverride appends the per-query suffix to a resolved agent
+// prompt
+func applySystemPromptOverride(ctx context.Context, prompt string) string {
+	overrides := QueryOverridesFromContext(ctx)
+	if overrides == nil || overrides.SystemPromptSuffix == "" {
+		return prompt
+	}
+	if prompt == "" {
+		return overrides.SystemPromptSuffix
+	}
+	return prompt + "\n\n" + overrides.SystemPromptSuffix
+}
@@ -0,0 +1,93 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParseQueryOverrides(t *testing.T) {
+	overrides, err := ParseQueryOverrides(map[string]string{
+		"ark.mckinsey.com/override.temperature":        "0.2",
+		"ark.mckinsey.com/override.systemPromptSuffix": "Answer in French.",
+		"ark.mckinsey.com/dashboard-icon":              "robot",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if overrides.Temperature == nil || *overrides.Temperature != 0.2 {
+		t.Fatalf("unexpected temperature: %+v", overrides.Temperature)
+	}
+	if overrides.SystemPromptSuffix != "Answer in French." {
+		t.Fatalf("unexpected suffix: %q", overrides.SystemPromptSuffix)
+	}
+	if len(overrides.Applied) != 2 {
+		t.Fatalf("expected two applied overrides, got %v", overrides.Applied)
+	}
+}
+
+func TestParseQueryOverridesNoneSet(t *testing.T) {
+	overrides, err := ParseQueryOverrides(map[string]string{"ark.mckinsey.com/service": "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if overrides != nil {
+		t.Fatalf("expected nil overrides, got %+v", overrides)
+	}
+}
+
+func TestParseQueryOverridesRejectsInvalidValues(t *testing.T) {
+	cases := map[string]map[string]string{
+		"out of range": {"ark.mckinsey.com/override.temperature": "3"},
+		"not a number": {"ark.mckinsey.com/override.topP": "high"},
+		"unknown key":  {"ark.mckinsey.com/override.presencePenalty": "1"},
+		"empty suffix": {"ark.mckinsey.com/override.systemPromptSuffix": ""},
+	}
+	for name, queryAnnotations := range cases {
+		if _, err := ParseQueryOverrides(queryAnnotations); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestApplyToModelMergesProperties(t *testing.T) {
+	overrides, err := ParseQueryOverrides(map[string]string{
+		"ark.mckinsey.com/override.temperature": "0.5",
+		"ark.mckinsey.com/override.topP":        "0.9",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider := &OpenAIProvider{Properties: map[string]string{"temperature": "1", "seed": "42"}}
+	model := &Model{Provider: provider, Properties: provider.Properties}
+	overrides.ApplyToModel(model)
+
+	if model.Properties["temperature"] != "0.5" || model.Properties["top_p"] != "0.9" {
+		t.Fatalf("unexpected model properties: %v", model.Properties)
+	}
+	if model.Properties["seed"] != "42" {
+		t.Fatal("expected existing properties preserved")
+	}
+	if provider.Properties["temperature"] != "0.5" {
+		t.Fatalf("expected provider properties updated: %v", provider.Properties)
+	}
+}
+
+func TestApplySystemPromptOverride(t *testing.T) {
+	overrides, err := ParseQueryOverrides(map[string]string{
+		"ark.mckinsey.com/override.systemPromptSuffix": "Be brief.",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := WithQueryOverrides(context.Background(), overrides)
+
+	if got := applySystemPromptOverride(ctx, "You are a helpful assistant."); got != "You are a helpful assistant.\n\nBe brief." {
+		t.Fatalf("unexpected prompt: %q", got)
+	}
+	if got := applySystemPromptOverride(context.Background(), "base"); got != "base" {
+		t.Fatalf("expected prompt unchanged without overrides, got %q", got)
+	}
+}
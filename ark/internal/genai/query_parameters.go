@@ -85,6 +85,7 @@ func resolveQueryValueFrom(ctx context.Context, k8sClient client.Client, namespa
 		if !exists {
 			return "", fmt.Errorf("key %s not found in Secret %s", valueFrom.SecretKeyRef.Key, valueFrom.SecretKeyRef.Name)
 		}
+		TrackSecretValue(ctx, string(value))
 		return string(value), nil
 	}
 
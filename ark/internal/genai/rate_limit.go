@@ -0,0 +1,142 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+type throttleNotifierKey struct{}
+
+// ThrottleNotifier is called when a provider call is delayed by a model rate
+// limit, so the controller can surface the wait on the query status
+type ThrottleNotifier func(model string, wait time.Duration)
+
+// WithThrottleNotifier installs the notifier on the context for one query
+// execution
+func WithThrottleNotifier(ctx context.Context, notify ThrottleNotifier) context.Context {
+	return context.WithValue(ctx, throttleNotifierKey{}, notify)
+}
+
+func throttleNotifierFromContext(ctx context.Context) ThrottleNotifier {
+	if notify, ok := ctx.Value(throttleNotifierKey{}).(ThrottleNotifier); ok {
+		return notify
+	}
+	return nil
+}
+
+// tokenBucket refills at ratePerMinute up to one minute's burst. Callers
+// debit up front and wait out any deficit, so short bursts pass immediately
+// while sustained load converges on the configured rate
+type tokenBucket struct {
+	ratePerMinute float64
+	capacity      float64
+	available     float64
+	last          time.Time
+}
+
+func newTokenBucket(perMinute int64) *tokenBucket {
+	rate := float64(perMinute)
+	return &tokenBucket{ratePerMinute: rate, capacity: rate, available: rate, last: time.Now()}
+}
+
+// reserve debits cost and returns how long the caller must wait for the
+// refill to cover the deficit
+func (b *tokenBucket) reserve(cost float64, now time.Time) time.Duration {
+	b.available += b.ratePerMinute * now.Sub(b.last).Minutes()
+	if b.available > b.capacity {
+		b.available = b.capacity
+	}
+	b.last = now
+	b.available -= cost
+	if b.available >= 0 {
+		return 0
+	}
+	return time.Duration(-b.available / b.ratePerMinute * float64(time.Minute))
+}
+
+// ModelRateLimiter throttles calls for one model by requests and estimated
+// prompt tokens per minute
+type ModelRateLimiter struct {
+	mu       sync.Mutex
+	requests *tokenBucket
+	tokens   *tokenBucket
+}
+
+var (
+	rateLimiterMu sync.Mutex
+	rateLimiters  = make(map[string]*ModelRateLimiter)
+)
+
+// rateLimiterFor returns the process-wide limiter for the given model,
+// creating it on first use and refreshing its rates from the spec
+func rateLimiterFor(modelKey string, spec *arkv1alpha1.ModelRateLimits) *ModelRateLimiter {
+	if spec == nil {
+		return nil
+	}
+
+	rateLimiterMu.Lock()
+	limiter, exists := rateLimiters[modelKey]
+	if !exists {
+		limiter = &ModelRateLimiter{}
+		rateLimiters[modelKey] = limiter
+	}
+	rateLimiterMu.Unlock()
+
+	limiter.configure(spec)
+	return limiter
+}
+
+func (l *ModelRateLimiter) configure(spec *arkv1alpha1.ModelRateLimits) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.requests = refreshBucket(l.requests, spec.RequestsPerMinute)
+	l.tokens = refreshBucket(l.tokens, spec.TokensPerMinute)
+}
+
+// refreshBucket keeps the existing bucket state across reloads unless the
+// rate changed, so re-resolving the model does not reset accrued debt
+func refreshBucket(bucket *tokenBucket, perMinute *int64) *tokenBucket {
+	if perMinute == nil {
+		return nil
+	}
+	if bucket != nil && bucket.ratePerMinute == float64(*perMinute) {
+		return bucket
+	}
+	return newTokenBucket(*perMinute)
+}
+
+// Wait blocks until the model's rate limits admit a call with the given
+// estimated prompt tokens, notifying the context's throttle observer when a
+// delay is imposed
+func (l *ModelRateLimiter) Wait(ctx context.Context, model string, estimatedTokens int64) error {
+	l.mu.Lock()
+	now := time.Now()
+	var wait time.Duration
+	if l.requests != nil {
+		wait = l.requests.reserve(1, now)
+	}
+	if l.tokens != nil && estimatedTokens > 0 {
+		if tokenWait := l.tokens.reserve(float64(estimatedTokens), now); tokenWait > wait {
+			wait = tokenWait
+		}
+	}
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	if notify := throttleNotifierFromContext(ctx); notify != nil {
+		notify(model, wait)
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(wait):
+		return nil
+	}
+}
@@ -0,0 +1,94 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func TestTokenBucketAllowsBurstThenWaits(t *testing.T) {
+	bucket := newTokenBucket(60)
+	now := time.Now()
+
+	for i := range 60 {
+		if wait := bucket.reserve(1, now); wait != 0 {
+			t.Fatalf("expected call %d within burst to pass immediately, got wait %s", i, wait)
+		}
+	}
+
+	wait := bucket.reserve(1, now)
+	if wait <= 0 {
+		t.Fatal("expected a wait once the burst is exhausted")
+	}
+	if wait > time.Second+time.Millisecond {
+		t.Fatalf("expected roughly one second wait at 60/minute, got %s", wait)
+	}
+}
+
+func TestTokenBucketRefillsOverTime(t *testing.T) {
+	bucket := newTokenBucket(60)
+	now := time.Now()
+
+	bucket.reserve(60, now)
+	if wait := bucket.reserve(1, now.Add(2*time.Second)); wait != 0 {
+		t.Fatalf("expected refill to cover the call, got wait %s", wait)
+	}
+}
+
+func TestRateLimiterWaitNotifiesThrottleObserver(t *testing.T) {
+	limiter := rateLimiterFor("default/throttled-model", &arkv1alpha1.ModelRateLimits{
+		RequestsPerMinute: int64Ptr(1),
+	})
+
+	var notifiedModel string
+	var notifiedWait time.Duration
+	ctx := WithThrottleNotifier(context.Background(), func(model string, wait time.Duration) {
+		notifiedModel = model
+		notifiedWait = wait
+	})
+
+	if err := limiter.Wait(ctx, "throttled-model", 0); err != nil {
+		t.Fatal(err)
+	}
+	if notifiedModel != "" {
+		t.Fatal("expected no notification for the burst call")
+	}
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := limiter.Wait(cancelCtx, "throttled-model", 0); err == nil {
+		t.Fatal("expected canceled context to abort the wait")
+	}
+	if notifiedModel != "throttled-model" || notifiedWait <= 0 {
+		t.Fatalf("expected throttle notification before waiting, got model %q wait %s", notifiedModel, notifiedWait)
+	}
+}
+
+func TestRateLimiterForRefreshesWithoutResettingState(t *testing.T) {
+	spec := &arkv1alpha1.ModelRateLimits{RequestsPerMinute: int64Ptr(60)}
+	limiter := rateLimiterFor("default/refresh-model", spec)
+	limiter.requests.reserve(60, time.Now())
+	spent := limiter.requests.available
+
+	if again := rateLimiterFor("default/refresh-model", spec); again != limiter {
+		t.Fatal("expected the same limiter for the same model")
+	}
+	if limiter.requests.available != spent {
+		t.Fatal("expected bucket state preserved when the rate is unchanged")
+	}
+
+	rateLimiterFor("default/refresh-model", &arkv1alpha1.ModelRateLimits{RequestsPerMinute: int64Ptr(120)})
+	if limiter.requests.ratePerMinute != 120 {
+		t.Fatal("expected bucket replaced when the rate changed")
+	}
+}
+
+func TestRateLimiterForNilSpec(t *testing.T) {
+	if limiter := rateLimiterFor("default/unlimited-model", nil); limiter != nil {
+		t.Fatal("expected no limiter for a model without rate limits")
+	}
+}
@@ -32,6 +32,27 @@ func NewModelRecorder(model *arkv1alpha1.Model, recorder record.EventRecorder) *
 	}
 }
 
+func NewConversationSnapshotRecorder(snapshot *arkv1alpha1.ConversationSnapshot, recorder record.EventRecorder) *Recorder[*arkv1alpha1.ConversationSnapshot] {
+	return &Recorder[*arkv1alpha1.ConversationSnapshot]{
+		resource: snapshot,
+		recorder: recorder,
+	}
+}
+
+func NewMemoryRecorder(memory *arkv1alpha1.Memory, recorder record.EventRecorder) *Recorder[*arkv1alpha1.Memory] {
+	return &Recorder[*arkv1alpha1.Memory]{
+		resource: memory,
+		recorder: recorder,
+	}
+}
+
+func NewScenarioTestRecorder(test *arkv1alpha1.ScenarioTest, recorder record.EventRecorder) *Recorder[*arkv1alpha1.ScenarioTest] {
+	return &Recorder[*arkv1alpha1.ScenarioTest]{
+		resource: test,
+		recorder: recorder,
+	}
+}
+
 func (r *Recorder[T]) EmitEvent(ctx context.Context, eventType, reason string, data EventData) {
 	log := logf.FromContext(ctx).WithValues("reason", reason)
 
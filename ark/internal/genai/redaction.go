@@ -0,0 +1,123 @@
+package genai
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// RedactedPlaceholder replaces secret-sourced values in observability and
+// persistence outputs
+const RedactedPlaceholder = "[REDACTED]"
+
+// minRedactableLength avoids redacting values so short that replacement would
+// mangle unrelated text
+const minRedactableLength = 4
+
+// SecretRedactor collects values resolved from Secrets during template
+// rendering, so they can be scrubbed from events, spans and memory while the
+// real values still reach providers and tools.
+type SecretRedactor struct {
+	mu     sync.RWMutex
+	values []string
+}
+
+const secretRedactorKey contextKey = "secretRedactor"
+
+// WithSecretRedactor installs a secret redactor on the context, returning the
+// redactor so callers can scrub outputs after execution
+func WithSecretRedactor(ctx context.Context) (context.Context, *SecretRedactor) {
+	redactor := &SecretRedactor{}
+	return context.WithValue(ctx, secretRedactorKey, redactor), redactor
+}
+
+// SecretRedactorFromContext returns the redactor installed on the context, or
+// nil when secret tracking is not active
+func SecretRedactorFromContext(ctx context.Context) *SecretRedactor {
+	if val := ctx.Value(secretRedactorKey); val != nil {
+		if redactor, ok := val.(*SecretRedactor); ok {
+			return redactor
+		}
+	}
+	return nil
+}
+
+// TrackSecretValue records a secret-sourced value on the context's redactor,
+// if one is installed
+func TrackSecretValue(ctx context.Context, value string) {
+	if redactor := SecretRedactorFromContext(ctx); redactor != nil {
+		redactor.Track(value)
+	}
+}
+
+// RedactSecrets scrubs tracked secret values from the given string. Returns
+// the string unchanged when no redactor is installed
+func RedactSecrets(ctx context.Context, s string) string {
+	if redactor := SecretRedactorFromContext(ctx); redactor != nil {
+		return redactor.Redact(s)
+	}
+	return s
+}
+
+func (r *SecretRedactor) Track(value string) {
+	if len(value) < minRedactableLength {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.values {
+		if existing == value {
+			return
+		}
+	}
+	r.values = append(r.values, value)
+}
+
+func (r *SecretRedactor) Redact(s string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, value := range r.values {
+		s = strings.ReplaceAll(s, value, RedactedPlaceholder)
+	}
+	return s
+}
+
+// redactMessages returns a copy of the messages with tracked secret values
+// scrubbed from string content, for observability and persistence outputs
+func redactMessages(ctx context.Context, messages []Message) []Message {
+	redactor := SecretRedactorFromContext(ctx)
+	if redactor == nil {
+		return messages
+	}
+
+	redacted := make([]Message, len(messages))
+	for i, message := range messages {
+		redacted[i] = redactMessage(redactor, message)
+	}
+	return redacted
+}
+
+func redactMessage(redactor *SecretRedactor, message Message) Message {
+	switch {
+	case message.OfUser != nil && message.OfUser.Content.OfString.Valid():
+		copied := *message.OfUser
+		copied.Content.OfString.Value = redactor.Redact(copied.Content.OfString.Value)
+		message.OfUser = &copied
+	case message.OfSystem != nil && message.OfSystem.Content.OfString.Valid():
+		copied := *message.OfSystem
+		copied.Content.OfString.Value = redactor.Redact(copied.Content.OfString.Value)
+		message.OfSystem = &copied
+	case message.OfAssistant != nil && message.OfAssistant.Content.OfString.Valid():
+		copied := *message.OfAssistant
+		copied.Content.OfString.Value = redactor.Redact(copied.Content.OfString.Value)
+		message.OfAssistant = &copied
+	case message.OfTool != nil && message.OfTool.Content.OfString.Valid():
+		copied := *message.OfTool
+		copied.Content.OfString.Value = redactor.Redact(copied.Content.OfString.Value)
+		message.OfTool = &copied
+	}
+	return message
+}
@@ -0,0 +1,65 @@
+package genai
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	ctx, redactor := WithSecretRedactor(context.Background())
+	redactor.Track("s3cret-value")
+
+	result := RedactSecrets(ctx, "the key is s3cret-value, keep it safe")
+	if strings.Contains(result, "s3cret-value") {
+		t.Errorf("expected secret to be redacted, got %q", result)
+	}
+	if !strings.Contains(result, RedactedPlaceholder) {
+		t.Errorf("expected placeholder in %q", result)
+	}
+}
+
+func TestRedactSecretsIgnoresShortValues(t *testing.T) {
+	ctx, redactor := WithSecretRedactor(context.Background())
+	redactor.Track("ab")
+
+	result := RedactSecrets(ctx, "abandon")
+	if result != "abandon" {
+		t.Errorf("short values must not be redacted, got %q", result)
+	}
+}
+
+func TestRedactSecretsWithoutRedactor(t *testing.T) {
+	result := RedactSecrets(context.Background(), "unchanged")
+	if result != "unchanged" {
+		t.Errorf("expected input unchanged, got %q", result)
+	}
+}
+
+func TestRedactMessages(t *testing.T) {
+	ctx, redactor := WithSecretRedactor(context.Background())
+	redactor.Track("s3cret-value")
+
+	messages := []Message{
+		NewUserMessage("token is s3cret-value"),
+		NewAssistantMessage("using s3cret-value now"),
+	}
+	redacted := redactMessages(ctx, messages)
+
+	for i, message := range redacted {
+		var content string
+		switch {
+		case message.OfUser != nil:
+			content = message.OfUser.Content.OfString.Value
+		case message.OfAssistant != nil:
+			content = message.OfAssistant.Content.OfString.Value
+		}
+		if strings.Contains(content, "s3cret-value") {
+			t.Errorf("message %d not redacted: %q", i, content)
+		}
+	}
+
+	if messages[0].OfUser.Content.OfString.Value != "token is s3cret-value" {
+		t.Error("original messages must not be modified")
+	}
+}
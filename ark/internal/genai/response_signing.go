@@ -0,0 +1,128 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/common"
+)
+
+const responseSignatureAlgorithm = "ed25519"
+
+// signedResponse is the per-response portion of the signature payload
+type signedResponse struct {
+	TargetType string `json:"targetType"`
+	TargetName string `json:"targetName"`
+	Content    string `json:"content"`
+}
+
+// signaturePayload is the canonical document covered by a response
+// signature. Field order is fixed by the struct so verifiers can rebuild
+// the exact bytes from the query status
+type signaturePayload struct {
+	Query      string                 `json:"query"`
+	Namespace  string                 `json:"namespace"`
+	Generation int64                  `json:"generation"`
+	Responses  []signedResponse       `json:"responses"`
+	TokenUsage arkv1alpha1.TokenUsage `json:"tokenUsage"`
+}
+
+// SignQueryResponses signs the query's responses with the key configured in
+// the namespace ArkConfig. Returns nil when signing is not configured
+func SignQueryResponses(ctx context.Context, k8sClient client.Client, query *arkv1alpha1.Query) (*arkv1alpha1.ResponseSignature, error) {
+	var config arkv1alpha1.ArkConfig
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: "default", Namespace: query.Namespace}, &config); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	if config.Spec.ResponseSigning == nil {
+		return nil, nil
+	}
+
+	resolver := common.NewValueSourceResolver(k8sClient)
+	keyPEM, err := resolver.ResolveValueSource(ctx, config.Spec.ResponseSigning.PrivateKey, query.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve response signing key: %w", err)
+	}
+
+	key, err := parseSigningKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(buildSignaturePayload(query))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal signature payload: %w", err)
+	}
+
+	return &arkv1alpha1.ResponseSignature{
+		Algorithm: responseSignatureAlgorithm,
+		KeyID:     config.Spec.ResponseSigning.KeyID,
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(key, payload)),
+		SignedAt:  metav1.Now(),
+	}, nil
+}
+
+// VerifyQueryResponses checks a query's signature against a public key,
+// rebuilding the canonical payload from the query status
+func VerifyQueryResponses(query *arkv1alpha1.Query, publicKey ed25519.PublicKey) error {
+	if query.Status.Signature == nil {
+		return fmt.Errorf("query %s has no response signature", query.Name)
+	}
+	signature, err := base64.StdEncoding.DecodeString(query.Status.Signature.Signature)
+	if err != nil {
+		return fmt.Errorf("failed to decode response signature: %w", err)
+	}
+	payload, err := json.Marshal(buildSignaturePayload(query))
+	if err != nil {
+		return fmt.Errorf("failed to marshal signature payload: %w", err)
+	}
+	if !ed25519.Verify(publicKey, payload, signature) {
+		return fmt.Errorf("response signature verification failed for query %s", query.Name)
+	}
+	return nil
+}
+
+func buildSignaturePayload(query *arkv1alpha1.Query) signaturePayload {
+	responses := make([]signedResponse, len(query.Status.Responses))
+	for i, response := range query.Status.Responses {
+		responses[i] = signedResponse{
+			TargetType: response.Target.Type,
+			TargetName: response.Target.Name,
+			Content:    response.Content,
+		}
+	}
+	return signaturePayload{
+		Query:      query.Name,
+		Namespace:  query.Namespace,
+		Generation: query.Generation,
+		Responses:  responses,
+		TokenUsage: query.Status.TokenUsage,
+	}
+}
+
+func parseSigningKey(keyPEM string) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("response signing key is not PEM encoded")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse response signing key: %w", err)
+	}
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("response signing key must be ed25519, got %T", parsed)
+	}
+	return key, nil
+}
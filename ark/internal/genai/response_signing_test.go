@@ -0,0 +1,100 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func signingTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := arkv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+func generateSigningKeyPEM(t *testing.T) (string, ed25519.PublicKey) {
+	t.Helper()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	return string(keyPEM), publicKey
+}
+
+func TestSignQueryResponsesSkipsWhenNotConfigured(t *testing.T) {
+	k8sClient := fake.NewClientBuilder().WithScheme(signingTestScheme(t)).Build()
+	query := &arkv1alpha1.Query{ObjectMeta: metav1.ObjectMeta{Name: "q", Namespace: "default"}}
+
+	signature, err := SignQueryResponses(context.Background(), k8sClient, query)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if signature != nil {
+		t.Fatal("expected no signature without responseSigning config")
+	}
+}
+
+func TestSignQueryResponsesRoundTrip(t *testing.T) {
+	keyPEM, publicKey := generateSigningKeyPEM(t)
+	config := &arkv1alpha1.ArkConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "default"},
+		Spec: arkv1alpha1.ArkConfigSpec{
+			ResponseSigning: &arkv1alpha1.ResponseSigningConfig{
+				PrivateKey: arkv1alpha1.ValueSource{Value: keyPEM},
+				KeyID:      "signing-key-1",
+			},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(signingTestScheme(t)).WithObjects(config).Build()
+
+	query := &arkv1alpha1.Query{
+		ObjectMeta: metav1.ObjectMeta{Name: "q", Namespace: "default", Generation: 2},
+		Status: arkv1alpha1.QueryStatus{
+			Responses: []arkv1alpha1.Response{
+				{Target: arkv1alpha1.QueryTarget{Type: "agent", Name: "researcher"}, Content: "the answer"},
+			},
+			TokenUsage: arkv1alpha1.TokenUsage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+		},
+	}
+
+	signature, err := SignQueryResponses(context.Background(), k8sClient, query)
+	if err != nil {
+		t.Fatalf("expected signing to succeed, got %v", err)
+	}
+	if signature.Algorithm != "ed25519" || signature.KeyID != "signing-key-1" {
+		t.Fatalf("unexpected signature metadata: %+v", signature)
+	}
+
+	query.Status.Signature = signature
+	if err := VerifyQueryResponses(query, publicKey); err != nil {
+		t.Fatalf("expected verification to succeed, got %v", err)
+	}
+
+	query.Status.Responses[0].Content = "tampered"
+	if err := VerifyQueryResponses(query, publicKey); err == nil {
+		t.Fatal("expected verification to fail after tampering")
+	}
+}
@@ -0,0 +1,53 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"sync"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+type routingRecorderKey struct{}
+
+// RoutingRecorder collects the routing decisions made while resolving a
+// query's router targets, so they can be surfaced on the query status
+type RoutingRecorder struct {
+	mu        sync.Mutex
+	decisions []arkv1alpha1.RoutingDecision
+}
+
+// WithRoutingRecorder installs a routing recorder on the context for one
+// query execution
+func WithRoutingRecorder(ctx context.Context) (context.Context, *RoutingRecorder) {
+	recorder := &RoutingRecorder{}
+	return context.WithValue(ctx, routingRecorderKey{}, recorder), recorder
+}
+
+// RoutingRecorderFromContext returns the active recorder, or nil when none
+// is installed
+func RoutingRecorderFromContext(ctx context.Context) *RoutingRecorder {
+	if recorder, ok := ctx.Value(routingRecorderKey{}).(*RoutingRecorder); ok {
+		return recorder
+	}
+	return nil
+}
+
+func (r *RoutingRecorder) Record(decision arkv1alpha1.RoutingDecision) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.decisions = append(r.decisions, decision)
+}
+
+func (r *RoutingRecorder) Decisions() []arkv1alpha1.RoutingDecision {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]arkv1alpha1.RoutingDecision{}, r.decisions...)
+}
@@ -0,0 +1,108 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"strconv"
+	"sync"
+)
+
+// maxSchemaCacheEntries bounds the cache; when reached the cache is dropped
+// wholesale, which is cheaper than tracking recency for what is a small,
+// stable working set of tool schemas
+const maxSchemaCacheEntries = 1024
+
+type toolSchemaTokensKey struct{}
+
+// schemaCacheEntry holds one parsed tool schema and its estimated token cost
+type schemaCacheEntry struct {
+	parameters map[string]any
+	tokens     int64
+}
+
+var (
+	schemaCacheMu sync.Mutex
+	schemaCache   = make(map[string]schemaCacheEntry)
+)
+
+func schemaCacheKey(raw []byte) string {
+	hash := fnv.New64a()
+	_, _ = hash.Write(raw)
+	return strconv.FormatUint(hash.Sum64(), 16)
+}
+
+// parseToolSchema parses a raw input schema, serving repeated schemas from a
+// content-hash cache so high-QPS agents with large tool sets do not re-parse
+// them per execution. Callers mutate the returned map during registration, so
+// hits return a deep copy of the cached value
+func parseToolSchema(raw []byte) (map[string]any, int64, error) {
+	key := schemaCacheKey(raw)
+
+	schemaCacheMu.Lock()
+	entry, hit := schemaCache[key]
+	schemaCacheMu.Unlock()
+	if hit {
+		return copySchemaMap(entry.parameters), entry.tokens, nil
+	}
+
+	parameters := map[string]any{
+		"type":       "object",
+		"properties": map[string]any{},
+	}
+	if err := json.Unmarshal(raw, &parameters); err != nil {
+		return nil, 0, err
+	}
+	entry = schemaCacheEntry{parameters: parameters, tokens: int64(len(raw)) / charsPerToken}
+
+	schemaCacheMu.Lock()
+	if len(schemaCache) >= maxSchemaCacheEntries {
+		schemaCache = make(map[string]schemaCacheEntry)
+	}
+	schemaCache[key] = entry
+	schemaCacheMu.Unlock()
+
+	return copySchemaMap(parameters), entry.tokens, nil
+}
+
+func copySchemaMap(source map[string]any) map[string]any {
+	copied := make(map[string]any, len(source))
+	for key, value := range source {
+		copied[key] = copySchemaValue(value)
+	}
+	return copied
+}
+
+func copySchemaValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		return copySchemaMap(typed)
+	case []any:
+		copied := make([]any, len(typed))
+		for i, entry := range typed {
+			copied[i] = copySchemaValue(entry)
+		}
+		return copied
+	default:
+		return value
+	}
+}
+
+// WithToolSchemaTokens records the estimated token cost of the tool schemas
+// sent with each call, so rate limiting can account for them alongside the
+// messages
+func WithToolSchemaTokens(ctx context.Context, tokens int64) context.Context {
+	if tokens <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, toolSchemaTokensKey{}, tokens)
+}
+
+func ToolSchemaTokensFromContext(ctx context.Context) int64 {
+	if tokens, ok := ctx.Value(toolSchemaTokensKey{}).(int64); ok {
+		return tokens
+	}
+	return 0
+}
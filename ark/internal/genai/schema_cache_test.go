@@ -0,0 +1,75 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParseToolSchemaCachesRepeatedSchemas(t *testing.T) {
+	raw := []byte(`{"type":"object","properties":{"city":{"type":"string"}},"required":["city"]}`)
+
+	first, tokens, err := parseToolSchema(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokens != int64(len(raw))/charsPerToken {
+		t.Fatalf("unexpected token estimate: %d", tokens)
+	}
+
+	second, _, err := parseToolSchema(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	properties, ok := second["properties"].(map[string]any)
+	if !ok {
+		t.Fatalf("unexpected parsed schema: %v", second)
+	}
+	delete(properties, "city")
+
+	firstProperties := first["properties"].(map[string]any)
+	if _, exists := firstProperties["city"]; !exists {
+		t.Fatal("expected cache hits to return independent copies")
+	}
+	third, _, err := parseToolSchema(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, exists := third["properties"].(map[string]any)["city"]; !exists {
+		t.Fatal("expected cached schema unaffected by caller mutation")
+	}
+}
+
+func TestParseToolSchemaDefaults(t *testing.T) {
+	parsed, _, err := parseToolSchema([]byte(`{"required":[]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed["type"] != "object" {
+		t.Fatalf("expected object type default, got %v", parsed["type"])
+	}
+	if _, ok := parsed["properties"].(map[string]any); !ok {
+		t.Fatalf("expected properties default, got %v", parsed["properties"])
+	}
+}
+
+func TestParseToolSchemaInvalidJSON(t *testing.T) {
+	if _, _, err := parseToolSchema([]byte(`{`)); err == nil {
+		t.Fatal("expected error for invalid schema")
+	}
+}
+
+func TestToolSchemaTokensContext(t *testing.T) {
+	ctx := WithToolSchemaTokens(context.Background(), 120)
+	if got := ToolSchemaTokensFromContext(ctx); got != 120 {
+		t.Fatalf("expected 120 tokens, got %d", got)
+	}
+	if got := ToolSchemaTokensFromContext(context.Background()); got != 0 {
+		t.Fatalf("expected zero without context value, got %d", got)
+	}
+	if ctx := WithToolSchemaTokens(context.Background(), 0); ToolSchemaTokensFromContext(ctx) != 0 {
+		t.Fatal("expected zero tokens not to be recorded")
+	}
+}
@@ -0,0 +1,51 @@
+package genai
+
+import (
+	"context"
+	"sync"
+)
+
+const servedByTrackerKey contextKey = "servedByTracker"
+
+// ServedByTracker records which model actually answered a target execution,
+// so clients can tell when failover routed around the primary
+type ServedByTracker struct {
+	mu    sync.Mutex
+	model string
+}
+
+// WithServedByTracker installs a served-by tracker on the context so model
+// calls executed under it can report which backend answered
+func WithServedByTracker(ctx context.Context) (context.Context, *ServedByTracker) {
+	tracker := &ServedByTracker{}
+	return context.WithValue(ctx, servedByTrackerKey, tracker), tracker
+}
+
+// RecordServedBy records the model that served a completed call on the
+// context's tracker, if one is installed
+func RecordServedBy(ctx context.Context, model string) {
+	tracker, _ := ctx.Value(servedByTrackerKey).(*ServedByTracker)
+	if tracker == nil || model == "" {
+		return
+	}
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	tracker.model = model
+}
+
+// Model returns the last recorded serving model, or empty when no call
+// completed under this tracker
+func (t *ServedByTracker) Model() string {
+	if t == nil {
+		return ""
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.model
+}
+
+// servedByFromContext returns the recorded serving model for stream metadata
+func servedByFromContext(ctx context.Context) string {
+	tracker, _ := ctx.Value(servedByTrackerKey).(*ServedByTracker)
+	return tracker.Model()
+}
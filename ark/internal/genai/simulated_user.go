@@ -0,0 +1,50 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SimulatedUserGoalMet is the sentinel the persona model replies with when
+// the conversation has achieved the goal
+const SimulatedUserGoalMet = "GOAL_ACHIEVED"
+
+const simulatedUserSystemPrompt = "You are role-playing as a user talking " +
+	"to an AI assistant.\n\nPersona: %s\n\nGoal: %s\n\nWrite the user's next " +
+	"message only, staying in character. When the conversation so far " +
+	"already achieves the goal, reply with exactly %s."
+
+// NextSimulatedUserTurn asks the persona model for the user's next message
+// given the conversation so far, returning done when the persona reports the
+// goal achieved
+func NextSimulatedUserTurn(ctx context.Context, model *Model, persona, goal string, history []Message) (string, bool, error) {
+	messages := []Message{
+		NewSystemMessage(fmt.Sprintf(simulatedUserSystemPrompt, persona, goal, SimulatedUserGoalMet)),
+	}
+	if len(history) > 0 {
+		messages = append(messages, NewUserMessage("Conversation so far:\n\n"+renderTranscript(history)))
+	} else {
+		messages = append(messages, NewUserMessage("The conversation has not started. Write the user's opening message."))
+	}
+
+	completion, err := model.ChatCompletion(ctx, messages, nil, 1)
+	if err != nil {
+		return "", false, fmt.Errorf("persona model call failed: %w", err)
+	}
+	if completion == nil || len(completion.Choices) == 0 {
+		return "", false, fmt.Errorf("persona model returned no content")
+	}
+
+	content := strings.TrimSpace(completion.Choices[0].Message.Content)
+	if content == "" || strings.Contains(content, SimulatedUserGoalMet) {
+		return "", true, nil
+	}
+	return content, false, nil
+}
+
+// RenderTranscript returns the conversation as role-prefixed lines, used for
+// simulated-user status reporting and evaluator input
+func RenderTranscript(messages []Message) string {
+	return renderTranscript(messages)
+}
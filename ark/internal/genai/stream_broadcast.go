@@ -0,0 +1,144 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const broadcastSubscriberBuffer = 256
+
+var errSubscriberQueueFull = errors.New("subscriber queue full, chunk dropped")
+
+// BroadcastEventStream fans a query's chunk stream out to multiple
+// subscribers, so consumers such as the memory service, a UI session or a
+// logging sink can follow the same stream concurrently. Each subscriber gets
+// its own bounded queue and delivery goroutine: a slow or failing subscriber
+// drops its own chunks without blocking the producer or other subscribers
+type BroadcastEventStream struct {
+	mu          sync.Mutex
+	nextID      uint64
+	subscribers map[uint64]*broadcastSubscriber
+	closed      bool
+	wg          sync.WaitGroup
+}
+
+type broadcastSubscriber struct {
+	stream EventStreamInterface
+	items  chan broadcastItem
+}
+
+type broadcastItem struct {
+	ctx        context.Context
+	chunk      any
+	completion bool
+}
+
+func NewBroadcastEventStream() *BroadcastEventStream {
+	return &BroadcastEventStream{subscribers: map[uint64]*broadcastSubscriber{}}
+}
+
+// Subscribe attaches a consumer to the stream and returns a function that
+// detaches it. Subscribing after the stream has closed is a no-op
+func (b *BroadcastEventStream) Subscribe(stream EventStreamInterface) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return func() {}
+	}
+
+	b.nextID++
+	id := b.nextID
+	subscriber := &broadcastSubscriber{
+		stream: stream,
+		items:  make(chan broadcastItem, broadcastSubscriberBuffer),
+	}
+	b.subscribers[id] = subscriber
+
+	b.wg.Add(1)
+	go b.deliver(subscriber)
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, exists := b.subscribers[id]; exists {
+			delete(b.subscribers, id)
+			close(subscriber.items)
+		}
+	}
+}
+
+func (b *BroadcastEventStream) deliver(subscriber *broadcastSubscriber) {
+	defer b.wg.Done()
+
+	for item := range subscriber.items {
+		if item.completion {
+			if err := subscriber.stream.NotifyCompletion(item.ctx); err != nil {
+				logf.FromContext(item.ctx).Error(err, "stream subscriber failed to handle completion")
+			}
+			continue
+		}
+		if err := subscriber.stream.StreamChunk(item.ctx, item.chunk); err != nil {
+			StreamHealthFromContext(item.ctx).RecordStreamError(err)
+			logf.FromContext(item.ctx).V(1).Error(err, "stream subscriber failed to handle chunk")
+		}
+	}
+
+	if err := subscriber.stream.Close(); err != nil {
+		logf.Log.V(1).Error(err, "stream subscriber failed to close")
+	}
+}
+
+// StreamChunk enqueues the chunk for every subscriber, dropping it for
+// subscribers whose queues are full
+func (b *BroadcastEventStream) StreamChunk(ctx context.Context, chunk interface{}) error {
+	b.enqueue(broadcastItem{ctx: ctx, chunk: chunk})
+	return nil
+}
+
+// NotifyCompletion forwards the completion signal to every subscriber
+func (b *BroadcastEventStream) NotifyCompletion(ctx context.Context) error {
+	b.enqueue(broadcastItem{ctx: ctx, completion: true})
+	return nil
+}
+
+func (b *BroadcastEventStream) enqueue(item broadcastItem) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	for _, subscriber := range b.subscribers {
+		select {
+		case subscriber.items <- item:
+		default:
+			StreamHealthFromContext(item.ctx).RecordStreamError(errSubscriberQueueFull)
+			logf.FromContext(item.ctx).V(1).Info("dropping stream chunk for slow subscriber")
+		}
+	}
+}
+
+// Close detaches all subscribers and waits for their queued chunks to drain
+func (b *BroadcastEventStream) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	for id, subscriber := range b.subscribers {
+		delete(b.subscribers, id)
+		close(subscriber.items)
+	}
+	b.mu.Unlock()
+
+	b.wg.Wait()
+	return nil
+}
@@ -0,0 +1,120 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+type recordingEventStream struct {
+	mu          sync.Mutex
+	chunks      []interface{}
+	completions int
+	closed      bool
+	chunkErr    error
+}
+
+func (r *recordingEventStream) StreamChunk(ctx context.Context, chunk interface{}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.chunkErr != nil {
+		return r.chunkErr
+	}
+	r.chunks = append(r.chunks, chunk)
+	return nil
+}
+
+func (r *recordingEventStream) NotifyCompletion(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.completions++
+	return nil
+}
+
+func (r *recordingEventStream) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	return nil
+}
+
+func TestBroadcastEventStreamFansOutToAllSubscribers(t *testing.T) {
+	ctx := context.Background()
+	broadcast := NewBroadcastEventStream()
+	first := &recordingEventStream{}
+	second := &recordingEventStream{}
+	broadcast.Subscribe(first)
+	broadcast.Subscribe(second)
+
+	_ = broadcast.StreamChunk(ctx, "one")
+	_ = broadcast.StreamChunk(ctx, "two")
+	_ = broadcast.NotifyCompletion(ctx)
+	_ = broadcast.Close()
+
+	for _, subscriber := range []*recordingEventStream{first, second} {
+		if len(subscriber.chunks) != 2 || subscriber.chunks[0] != "one" || subscriber.chunks[1] != "two" {
+			t.Errorf("expected chunks [one two], got %v", subscriber.chunks)
+		}
+		if subscriber.completions != 1 {
+			t.Errorf("expected 1 completion, got %d", subscriber.completions)
+		}
+		if !subscriber.closed {
+			t.Error("expected subscriber stream to be closed")
+		}
+	}
+}
+
+func TestBroadcastEventStreamIsolatesFailingSubscriber(t *testing.T) {
+	ctx := context.Background()
+	broadcast := NewBroadcastEventStream()
+	failing := &recordingEventStream{chunkErr: errors.New("consumer broken")}
+	healthy := &recordingEventStream{}
+	broadcast.Subscribe(failing)
+	broadcast.Subscribe(healthy)
+
+	if err := broadcast.StreamChunk(ctx, "chunk"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = broadcast.Close()
+
+	if len(healthy.chunks) != 1 {
+		t.Errorf("expected healthy subscriber to receive the chunk, got %v", healthy.chunks)
+	}
+}
+
+func TestBroadcastEventStreamUnsubscribeStopsDelivery(t *testing.T) {
+	ctx := context.Background()
+	broadcast := NewBroadcastEventStream()
+	subscriber := &recordingEventStream{}
+	cancel := broadcast.Subscribe(subscriber)
+
+	_ = broadcast.StreamChunk(ctx, "before")
+	cancel()
+	_ = broadcast.StreamChunk(ctx, "after")
+	_ = broadcast.Close()
+
+	subscriber.mu.Lock()
+	defer subscriber.mu.Unlock()
+	for _, chunk := range subscriber.chunks {
+		if chunk == "after" {
+			t.Error("expected no delivery after unsubscribe")
+		}
+	}
+}
+
+func TestBroadcastEventStreamSubscribeAfterCloseIsNoop(t *testing.T) {
+	broadcast := NewBroadcastEventStream()
+	_ = broadcast.Close()
+
+	subscriber := &recordingEventStream{}
+	cancel := broadcast.Subscribe(subscriber)
+	cancel()
+
+	_ = broadcast.StreamChunk(context.Background(), "chunk")
+	if len(subscriber.chunks) != 0 {
+		t.Errorf("expected no chunks after close, got %v", subscriber.chunks)
+	}
+}
@@ -0,0 +1,88 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+type streamHealthKey struct{}
+
+// StreamHealth collects streaming and memory-write failures that degrade a
+// query without failing it, so the controller can surface them as status
+// conditions instead of leaving clients watching a silent dead stream
+type StreamHealth struct {
+	mu          sync.Mutex
+	streamErr   error
+	streamCount int
+	memoryErr   error
+	memoryCount int
+}
+
+// WithStreamHealth attaches a fresh health tracker to the context and returns
+// it
+func WithStreamHealth(ctx context.Context) (context.Context, *StreamHealth) {
+	health := &StreamHealth{}
+	return context.WithValue(ctx, streamHealthKey{}, health), health
+}
+
+// StreamHealthFromContext returns the health tracker, or nil when the caller
+// did not opt in
+func StreamHealthFromContext(ctx context.Context) *StreamHealth {
+	health, _ := ctx.Value(streamHealthKey{}).(*StreamHealth)
+	return health
+}
+
+// RecordStreamError notes a failed or dropped chunk delivery; nil receivers
+// make call sites unconditional
+func (h *StreamHealth) RecordStreamError(err error) {
+	if h == nil || err == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.streamErr = err
+	h.streamCount++
+}
+
+// RecordMemoryWriteError notes a failed write to the memory backend
+func (h *StreamHealth) RecordMemoryWriteError(err error) {
+	if h == nil || err == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.memoryErr = err
+	h.memoryCount++
+}
+
+// StreamError returns the last chunk delivery failure, annotated with the
+// failure count, or nil when streaming was healthy
+func (h *StreamHealth) StreamError() error {
+	if h == nil {
+		return nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return annotateCount(h.streamErr, h.streamCount)
+}
+
+// MemoryWriteError returns the last memory write failure, annotated with the
+// failure count, or nil when all writes succeeded
+func (h *StreamHealth) MemoryWriteError() error {
+	if h == nil {
+		return nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return annotateCount(h.memoryErr, h.memoryCount)
+}
+
+func annotateCount(err error, count int) error {
+	if err == nil || count <= 1 {
+		return err
+	}
+	return fmt.Errorf("%d failures, last: %w", count, err)
+}
@@ -0,0 +1,45 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestStreamHealthAnnotatesFailureCount(t *testing.T) {
+	health := &StreamHealth{}
+	health.RecordStreamError(errors.New("first"))
+	health.RecordStreamError(errors.New("second"))
+
+	err := health.StreamError()
+	if err == nil || !strings.Contains(err.Error(), "2 failures") || !strings.Contains(err.Error(), "second") {
+		t.Errorf("expected count and last error, got %v", err)
+	}
+	if health.MemoryWriteError() != nil {
+		t.Error("expected no memory write error")
+	}
+}
+
+func TestStreamHealthNilReceiverIsNoop(t *testing.T) {
+	var health *StreamHealth
+	health.RecordStreamError(errors.New("ignored"))
+	if health.StreamError() != nil {
+		t.Error("expected nil stream error from nil receiver")
+	}
+}
+
+func TestBroadcastRecordsFailuresToStreamHealth(t *testing.T) {
+	ctx, health := WithStreamHealth(context.Background())
+	broadcast := NewBroadcastEventStream()
+	broadcast.Subscribe(&recordingEventStream{chunkErr: errors.New("consumer broken")})
+
+	_ = broadcast.StreamChunk(ctx, "chunk")
+	_ = broadcast.Close()
+
+	if err := health.StreamError(); err == nil || !strings.Contains(err.Error(), "consumer broken") {
+		t.Errorf("expected subscriber failure recorded, got %v", err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"sync"
 	"time"
 
@@ -32,6 +33,12 @@ type StreamMetadata struct {
 	Team    string `json:"team,omitempty"`
 	Agent   string `json:"agent,omitempty"`
 	Model   string `json:"model,omitempty"`
+	// ServedBy names the model that actually answered when failover routed
+	// around the configured model
+	ServedBy string `json:"servedBy,omitempty"`
+	// Event marks frames with a lifecycle role, currently only "final" on the
+	// terminal frame carrying the complete assistant message
+	Event string `json:"event,omitempty"`
 }
 
 // ChunkWithMetadata wraps an OpenAI chunk with ARK metadata
@@ -42,7 +49,21 @@ type ChunkWithMetadata struct {
 
 // WrapChunkWithMetadata adds ARK metadata to a streaming chunk
 func WrapChunkWithMetadata(ctx context.Context, chunk *openai.ChatCompletionChunk, modelName string) interface{} {
-	// Build metadata from context
+	metadata := buildStreamMetadata(ctx, modelName)
+
+	// If no metadata, return chunk as-is for backward compatibility
+	if *metadata == (StreamMetadata{}) {
+		return chunk
+	}
+
+	return ChunkWithMetadata{
+		ChatCompletionChunk: chunk,
+		Ark:                 metadata,
+	}
+}
+
+// buildStreamMetadata builds ARK metadata from the execution context
+func buildStreamMetadata(ctx context.Context, modelName string) *StreamMetadata {
 	metadata := &StreamMetadata{}
 
 	// Get execution metadata from context
@@ -62,6 +83,10 @@ func WrapChunkWithMetadata(ctx context.Context, chunk *openai.ChatCompletionChun
 		metadata.Model = modelName
 	}
 
+	if servedBy := servedByFromContext(ctx); servedBy != "" && servedBy != metadata.Model {
+		metadata.ServedBy = servedBy
+	}
+
 	// Add query and session IDs
 	if queryID := getQueryID(ctx); queryID != "" {
 		metadata.Query = queryID
@@ -70,15 +95,163 @@ func WrapChunkWithMetadata(ctx context.Context, chunk *openai.ChatCompletionChun
 		metadata.Session = sessionID
 	}
 
-	// If no metadata, return chunk as-is for backward compatibility
-	if *metadata == (StreamMetadata{}) {
-		return chunk
+	return metadata
+}
+
+const (
+	ToolCallFrameStarted       = "tool_call.started"
+	ToolCallFrameArgumentsDone = "tool_call.arguments_done"
+	ToolCallFrameResult        = "tool_call.result"
+
+	// FinalFrameEvent is the ark.event value on the terminal stream frame
+	FinalFrameEvent = "final"
+)
+
+// FinalMessageFrame is the terminal frame emitted after a streamed model call:
+// it carries the full accumulated assistant message, content and tool calls
+// included, so consumers do not have to reassemble deltas. It is marked with
+// ark.event set to "final"
+type FinalMessageFrame struct {
+	ID           string                       `json:"id"`
+	Object       string                       `json:"object"`
+	Created      int64                        `json:"created"`
+	Model        string                       `json:"model"`
+	Message      openai.ChatCompletionMessage `json:"message"`
+	FinishReason string                       `json:"finish_reason,omitempty"`
+	Ark          *StreamMetadata              `json:"ark"`
+}
+
+// EmitFinalMessageFrame sends the terminal frame for a completed streamed
+// response
+func EmitFinalMessageFrame(ctx context.Context, eventStream EventStreamInterface, modelName string, response *openai.ChatCompletion) error {
+	if eventStream == nil || response == nil || len(response.Choices) == 0 {
+		return nil
 	}
 
-	return ChunkWithMetadata{
-		ChatCompletionChunk: chunk,
-		Ark:                 metadata,
+	choice := response.Choices[0]
+	metadata := buildStreamMetadata(ctx, modelName)
+	metadata.Event = FinalFrameEvent
+
+	frame := FinalMessageFrame{
+		ID:           response.ID,
+		Object:       "chat.completion.message",
+		Created:      response.Created,
+		Model:        response.Model,
+		Message:      choice.Message,
+		FinishReason: choice.FinishReason,
+		Ark:          metadata,
+	}
+	return eventStream.StreamChunk(ctx, frame)
+}
+
+// ToolCallFrame is an ARK-typed stream frame describing tool-call progress, so
+// consumers can show a tool call while its arguments are still streaming and
+// its result as soon as the tool completes
+type ToolCallFrame struct {
+	Type      string          `json:"type"`
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	Arguments string          `json:"arguments,omitempty"`
+	Result    string          `json:"result,omitempty"`
+	Ark       *StreamMetadata `json:"ark,omitempty"`
+}
+
+// EmitToolResultFrame sends a typed frame carrying a completed tool call's
+// result, so clients see tool outputs mid-query instead of waiting for the
+// final response
+func EmitToolResultFrame(ctx context.Context, eventStream EventStreamInterface, toolCallID, toolName, result string) error {
+	if eventStream == nil {
+		return nil
+	}
+
+	frame := ToolCallFrame{
+		Type:   ToolCallFrameResult,
+		ID:     toolCallID,
+		Name:   toolName,
+		Result: result,
+		Ark:    buildStreamMetadata(ctx, ""),
 	}
+	if *frame.Ark == (StreamMetadata{}) {
+		frame.Ark = nil
+	}
+	return eventStream.StreamChunk(ctx, frame)
+}
+
+// ToolCallStreamTracker watches streamed chunks and emits a ToolCallFrame when
+// a tool call first appears and again once its arguments have fully accumulated
+type ToolCallStreamTracker struct {
+	modelName string
+	calls     map[int64]*toolCallProgress
+	order     []int64
+}
+
+type toolCallProgress struct {
+	id        string
+	name      string
+	arguments string
+}
+
+func NewToolCallStreamTracker(modelName string) *ToolCallStreamTracker {
+	return &ToolCallStreamTracker{
+		modelName: modelName,
+		calls:     make(map[int64]*toolCallProgress),
+	}
+}
+
+// Observe inspects a chunk as it passes through the stream, emitting frames to
+// the event stream as tool-call boundaries are detected
+func (t *ToolCallStreamTracker) Observe(ctx context.Context, chunk *openai.ChatCompletionChunk, eventStream EventStreamInterface) error {
+	if len(chunk.Choices) == 0 {
+		return nil
+	}
+
+	choice := chunk.Choices[0]
+	for _, delta := range choice.Delta.ToolCalls {
+		call, exists := t.calls[delta.Index]
+		if !exists {
+			call = &toolCallProgress{id: delta.ID, name: delta.Function.Name, arguments: delta.Function.Arguments}
+			t.calls[delta.Index] = call
+			t.order = append(t.order, delta.Index)
+			if err := t.emitFrame(ctx, eventStream, ToolCallFrameStarted, call, false); err != nil {
+				return err
+			}
+			continue
+		}
+		call.arguments += delta.Function.Arguments
+	}
+
+	if choice.FinishReason == "tool_calls" {
+		return t.flush(ctx, eventStream)
+	}
+
+	return nil
+}
+
+func (t *ToolCallStreamTracker) flush(ctx context.Context, eventStream EventStreamInterface) error {
+	for _, index := range t.order {
+		if err := t.emitFrame(ctx, eventStream, ToolCallFrameArgumentsDone, t.calls[index], true); err != nil {
+			return err
+		}
+	}
+	t.calls = make(map[int64]*toolCallProgress)
+	t.order = nil
+	return nil
+}
+
+func (t *ToolCallStreamTracker) emitFrame(ctx context.Context, eventStream EventStreamInterface, frameType string, call *toolCallProgress, includeArguments bool) error {
+	frame := ToolCallFrame{
+		Type: frameType,
+		ID:   call.id,
+		Name: call.name,
+		Ark:  buildStreamMetadata(ctx, t.modelName),
+	}
+	if includeArguments {
+		frame.Arguments = call.arguments
+	}
+	if *frame.Ark == (StreamMetadata{}) {
+		frame.Ark = nil
+	}
+	return eventStream.StreamChunk(ctx, frame)
 }
 
 // EventStreamInterface defines streaming capabilities for real-time event delivery
@@ -97,6 +270,9 @@ type EventStreamInterface interface {
 type StreamingConfig struct {
 	Enabled    bool
 	ServiceRef arkv1alpha1.ServiceReference
+	// MaxChunksPerSecond caps the frame rate per stream by coalescing content
+	// deltas; zero streams every chunk through unthrottled
+	MaxChunksPerSecond int
 }
 
 // GetStreamingConfig loads and validates the streaming configuration from ConfigMap
@@ -151,13 +327,21 @@ func GetStreamingConfig(ctx context.Context, k8sClient client.Client, namespace
 		return nil, fmt.Errorf("serviceRef must have a name")
 	}
 
+	if rateStr, ok := cm.Data["maxChunksPerSecond"]; ok {
+		rate, err := strconv.Atoi(rateStr)
+		if err != nil || rate < 0 {
+			return nil, fmt.Errorf("invalid maxChunksPerSecond %q", rateStr)
+		}
+		config.MaxChunksPerSecond = rate
+	}
+
 	return config, nil
 }
 
 // NewEventStreamForQuery creates an EventStreamInterface if streaming is configured and enabled
 // Returns (nil, nil) if streaming is not configured or disabled
 // Returns (nil, error) if configuration is invalid or service cannot be resolved
-func NewEventStreamForQuery(ctx context.Context, k8sClient client.Client, namespace, sessionId, queryName string) (EventStreamInterface, error) {
+func NewEventStreamForQuery(ctx context.Context, k8sClient client.Client, namespace string, memoryCtx MemoryContext) (EventStreamInterface, error) {
 	// Get streaming configuration
 	config, err := GetStreamingConfig(ctx, k8sClient, namespace)
 	if err != nil {
@@ -176,19 +360,21 @@ func NewEventStreamForQuery(ctx context.Context, k8sClient client.Client, namesp
 	}
 
 	// Create HTTP event stream client
-	return &HTTPEventStream{
+	var stream EventStreamInterface = &HTTPEventStream{
 		baseURL:   baseURL,
-		sessionId: sessionId,
-		queryName: queryName,
+		memoryCtx: memoryCtx,
 		client:    common.NewHTTPClientWithLogging(ctx),
-	}, nil
+	}
+	if config.MaxChunksPerSecond > 0 {
+		stream = NewThrottledEventStream(stream, config.MaxChunksPerSecond)
+	}
+	return stream, nil
 }
 
 // HTTPEventStream implements EventStreamInterface for HTTP-based streaming
 type HTTPEventStream struct {
 	baseURL   string
-	sessionId string
-	queryName string
+	memoryCtx MemoryContext
 	client    *http.Client
 
 	// For persistent streaming connection
@@ -234,7 +420,7 @@ func (h *HTTPEventStream) startStream(ctx context.Context) error {
 	h.streamWriter = pipeWriter
 
 	// Construct the streaming URL with proper escaping
-	streamURL := fmt.Sprintf("%s/stream/%s", h.baseURL, url.QueryEscape(h.queryName))
+	streamURL := fmt.Sprintf("%s/stream/%s", h.baseURL, url.QueryEscape(h.memoryCtx.QueryName))
 
 	// CRITICAL: Use context.Background() instead of the query context for the streaming HTTP request.
 	// This allows the HTTP POST to complete gracefully when NotifyCompletion is called.
@@ -293,7 +479,7 @@ func (h *HTTPEventStream) NotifyCompletion(ctx context.Context) error {
 	}
 
 	// Send completion signal
-	completeURL := fmt.Sprintf("%s/stream/%s/complete", h.baseURL, url.QueryEscape(h.queryName))
+	completeURL := fmt.Sprintf("%s"+CompletionEndpoint, h.baseURL, url.QueryEscape(h.memoryCtx.QueryName))
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, completeURL, bytes.NewReader([]byte("{}")))
 	if err != nil {
 		return fmt.Errorf("failed to create completion request: %w", err)
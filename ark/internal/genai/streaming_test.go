@@ -114,6 +114,115 @@ func TestWrapChunkWithMetadata(t *testing.T) {
 	}
 }
 
+type mockEventStream struct {
+	chunks []interface{}
+}
+
+func (m *mockEventStream) StreamChunk(ctx context.Context, chunk interface{}) error {
+	m.chunks = append(m.chunks, chunk)
+	return nil
+}
+
+func (m *mockEventStream) NotifyCompletion(ctx context.Context) error { return nil }
+
+func (m *mockEventStream) Close() error { return nil }
+
+func TestToolCallStreamTracker(t *testing.T) {
+	ctx := context.Background()
+	stream := &mockEventStream{}
+	tracker := NewToolCallStreamTracker("test-model")
+
+	toolCallChunk := func(index int64, id, name, arguments string) *openai.ChatCompletionChunk {
+		return &openai.ChatCompletionChunk{
+			Choices: []openai.ChatCompletionChunkChoice{
+				{
+					Delta: openai.ChatCompletionChunkChoiceDelta{
+						ToolCalls: []openai.ChatCompletionChunkChoiceDeltaToolCall{
+							{
+								Index: index,
+								ID:    id,
+								Function: openai.ChatCompletionChunkChoiceDeltaToolCallFunction{
+									Name:      name,
+									Arguments: arguments,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	assert.NoError(t, tracker.Observe(ctx, toolCallChunk(0, "call_1", "get_weather", ""), stream))
+	assert.NoError(t, tracker.Observe(ctx, toolCallChunk(0, "", "", `{"location"`), stream))
+	assert.NoError(t, tracker.Observe(ctx, toolCallChunk(0, "", "", `: "Boston"}`), stream))
+
+	assert.Len(t, stream.chunks, 1)
+	started, ok := stream.chunks[0].(ToolCallFrame)
+	assert.True(t, ok)
+	assert.Equal(t, ToolCallFrameStarted, started.Type)
+	assert.Equal(t, "call_1", started.ID)
+	assert.Equal(t, "get_weather", started.Name)
+	assert.Empty(t, started.Arguments)
+
+	finishChunk := &openai.ChatCompletionChunk{
+		Choices: []openai.ChatCompletionChunkChoice{
+			{FinishReason: "tool_calls"},
+		},
+	}
+	assert.NoError(t, tracker.Observe(ctx, finishChunk, stream))
+
+	assert.Len(t, stream.chunks, 2)
+	done, ok := stream.chunks[1].(ToolCallFrame)
+	assert.True(t, ok)
+	assert.Equal(t, ToolCallFrameArgumentsDone, done.Type)
+	assert.Equal(t, "call_1", done.ID)
+	assert.Equal(t, "get_weather", done.Name)
+	assert.Equal(t, `{"location": "Boston"}`, done.Arguments)
+}
+
+func TestEmitFinalMessageFrame(t *testing.T) {
+	ctx := WithQueryContext(context.Background(), "query-123", "session-456", "test-query")
+	stream := &mockEventStream{}
+
+	response := &openai.ChatCompletion{
+		ID:      "chatcmpl-1",
+		Created: 42,
+		Model:   "test-model",
+		Choices: []openai.ChatCompletionChoice{
+			{
+				FinishReason: "tool_calls",
+				Message: openai.ChatCompletionMessage{
+					Content: "partial answer",
+					ToolCalls: []openai.ChatCompletionMessageToolCall{
+						{ID: "call_1"},
+					},
+				},
+			},
+		},
+	}
+
+	assert.NoError(t, EmitFinalMessageFrame(ctx, stream, "test-model", response))
+	assert.Len(t, stream.chunks, 1)
+
+	frame, ok := stream.chunks[0].(FinalMessageFrame)
+	assert.True(t, ok)
+	assert.Equal(t, "chatcmpl-1", frame.ID)
+	assert.Equal(t, "chat.completion.message", frame.Object)
+	assert.Equal(t, "partial answer", frame.Message.Content)
+	assert.Len(t, frame.Message.ToolCalls, 1)
+	assert.Equal(t, "tool_calls", frame.FinishReason)
+	assert.Equal(t, FinalFrameEvent, frame.Ark.Event)
+	assert.Equal(t, "query-123", frame.Ark.Query)
+}
+
+func TestEmitFinalMessageFrameNoChoices(t *testing.T) {
+	stream := &mockEventStream{}
+
+	assert.NoError(t, EmitFinalMessageFrame(context.Background(), stream, "test-model", &openai.ChatCompletion{}))
+	assert.Empty(t, stream.chunks)
+}
+
 func TestStreamMetadata_Empty(t *testing.T) {
 	// Test that empty metadata is correctly identified
 	emptyMeta := StreamMetadata{}
@@ -0,0 +1,133 @@
+package genai
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/openai/openai-go"
+)
+
+// ThrottledEventStream wraps an event stream and bounds the frame rate by
+// coalescing consecutive content deltas into larger frames, protecting
+// downstream services and browsers from very fast models emitting hundreds of
+// tiny frames per second. Tool-call frames, final frames and anything else
+// that is not a plain content delta flush the pending buffer and pass through
+// immediately
+type ThrottledEventStream struct {
+	inner       EventStreamInterface
+	minInterval time.Duration
+
+	mu        sync.Mutex
+	pending   interface{}
+	buffered  string
+	lastFlush time.Time
+}
+
+// NewThrottledEventStream caps the wrapped stream at maxChunksPerSecond
+// content frames per second
+func NewThrottledEventStream(inner EventStreamInterface, maxChunksPerSecond int) *ThrottledEventStream {
+	return &ThrottledEventStream{
+		inner:       inner,
+		minInterval: time.Second / time.Duration(maxChunksPerSecond),
+		lastFlush:   time.Now(),
+	}
+}
+
+func (t *ThrottledEventStream) StreamChunk(ctx context.Context, chunk interface{}) error {
+	content, coalescible := chunkDeltaContent(chunk)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !coalescible {
+		if err := t.flushLocked(ctx); err != nil {
+			return err
+		}
+		t.lastFlush = time.Now()
+		return t.inner.StreamChunk(ctx, chunk)
+	}
+
+	t.pending = chunk
+	t.buffered += content
+	if time.Since(t.lastFlush) < t.minInterval {
+		return nil
+	}
+	t.lastFlush = time.Now()
+	return t.flushLocked(ctx)
+}
+
+func (t *ThrottledEventStream) NotifyCompletion(ctx context.Context) error {
+	t.mu.Lock()
+	err := t.flushLocked(ctx)
+	t.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return t.inner.NotifyCompletion(ctx)
+}
+
+func (t *ThrottledEventStream) Close() error {
+	t.mu.Lock()
+	err := t.flushLocked(context.Background())
+	t.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return t.inner.Close()
+}
+
+// flushLocked forwards the coalesced pending frame, rewriting its delta to
+// carry the accumulated content. Callers must hold the mutex
+func (t *ThrottledEventStream) flushLocked(ctx context.Context) error {
+	if t.pending == nil {
+		return nil
+	}
+	chunk := withDeltaContent(t.pending, t.buffered)
+	t.pending = nil
+	t.buffered = ""
+	return t.inner.StreamChunk(ctx, chunk)
+}
+
+// chunkDeltaContent returns the content delta of a frame and whether the
+// frame is a plain content delta that may be coalesced
+func chunkDeltaContent(chunk interface{}) (string, bool) {
+	raw := rawChunk(chunk)
+	if raw == nil || len(raw.Choices) != 1 {
+		return "", false
+	}
+	delta := raw.Choices[0].Delta
+	if len(delta.ToolCalls) > 0 || raw.Choices[0].FinishReason != "" {
+		return "", false
+	}
+	return delta.Content, delta.Content != ""
+}
+
+func rawChunk(chunk interface{}) *openai.ChatCompletionChunk {
+	switch value := chunk.(type) {
+	case *openai.ChatCompletionChunk:
+		return value
+	case ChunkWithMetadata:
+		return value.ChatCompletionChunk
+	default:
+		return nil
+	}
+}
+
+// withDeltaContent returns a copy of the frame whose single delta carries the
+// given accumulated content
+func withDeltaContent(chunk interface{}, content string) interface{} {
+	switch value := chunk.(type) {
+	case *openai.ChatCompletionChunk:
+		copied := *value
+		copied.Choices = append([]openai.ChatCompletionChunkChoice{}, value.Choices...)
+		copied.Choices[0].Delta.Content = content
+		return &copied
+	case ChunkWithMetadata:
+		copied := *value.ChatCompletionChunk
+		copied.Choices = append([]openai.ChatCompletionChunkChoice{}, value.ChatCompletionChunk.Choices...)
+		copied.Choices[0].Delta.Content = content
+		return ChunkWithMetadata{ChatCompletionChunk: &copied, Ark: value.Ark}
+	default:
+		return chunk
+	}
+}
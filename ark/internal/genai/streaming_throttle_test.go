@@ -0,0 +1,100 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openai/openai-go"
+)
+
+func contentChunk(content string) *openai.ChatCompletionChunk {
+	return &openai.ChatCompletionChunk{
+		Choices: []openai.ChatCompletionChunkChoice{
+			{Delta: openai.ChatCompletionChunkChoiceDelta{Content: content}},
+		},
+	}
+}
+
+func TestThrottledEventStreamCoalescesContentDeltas(t *testing.T) {
+	ctx := context.Background()
+	inner := &recordingEventStream{}
+	stream := NewThrottledEventStream(inner, 1)
+
+	for _, content := range []string{"Hel", "lo ", "wor", "ld"} {
+		if err := stream.StreamChunk(ctx, contentChunk(content)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := stream.NotifyCompletion(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(inner.chunks) != 1 {
+		t.Fatalf("expected 1 coalesced chunk, got %d", len(inner.chunks))
+	}
+	chunk, ok := inner.chunks[0].(*openai.ChatCompletionChunk)
+	if !ok {
+		t.Fatalf("expected *openai.ChatCompletionChunk, got %T", inner.chunks[0])
+	}
+	if chunk.Choices[0].Delta.Content != "Hello world" {
+		t.Errorf("expected coalesced content 'Hello world', got %q", chunk.Choices[0].Delta.Content)
+	}
+	if inner.completions != 1 {
+		t.Errorf("expected 1 completion, got %d", inner.completions)
+	}
+}
+
+func TestThrottledEventStreamFlushesBeforeNonContentFrames(t *testing.T) {
+	ctx := context.Background()
+	inner := &recordingEventStream{}
+	stream := NewThrottledEventStream(inner, 1)
+
+	_ = stream.StreamChunk(ctx, contentChunk("partial"))
+	frame := ToolCallFrame{Type: "tool_call", ID: "call-1", Name: "search"}
+	if err := stream.StreamChunk(ctx, frame); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(inner.chunks) != 2 {
+		t.Fatalf("expected buffered content then tool frame, got %d chunks", len(inner.chunks))
+	}
+	chunk, ok := inner.chunks[0].(*openai.ChatCompletionChunk)
+	if !ok || chunk.Choices[0].Delta.Content != "partial" {
+		t.Errorf("expected buffered content flushed first, got %v", inner.chunks[0])
+	}
+	if _, ok := inner.chunks[1].(ToolCallFrame); !ok {
+		t.Errorf("expected tool call frame passed through, got %T", inner.chunks[1])
+	}
+}
+
+func TestThrottledEventStreamPreservesChunkMetadata(t *testing.T) {
+	ctx := context.Background()
+	inner := &recordingEventStream{}
+	stream := NewThrottledEventStream(inner, 1)
+
+	metadata := &StreamMetadata{Model: "gpt-4"}
+	_ = stream.StreamChunk(ctx, ChunkWithMetadata{ChatCompletionChunk: contentChunk("a"), Ark: metadata})
+	_ = stream.StreamChunk(ctx, ChunkWithMetadata{ChatCompletionChunk: contentChunk("b"), Ark: metadata})
+	if err := stream.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(inner.chunks) != 1 {
+		t.Fatalf("expected 1 coalesced chunk, got %d", len(inner.chunks))
+	}
+	wrapped, ok := inner.chunks[0].(ChunkWithMetadata)
+	if !ok {
+		t.Fatalf("expected ChunkWithMetadata, got %T", inner.chunks[0])
+	}
+	if wrapped.Choices[0].Delta.Content != "ab" {
+		t.Errorf("expected coalesced content 'ab', got %q", wrapped.Choices[0].Delta.Content)
+	}
+	if wrapped.Ark != metadata {
+		t.Error("expected metadata preserved on coalesced chunk")
+	}
+	if !inner.closed {
+		t.Error("expected inner stream to be closed")
+	}
+}
@@ -0,0 +1,52 @@
+package genai
+
+import (
+	"context"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// PinnedSystemPromptKey is the metadata key under which a conversation's
+// system prompt is pinned
+const PinnedSystemPromptKey = "pinned-system-prompt"
+
+// PinSystemPrompt stores the system prompt in memory metadata the first time
+// one is used in a conversation, so follow-up queries that resume the
+// conversation reuse it even when they target a raw model. Backends without
+// metadata support are skipped
+func PinSystemPrompt(ctx context.Context, memory MemoryInterface, prompt string) {
+	store, ok := memory.(MetadataInterface)
+	if !ok || prompt == "" {
+		return
+	}
+
+	log := logf.FromContext(ctx)
+	_, pinned, err := store.GetMetadata(ctx, PinnedSystemPromptKey)
+	if err != nil {
+		log.V(1).Info("skipping system prompt pinning", "error", err.Error())
+		return
+	}
+	if pinned {
+		return
+	}
+
+	if err := store.PutMetadata(ctx, PinnedSystemPromptKey, prompt); err != nil {
+		log.Error(err, "failed to pin system prompt to conversation")
+	}
+}
+
+// PinnedSystemPrompt returns the system prompt pinned to the conversation, if
+// any
+func PinnedSystemPrompt(ctx context.Context, memory MemoryInterface) (string, bool) {
+	store, ok := memory.(MetadataInterface)
+	if !ok {
+		return "", false
+	}
+
+	value, found, err := store.GetMetadata(ctx, PinnedSystemPromptKey)
+	if err != nil {
+		logf.FromContext(ctx).V(1).Info("failed to read pinned system prompt", "error", err.Error())
+		return "", false
+	}
+	return value, found
+}
@@ -20,6 +20,7 @@ type Team struct {
 	MaxTurns    *int
 	Selector    *arkv1alpha1.TeamSelectorSpec
 	Graph       *arkv1alpha1.TeamGraphSpec
+	Parallel    *arkv1alpha1.TeamParallelSpec
 	Recorder    EventEmitter
 	Client      client.Client
 	Namespace   string
@@ -59,6 +60,8 @@ func (t *Team) Execute(ctx context.Context, userInput Message, history []Message
 		execFunc = t.executeSelector
 	case "graph":
 		execFunc = t.executeGraph
+	case "parallel":
+		execFunc = t.executeParallel
 	default:
 		err := fmt.Errorf("unsupported strategy %s for team %s", t.Strategy, t.FullName())
 		teamTracker.Fail(err)
@@ -173,6 +176,7 @@ func MakeTeam(ctx context.Context, k8sClient client.Client, crd *arkv1alpha1.Tea
 		MaxTurns:    crd.Spec.MaxTurns,
 		Selector:    crd.Spec.Selector,
 		Graph:       crd.Spec.Graph,
+		Parallel:    crd.Spec.Parallel,
 		Recorder:    recorder,
 		Client:      k8sClient,
 		Namespace:   crd.Namespace,
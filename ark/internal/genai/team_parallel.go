@@ -0,0 +1,100 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"mckinsey.com/ark/internal/telemetry"
+)
+
+// executeParallel fans the same prompt out to every member at once with
+// bounded concurrency, then optionally has a coordinator member merge or
+// critique the collected responses
+func (t *Team) executeParallel(ctx context.Context, userInput Message, history []Message) ([]Message, error) {
+	fanOutMembers, coordinator := t.splitParallelMembers()
+	if len(fanOutMembers) == 0 {
+		return nil, fmt.Errorf("team %s has no members to fan out to", t.FullName())
+	}
+
+	maxConcurrency := len(fanOutMembers)
+	if t.Parallel != nil && t.Parallel.MaxConcurrency != nil && *t.Parallel.MaxConcurrency > 0 {
+		maxConcurrency = *t.Parallel.MaxConcurrency
+	}
+
+	results := make([][]Message, len(fanOutMembers))
+	errs := make([]error, len(fanOutMembers))
+	semaphore := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+
+	tracer := telemetry.NewTraceContext()
+	for i, member := range fanOutMembers {
+		wg.Add(1)
+		go func(idx int, member TeamMember) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			memberCtx, span := tracer.StartTargetSpan(ctx, member.GetType(), member.GetName())
+			defer span.End()
+
+			memberMessages := slices.Clone(history)
+			var memberNewMessages []Message
+			err := t.executeMemberAndAccumulate(memberCtx, member, userInput, &memberMessages, &memberNewMessages, 0)
+			if err != nil && !IsTerminateTeam(err) {
+				telemetry.RecordError(span, err)
+			} else {
+				telemetry.RecordSuccess(span)
+			}
+			results[idx] = memberNewMessages
+			errs[idx] = err
+		}(i, member)
+	}
+	wg.Wait()
+
+	var newMessages []Message
+	for _, memberMessages := range results {
+		newMessages = append(newMessages, memberMessages...)
+	}
+
+	for i, memberErr := range errs {
+		if memberErr != nil && !IsTerminateTeam(memberErr) {
+			return newMessages, fmt.Errorf("agent %s failed in team %s: %w", fanOutMembers[i].GetName(), t.FullName(), memberErr)
+		}
+	}
+
+	if coordinator == nil {
+		return newMessages, nil
+	}
+
+	messages := slices.Clone(history)
+	messages = append(messages, newMessages...)
+	if err := t.executeMemberAndAccumulate(ctx, coordinator, userInput, &messages, &newMessages, 1); err != nil {
+		if IsTerminateTeam(err) {
+			return newMessages, nil
+		}
+		return newMessages, fmt.Errorf("coordinator %s failed in team %s: %w", coordinator.GetName(), t.FullName(), err)
+	}
+
+	return newMessages, nil
+}
+
+// splitParallelMembers separates the coordinator, when configured, from the
+// members that receive the fan-out prompt
+func (t *Team) splitParallelMembers() ([]TeamMember, TeamMember) {
+	if t.Parallel == nil || t.Parallel.Coordinator == "" {
+		return t.Members, nil
+	}
+
+	var fanOutMembers []TeamMember
+	var coordinator TeamMember
+	for _, member := range t.Members {
+		if coordinator == nil && member.GetName() == t.Parallel.Coordinator {
+			coordinator = member
+			continue
+		}
+		fanOutMembers = append(fanOutMembers, member)
+	}
+	return fanOutMembers, coordinator
+}
@@ -0,0 +1,80 @@
+package genai
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const timingTrackerKey contextKey = "timingTracker"
+
+// TargetTiming summarizes where time went while executing a single target
+type TargetTiming struct {
+	Duration         time.Duration
+	TimeToFirstToken time.Duration
+	ModelDuration    time.Duration
+	ToolDuration     time.Duration
+}
+
+// TimingTracker accumulates model and tool time for one target execution
+type TimingTracker struct {
+	mu            sync.Mutex
+	start         time.Time
+	firstToken    time.Time
+	modelDuration time.Duration
+	toolDuration  time.Duration
+}
+
+// WithTimingTracker installs a timing tracker on the context so model and
+// tool calls executed under it can report where time was spent
+func WithTimingTracker(ctx context.Context) (context.Context, *TimingTracker) {
+	tracker := &TimingTracker{start: time.Now()}
+	return context.WithValue(ctx, timingTrackerKey, tracker), tracker
+}
+
+// TimingTrackerFromContext returns the timing tracker installed on the
+// context, or nil when timing is not being collected
+func TimingTrackerFromContext(ctx context.Context) *TimingTracker {
+	tracker, _ := ctx.Value(timingTrackerKey).(*TimingTracker)
+	return tracker
+}
+
+// AddModelTime records time spent in a model call
+func (t *TimingTracker) AddModelTime(duration time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.modelDuration += duration
+}
+
+// AddToolTime records time spent in a tool call
+func (t *TimingTracker) AddToolTime(duration time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.toolDuration += duration
+}
+
+// MarkFirstToken records when the first streamed token arrived; subsequent
+// calls have no effect
+func (t *TimingTracker) MarkFirstToken() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.firstToken.IsZero() {
+		t.firstToken = time.Now()
+	}
+}
+
+// Summary returns the accumulated timing, measuring total duration from
+// tracker creation
+func (t *TimingTracker) Summary() TargetTiming {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	timing := TargetTiming{
+		Duration:      time.Since(t.start),
+		ModelDuration: t.modelDuration,
+		ToolDuration:  t.toolDuration,
+	}
+	if !t.firstToken.IsZero() {
+		timing.TimeToFirstToken = t.firstToken.Sub(t.start)
+	}
+	return timing
+}
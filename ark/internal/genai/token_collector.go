@@ -5,6 +5,8 @@ package genai
 import (
 	"context"
 	"sync"
+
+	corev1 "k8s.io/api/core/v1"
 )
 
 type TokenUsageCollector struct {
@@ -27,9 +29,21 @@ func (c *TokenUsageCollector) EmitEvent(ctx context.Context, eventType, reason s
 		c.mu.Lock()
 		c.tokenUsages = append(c.tokenUsages, opEvent.TokenUsage)
 		c.mu.Unlock()
+
+		c.emitUsageUpdate(ctx, opEvent.Name)
 	}
 }
 
+// emitUsageUpdate emits the cumulative token usage so far, so clients can
+// track spend incrementally while a query is still executing.
+func (c *TokenUsageCollector) emitUsageUpdate(ctx context.Context, operationName string) {
+	summary := c.GetTokenSummary()
+	c.recorder.EmitEvent(ctx, corev1.EventTypeNormal, "TokenUsageUpdate", OperationEvent{
+		BaseEvent:  BaseEvent{Name: operationName},
+		TokenUsage: summary,
+	})
+}
+
 func (c *TokenUsageCollector) GetTokenSummary() TokenUsage {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -53,8 +53,14 @@ func TestTokenUsageCollector(t *testing.T) {
 	}
 	collector.EmitEvent(ctx, corev1.EventTypeNormal, "LLMCallComplete", tokenEvent2)
 
-	// Verify events were passed through to underlying recorder
-	assert.Len(t, mockRec.events, 3)
+	// Verify events were passed through to underlying recorder, plus one
+	// incremental TokenUsageUpdate per token-bearing event
+	assert.Len(t, mockRec.events, 5)
+
+	// The final TokenUsageUpdate carries the cumulative totals
+	lastUpdate, ok := mockRec.events[4].(OperationEvent)
+	assert.True(t, ok)
+	assert.Equal(t, int64(425), lastUpdate.TokenUsage.TotalTokens)
 
 	// Verify token usage was aggregated correctly
 	summary := collector.GetTokenSummary()
@@ -0,0 +1,116 @@
+package genai
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// validateAndCoerceArguments checks tool call arguments against the tool's
+// input schema before execution. Arguments with a mismatched but convertible
+// type (e.g. a number sent as a string) are coerced; missing required
+// arguments or unknown types are reported as errors so the model receives
+// actionable feedback instead of a downstream execution failure.
+func validateAndCoerceArguments(def ToolDefinition, rawArguments string) (string, error) {
+	if len(def.Parameters) == 0 {
+		return rawArguments, nil
+	}
+
+	var arguments map[string]any
+	if rawArguments == "" {
+		arguments = map[string]any{}
+	} else if err := json.Unmarshal([]byte(rawArguments), &arguments); err != nil {
+		return "", fmt.Errorf("arguments are not valid JSON: %w", err)
+	}
+
+	properties, _ := def.Parameters["properties"].(map[string]any)
+
+	if required, ok := def.Parameters["required"].([]any); ok {
+		for _, name := range required {
+			requiredName, ok := name.(string)
+			if !ok {
+				continue
+			}
+			if _, present := arguments[requiredName]; !present {
+				return "", fmt.Errorf("missing required argument %q", requiredName)
+			}
+		}
+	}
+
+	for name, value := range arguments {
+		property, ok := properties[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		expectedType, ok := property["type"].(string)
+		if !ok {
+			continue
+		}
+		coerced, err := coerceArgument(value, expectedType)
+		if err != nil {
+			return "", fmt.Errorf("argument %q: %w", name, err)
+		}
+		arguments[name] = coerced
+	}
+
+	coercedJSON, err := json.Marshal(arguments)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize coerced arguments: %w", err)
+	}
+	return string(coercedJSON), nil
+}
+
+func coerceArgument(value any, expectedType string) (any, error) {
+	switch expectedType {
+	case "string":
+		switch typed := value.(type) {
+		case string:
+			return typed, nil
+		case float64:
+			return strconv.FormatFloat(typed, 'f', -1, 64), nil
+		case bool:
+			return strconv.FormatBool(typed), nil
+		}
+	case "number":
+		switch typed := value.(type) {
+		case float64:
+			return typed, nil
+		case string:
+			if parsed, err := strconv.ParseFloat(typed, 64); err == nil {
+				return parsed, nil
+			}
+		}
+	case "integer":
+		switch typed := value.(type) {
+		case float64:
+			if typed == float64(int64(typed)) {
+				return typed, nil
+			}
+			return nil, fmt.Errorf("expected integer, got %v", typed)
+		case string:
+			if parsed, err := strconv.ParseInt(typed, 10, 64); err == nil {
+				return parsed, nil
+			}
+		}
+	case "boolean":
+		switch typed := value.(type) {
+		case bool:
+			return typed, nil
+		case string:
+			if parsed, err := strconv.ParseBool(typed); err == nil {
+				return parsed, nil
+			}
+		}
+	case "object":
+		if typed, ok := value.(map[string]any); ok {
+			return typed, nil
+		}
+	case "array":
+		if typed, ok := value.([]any); ok {
+			return typed, nil
+		}
+	default:
+		return value, nil
+	}
+	return nil, fmt.Errorf("expected %s, got %T", expectedType, value)
+}
@@ -0,0 +1,57 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func weatherToolDefinition() ToolDefinition {
+	return ToolDefinition{
+		Name: "get-weather",
+		Parameters: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"location": map[string]any{"type": "string"},
+				"days":     map[string]any{"type": "integer"},
+				"detailed": map[string]any{"type": "boolean"},
+			},
+			"required": []any{"location"},
+		},
+	}
+}
+
+func TestValidateAndCoerceArgumentsPassesValidArguments(t *testing.T) {
+	result, err := validateAndCoerceArguments(weatherToolDefinition(), `{"location":"Boston","days":3}`)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"location":"Boston","days":3}`, result)
+}
+
+func TestValidateAndCoerceArgumentsCoercesTypes(t *testing.T) {
+	result, err := validateAndCoerceArguments(weatherToolDefinition(), `{"location":42,"days":"5","detailed":"true"}`)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"location":"42","days":5,"detailed":true}`, result)
+}
+
+func TestValidateAndCoerceArgumentsRejectsMissingRequired(t *testing.T) {
+	_, err := validateAndCoerceArguments(weatherToolDefinition(), `{"days":3}`)
+	assert.ErrorContains(t, err, "location")
+}
+
+func TestValidateAndCoerceArgumentsRejectsUncoercibleTypes(t *testing.T) {
+	_, err := validateAndCoerceArguments(weatherToolDefinition(), `{"location":"Boston","days":"soon"}`)
+	assert.ErrorContains(t, err, "days")
+}
+
+func TestValidateAndCoerceArgumentsWithoutSchemaIsPassthrough(t *testing.T) {
+	result, err := validateAndCoerceArguments(ToolDefinition{Name: "noop"}, `{"anything":"goes"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"anything":"goes"}`, result)
+}
+
+func TestValidateAndCoerceArgumentsRejectsMalformedJSON(t *testing.T) {
+	_, err := validateAndCoerceArguments(weatherToolDefinition(), `{"location":`)
+	assert.ErrorContains(t, err, "valid JSON")
+}
@@ -0,0 +1,56 @@
+package genai
+
+import (
+	"context"
+
+	"github.com/openai/openai-go"
+)
+
+// Well-known tool choice values; any other value names a specific tool the
+// model must call
+const (
+	ToolChoiceAuto     = "auto"
+	ToolChoiceNone     = "none"
+	ToolChoiceRequired = "required"
+)
+
+const toolChoiceOverrideKey contextKey = "toolChoiceOverride"
+
+// WithToolChoiceOverride installs a per-query tool choice on the context,
+// taking precedence over the agent's configured toolChoice
+func WithToolChoiceOverride(ctx context.Context, choice string) context.Context {
+	return context.WithValue(ctx, toolChoiceOverrideKey, choice)
+}
+
+// ToolChoiceOverrideFromContext returns the per-query tool choice override, or
+// empty when no override is active
+func ToolChoiceOverrideFromContext(ctx context.Context) string {
+	if val := ctx.Value(toolChoiceOverrideKey); val != nil {
+		if choice, ok := val.(string); ok {
+			return choice
+		}
+	}
+	return ""
+}
+
+// ResolveToolChoice picks the effective tool choice: a query override wins
+// over the agent's configured value
+func ResolveToolChoice(ctx context.Context, agentChoice string) string {
+	if override := ToolChoiceOverrideFromContext(ctx); override != "" {
+		return override
+	}
+	return agentChoice
+}
+
+// toolChoiceParam maps a tool choice string to the provider union: the
+// well-known values pass through as-is, anything else becomes a named
+// function choice
+func toolChoiceParam(choice string) openai.ChatCompletionToolChoiceOptionUnionParam {
+	switch choice {
+	case ToolChoiceAuto, ToolChoiceNone, ToolChoiceRequired:
+		return openai.ChatCompletionToolChoiceOptionUnionParam{OfAuto: openai.String(choice)}
+	default:
+		return openai.ChatCompletionToolChoiceOptionParamOfChatCompletionNamedToolChoice(
+			openai.ChatCompletionNamedToolChoiceFunctionParam{Name: choice})
+	}
+}
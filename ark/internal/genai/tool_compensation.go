@@ -0,0 +1,121 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const compensationTimeout = 30 * time.Second
+
+type compensationLogKey struct{}
+
+// CompensationEntry is one executed side-effecting tool call and the
+// executor that undoes it
+type CompensationEntry struct {
+	Tool             string
+	CompensationTool string
+	Arguments        string
+	CallID           string
+	executor         ToolExecutor
+}
+
+// CompensationLog collects executed tool calls that declared a compensation,
+// so the controller can undo them when the query fails or is canceled
+type CompensationLog struct {
+	mu      sync.Mutex
+	entries []CompensationEntry
+}
+
+// WithCompensationLog installs a compensation log on the context for one
+// query execution
+func WithCompensationLog(ctx context.Context) (context.Context, *CompensationLog) {
+	log := &CompensationLog{}
+	return context.WithValue(ctx, compensationLogKey{}, log), log
+}
+
+// CompensationLogFromContext returns the active log, or nil when none is
+// installed
+func CompensationLogFromContext(ctx context.Context) *CompensationLog {
+	if log, ok := ctx.Value(compensationLogKey{}).(*CompensationLog); ok {
+		return log
+	}
+	return nil
+}
+
+func (c *CompensationLog) Record(entry CompensationEntry) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = append(c.entries, entry)
+}
+
+// Run invokes the recorded compensations in reverse order, each with the
+// original call's arguments and its own timeout. Failures are reported as
+// events and do not stop the remaining compensations
+func (c *CompensationLog) Run(ctx context.Context, recorder EventEmitter) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	entries := append([]CompensationEntry{}, c.entries...)
+	c.entries = nil
+	c.mu.Unlock()
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		tracker := NewOperationTracker(recorder, ctx, "ToolCompensation", entry.CompensationTool, map[string]string{
+			"tool":   entry.Tool,
+			"callId": entry.CallID,
+		})
+
+		callCtx, cancel := context.WithTimeout(ctx, compensationTimeout)
+		compensationCall := ToolCall{ID: "compensation-" + entry.CallID}
+		compensationCall.Function.Name = entry.CompensationTool
+		compensationCall.Function.Arguments = entry.Arguments
+		result, err := entry.executor.Execute(callCtx, compensationCall, recorder)
+		cancel()
+
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "tool compensation failed",
+				"tool", entry.Tool, "compensation", entry.CompensationTool)
+			tracker.Fail(err)
+			continue
+		}
+		if result.Error != "" {
+			logf.FromContext(ctx).Info("tool compensation returned an error",
+				"tool", entry.Tool, "compensation", entry.CompensationTool, "error", result.Error)
+		}
+		tracker.Complete("compensated")
+	}
+}
+
+// CompensatingToolExecutor records successful calls of a side-effecting tool
+// so they can be undone if the query later fails
+type CompensatingToolExecutor struct {
+	BaseExecutor         ToolExecutor
+	ToolName             string
+	CompensationTool     string
+	CompensationExecutor ToolExecutor
+}
+
+func (e *CompensatingToolExecutor) Execute(ctx context.Context, call ToolCall, recorder EventEmitter) (ToolResult, error) {
+	result, err := e.BaseExecutor.Execute(ctx, call, recorder)
+	if err != nil || result.Error != "" {
+		return result, err
+	}
+	CompensationLogFromContext(ctx).Record(CompensationEntry{
+		Tool:             e.ToolName,
+		CompensationTool: e.CompensationTool,
+		Arguments:        call.Function.Arguments,
+		CallID:           call.ID,
+		executor:         e.CompensationExecutor,
+	})
+	return result, nil
+}
@@ -0,0 +1,96 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type recordingExecutor struct {
+	calls  []ToolCall
+	result ToolResult
+	err    error
+}
+
+func (e *recordingExecutor) Execute(_ context.Context, call ToolCall, _ EventEmitter) (ToolResult, error) {
+	e.calls = append(e.calls, call)
+	return e.result, e.err
+}
+
+func TestCompensatingExecutorRecordsSuccessfulCalls(t *testing.T) {
+	ctx, log := WithCompensationLog(context.Background())
+	undo := &recordingExecutor{}
+	executor := &CompensatingToolExecutor{
+		BaseExecutor:         &recordingExecutor{result: ToolResult{Content: "booked"}},
+		ToolName:             "book-flight",
+		CompensationTool:     "cancel-flight",
+		CompensationExecutor: undo,
+	}
+
+	call := ToolCall{ID: "call-1"}
+	call.Function.Name = "book-flight"
+	call.Function.Arguments = `{"flight":"BA123"}`
+	if _, err := executor.Execute(ctx, call, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	log.Run(context.Background(), &mockRecorder{})
+	if len(undo.calls) != 1 {
+		t.Fatalf("expected one compensation call, got %d", len(undo.calls))
+	}
+	if undo.calls[0].Function.Name != "cancel-flight" || undo.calls[0].Function.Arguments != `{"flight":"BA123"}` {
+		t.Fatalf("unexpected compensation call: %+v", undo.calls[0])
+	}
+}
+
+func TestCompensatingExecutorSkipsFailedCalls(t *testing.T) {
+	ctx, log := WithCompensationLog(context.Background())
+	undo := &recordingExecutor{}
+	executor := &CompensatingToolExecutor{
+		BaseExecutor:         &recordingExecutor{err: errors.New("boom")},
+		ToolName:             "book-flight",
+		CompensationTool:     "cancel-flight",
+		CompensationExecutor: undo,
+	}
+
+	if _, err := executor.Execute(ctx, ToolCall{ID: "call-1"}, nil); err == nil {
+		t.Fatal("expected base executor error")
+	}
+
+	log.Run(context.Background(), &mockRecorder{})
+	if len(undo.calls) != 0 {
+		t.Fatalf("expected no compensation for failed call, got %d", len(undo.calls))
+	}
+}
+
+func TestCompensationLogRunsInReverseOrder(t *testing.T) {
+	log := &CompensationLog{}
+	var order []string
+	for _, name := range []string{"first", "second", "third"} {
+		name := name
+		log.Record(CompensationEntry{
+			Tool:             name,
+			CompensationTool: "undo-" + name,
+			CallID:           name,
+			executor: &funcExecutor{fn: func(ToolCall) {
+				order = append(order, name)
+			}},
+		})
+	}
+
+	log.Run(context.Background(), &mockRecorder{})
+	if len(order) != 3 || order[0] != "third" || order[2] != "first" {
+		t.Fatalf("expected reverse order, got %v", order)
+	}
+}
+
+type funcExecutor struct {
+	fn func(ToolCall)
+}
+
+func (e *funcExecutor) Execute(_ context.Context, call ToolCall, _ EventEmitter) (ToolResult, error) {
+	e.fn(call)
+	return ToolResult{}, nil
+}
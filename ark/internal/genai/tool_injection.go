@@ -0,0 +1,91 @@
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+// InjectingToolExecutor wraps another executor and merges the tool's injected
+// parameters into the call arguments before execution. Values are resolved
+// from Secrets and ConfigMaps at call time and override anything the model
+// supplied under the same name, so credentials never originate from or return
+// to the conversation
+type InjectingToolExecutor struct {
+	BaseExecutor ToolExecutor
+	K8sClient    client.Client
+	Namespace    string
+	Parameters   []arkv1alpha1.Parameter
+	ToolName     string
+}
+
+func (i *InjectingToolExecutor) Execute(ctx context.Context, call ToolCall, recorder EventEmitter) (ToolResult, error) {
+	injected, err := i.injectArguments(ctx, call.Function.Arguments)
+	if err != nil {
+		return ToolResult{
+			ID:    call.ID,
+			Name:  call.Function.Name,
+			Error: fmt.Sprintf("failed to inject parameters: %v", err),
+		}, fmt.Errorf("failed to inject parameters for tool %s: %w", i.ToolName, err)
+	}
+	call.Function.Arguments = injected
+	return i.BaseExecutor.Execute(ctx, call, recorder)
+}
+
+func (i *InjectingToolExecutor) injectArguments(ctx context.Context, rawArguments string) (string, error) {
+	arguments := map[string]any{}
+	if rawArguments != "" {
+		if err := json.Unmarshal([]byte(rawArguments), &arguments); err != nil {
+			return "", fmt.Errorf("failed to parse arguments: %w", err)
+		}
+	}
+
+	resolved, err := resolveQueryParameters(ctx, i.K8sClient, i.Namespace, i.Parameters)
+	if err != nil {
+		return "", err
+	}
+	for name, value := range resolved {
+		arguments[name] = value
+	}
+
+	merged, err := json.Marshal(arguments)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal arguments: %w", err)
+	}
+	return string(merged), nil
+}
+
+// stripInjectedParameters removes injected parameter names from the schema
+// advertised to the model so they are neither requested nor documented
+func stripInjectedParameters(parameters map[string]any, injected []arkv1alpha1.Parameter) map[string]any {
+	if len(injected) == 0 {
+		return parameters
+	}
+	names := make(map[string]struct{}, len(injected))
+	for _, parameter := range injected {
+		names[parameter.Name] = struct{}{}
+	}
+
+	if properties, ok := parameters["properties"].(map[string]any); ok {
+		for name := range names {
+			delete(properties, name)
+		}
+	}
+	if required, ok := parameters["required"].([]any); ok {
+		kept := make([]any, 0, len(required))
+		for _, entry := range required {
+			if name, ok := entry.(string); ok {
+				if _, isInjected := names[name]; isInjected {
+					continue
+				}
+			}
+			kept = append(kept, entry)
+		}
+		parameters["required"] = kept
+	}
+	return parameters
+}
@@ -0,0 +1,92 @@
+package genai
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+type capturingExecutor struct {
+	call ToolCall
+}
+
+func (c *capturingExecutor) Execute(ctx context.Context, call ToolCall, recorder EventEmitter) (ToolResult, error) {
+	c.call = call
+	return ToolResult{ID: call.ID, Name: call.Function.Name, Content: "ok"}, nil
+}
+
+func TestInjectingToolExecutorMergesAndOverrides(t *testing.T) {
+	base := &capturingExecutor{}
+	executor := &InjectingToolExecutor{
+		BaseExecutor: base,
+		Parameters: []arkv1alpha1.Parameter{
+			{Name: "api_key", Value: "secret-value"},
+		},
+		ToolName: "weather",
+	}
+
+	call := ToolCall{ID: "call-1"}
+	call.Function.Name = "weather"
+	call.Function.Arguments = `{"city":"Paris","api_key":"model-guess"}`
+
+	if _, err := executor.Execute(context.Background(), call, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var arguments map[string]any
+	if err := json.Unmarshal([]byte(base.call.Function.Arguments), &arguments); err != nil {
+		t.Fatalf("failed to parse forwarded arguments: %v", err)
+	}
+	if arguments["city"] != "Paris" {
+		t.Errorf("expected model argument preserved, got %v", arguments["city"])
+	}
+	if arguments["api_key"] != "secret-value" {
+		t.Errorf("expected injected value to override model value, got %v", arguments["api_key"])
+	}
+}
+
+func TestInjectingToolExecutorEmptyArguments(t *testing.T) {
+	base := &capturingExecutor{}
+	executor := &InjectingToolExecutor{
+		BaseExecutor: base,
+		Parameters:   []arkv1alpha1.Parameter{{Name: "token", Value: "abc"}},
+		ToolName:     "fetch",
+	}
+
+	call := ToolCall{ID: "call-2"}
+	call.Function.Name = "fetch"
+
+	if _, err := executor.Execute(context.Background(), call, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if base.call.Function.Arguments != `{"token":"abc"}` {
+		t.Errorf("expected injected arguments, got %q", base.call.Function.Arguments)
+	}
+}
+
+func TestStripInjectedParameters(t *testing.T) {
+	parameters := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"city":    map[string]any{"type": "string"},
+			"api_key": map[string]any{"type": "string"},
+		},
+		"required": []any{"city", "api_key"},
+	}
+
+	stripped := stripInjectedParameters(parameters, []arkv1alpha1.Parameter{{Name: "api_key", Value: "x"}})
+
+	properties := stripped["properties"].(map[string]any)
+	if _, exists := properties["api_key"]; exists {
+		t.Error("expected injected property removed from schema")
+	}
+	if _, exists := properties["city"]; !exists {
+		t.Error("expected model-facing property kept")
+	}
+	required := stripped["required"].([]any)
+	if len(required) != 1 || required[0] != "city" {
+		t.Errorf("expected required to keep only city, got %v", required)
+	}
+}
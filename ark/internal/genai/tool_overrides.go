@@ -0,0 +1,142 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+const toolOverridesKey contextKey = "toolOverrides"
+
+const redirectedToolTimeout = 30 * time.Second
+
+// WithToolOverrides installs the query's per-query tool replacements on the
+// context, applied when tool registries are built for that query only
+func WithToolOverrides(ctx context.Context, overrides []arkv1alpha1.ToolOverride) context.Context {
+	if len(overrides) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, toolOverridesKey, overrides)
+}
+
+// ToolOverridesFromContext returns the per-query tool overrides, or nil when
+// none are active
+func ToolOverridesFromContext(ctx context.Context) []arkv1alpha1.ToolOverride {
+	if val := ctx.Value(toolOverridesKey); val != nil {
+		if overrides, ok := val.([]arkv1alpha1.ToolOverride); ok {
+			return overrides
+		}
+	}
+	return nil
+}
+
+// applyOverrides swaps registered executors for tools named in the context's
+// overrides. Names not registered on this agent are skipped, since a fan-out
+// query may override tools that only some targets carry
+func (tr *ToolRegistry) applyOverrides(ctx context.Context) {
+	for _, override := range ToolOverridesFromContext(ctx) {
+		if _, exists := tr.executors[override.Name]; !exists {
+			logf.FromContext(ctx).V(1).Info("tool override does not match a registered tool", "tool", override.Name)
+			continue
+		}
+		switch {
+		case override.Response != "":
+			tr.executors[override.Name] = &CannedToolExecutor{Response: override.Response}
+		case override.URL != "":
+			tr.executors[override.Name] = &RedirectedToolExecutor{URL: override.URL}
+		}
+	}
+}
+
+// CannedToolExecutor returns a fixed response without executing the tool
+type CannedToolExecutor struct {
+	Response string
+}
+
+func (c *CannedToolExecutor) Execute(ctx context.Context, call ToolCall, recorder EventEmitter) (ToolResult, error) {
+	return ToolResult{
+		ID:      call.ID,
+		Name:    call.Function.Name,
+		Content: c.Response,
+	}, nil
+}
+
+// RedirectedToolExecutor POSTs the tool call to an alternate endpoint instead
+// of the tool's normal executor
+type RedirectedToolExecutor struct {
+	URL string
+}
+
+func (r *RedirectedToolExecutor) Execute(ctx context.Context, call ToolCall, recorder EventEmitter) (ToolResult, error) {
+	arguments := call.Function.Arguments
+	if arguments == "" {
+		arguments = "{}"
+	}
+	requestBody, err := json.Marshal(map[string]json.RawMessage{
+		"name":      json.RawMessage(fmt.Sprintf("%q", call.Function.Name)),
+		"arguments": json.RawMessage(arguments),
+	})
+	if err != nil {
+		return ToolResult{
+			ID:    call.ID,
+			Name:  call.Function.Name,
+			Error: fmt.Sprintf("failed to serialize tool call: %v", err),
+		}, fmt.Errorf("failed to serialize tool call: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.URL, bytes.NewReader(requestBody))
+	if err != nil {
+		return ToolResult{
+			ID:    call.ID,
+			Name:  call.Function.Name,
+			Error: fmt.Sprintf("failed to create request: %v", err),
+		}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if user := UserIdentityFromContext(ctx); user != "" {
+		req.Header.Set(UserIdentityHeader, user)
+	}
+
+	httpClient := &http.Client{Timeout: redirectedToolTimeout}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return ToolResult{
+			ID:    call.ID,
+			Name:  call.Function.Name,
+			Error: fmt.Sprintf("failed to call override endpoint: %v", err),
+		}, fmt.Errorf("failed to call override endpoint: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ToolResult{
+			ID:    call.ID,
+			Name:  call.Function.Name,
+			Error: fmt.Sprintf("failed to read response: %v", err),
+		}, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return ToolResult{
+			ID:    call.ID,
+			Name:  call.Function.Name,
+			Error: fmt.Sprintf("HTTP error %d: %s (URL: %s)", resp.StatusCode, resp.Status, r.URL),
+		}, fmt.Errorf("HTTP error %d: %s", resp.StatusCode, resp.Status)
+	}
+
+	return ToolResult{
+		ID:      call.ID,
+		Name:    call.Function.Name,
+		Content: string(body),
+	}, nil
+}
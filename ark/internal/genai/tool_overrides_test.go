@@ -0,0 +1,70 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+func overrideToolCall(name string) ToolCall {
+	call := ToolCall{ID: "call-1"}
+	call.Function.Name = name
+	call.Function.Arguments = `{"city":"Boston"}`
+	return call
+}
+
+func TestApplyOverridesReplacesRegisteredTool(t *testing.T) {
+	registry := NewToolRegistry()
+	registry.RegisterTool(ToolDefinition{Name: "get_weather"}, &NoopExecutor{})
+
+	ctx := WithToolOverrides(context.Background(), []arkv1alpha1.ToolOverride{
+		{Name: "get_weather", Response: "72F"},
+		{Name: "not_registered", Response: "ignored"},
+	})
+	registry.applyOverrides(ctx)
+
+	result, err := registry.ExecuteTool(ctx, overrideToolCall("get_weather"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Content != "72F" {
+		t.Errorf("expected canned response, got %q", result.Content)
+	}
+	if registry.GetToolType("get_weather") != "mocked" {
+		t.Errorf("expected mocked tool type, got %q", registry.GetToolType("get_weather"))
+	}
+}
+
+func TestRedirectedToolExecutorPostsCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		_, _ = w.Write([]byte("redirected result"))
+	}))
+	defer server.Close()
+
+	executor := &RedirectedToolExecutor{URL: server.URL}
+	result, err := executor.Execute(context.Background(), overrideToolCall("get_weather"), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Content != "redirected result" {
+		t.Errorf("expected endpoint response, got %q", result.Content)
+	}
+}
+
+func TestToolOverridesContextRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	if overrides := ToolOverridesFromContext(ctx); overrides != nil {
+		t.Errorf("expected no overrides, got %v", overrides)
+	}
+	if withEmpty := WithToolOverrides(ctx, nil); withEmpty != ctx {
+		t.Error("expected empty overrides to leave the context unchanged")
+	}
+}
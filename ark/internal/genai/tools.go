@@ -25,6 +25,8 @@ type ToolDefinition struct {
 	Name        string         `json:"name"`
 	Description string         `json:"description"`
 	Parameters  map[string]any `json:"parameters"`
+
+	schemaTokens int64
 }
 
 // HTTPExecutor executes HTTP tools
@@ -130,6 +132,10 @@ func (h *HTTPExecutor) Execute(ctx context.Context, call ToolCall, recorder Even
 		req.Header.Set(header.Name, value)
 	}
 
+	if user := UserIdentityFromContext(ctx); user != "" {
+		req.Header.Set(UserIdentityHeader, user)
+	}
+
 	// Set timeout
 	timeout := h.getTimeout(httpSpec.Timeout)
 	httpClient := &http.Client{Timeout: timeout}
@@ -220,6 +226,16 @@ func (tr *ToolRegistry) GetToolType(toolName string) string {
 		return "mcp"
 	case *FilteredToolExecutor:
 		return "filtered"
+	case *SanitizingToolExecutor:
+		return "sanitized"
+	case *InjectingToolExecutor:
+		return "injected"
+	case *CannedToolExecutor:
+		return "mocked"
+	case *CompensatingToolExecutor:
+		return "compensating"
+	case *RedirectedToolExecutor:
+		return "redirected"
 	default:
 		return "unknown"
 	}
@@ -235,6 +251,18 @@ func (tr *ToolRegistry) ExecuteTool(ctx context.Context, call ToolCall, recorder
 		}, fmt.Errorf("tool %s not found", call.Function.Name)
 	}
 
+	if def, hasDef := tr.tools[call.Function.Name]; hasDef {
+		coercedArguments, err := validateAndCoerceArguments(def, call.Function.Arguments)
+		if err != nil {
+			return ToolResult{
+				ID:    call.ID,
+				Name:  call.Function.Name,
+				Error: fmt.Sprintf("invalid arguments: %v", err),
+			}, fmt.Errorf("invalid arguments for tool %s: %w", call.Function.Name, err)
+		}
+		call.Function.Arguments = coercedArguments
+	}
+
 	return executor.Execute(ctx, call, recorder)
 }
 
@@ -256,6 +284,16 @@ func (tr *ToolRegistry) ToOpenAITools() []openai.ChatCompletionToolParam {
 	return tools
 }
 
+// SchemaTokens returns the estimated token cost of the registered tool
+// schemas, for rate-limit estimation
+func (tr *ToolRegistry) SchemaTokens() int64 {
+	var tokens int64
+	for _, def := range tr.tools {
+		tokens += def.schemaTokens
+	}
+	return tokens
+}
+
 // GetMCPPool returns the MCP client pool for this tool registry
 func (tr *ToolRegistry) GetMCPPool() *MCPClientPool {
 	return tr.mcpPool
@@ -396,14 +434,18 @@ func CreateToolFromCRD(toolCRD *arkv1alpha1.Tool) ToolDefinition {
 		"type":       "object",
 		"properties": map[string]any{},
 	}
+	var schemaTokens int64
 	if toolCRD.Spec.InputSchema != nil && len(toolCRD.Spec.InputSchema.Raw) > 0 {
-		// Parse runtime.RawExtension to map[string]any
-		if err := json.Unmarshal(toolCRD.Spec.InputSchema.Raw, &parameters); err != nil {
+		parsed, tokens, err := parseToolSchema(toolCRD.Spec.InputSchema.Raw)
+		if err != nil {
 			logf.Log.Error(err, "failed to unmarshal tool input schema")
+		} else {
+			parameters = parsed
+			schemaTokens = tokens
 		}
 	}
 
-	return ToolDefinition{Name: toolCRD.Name, Description: description, Parameters: parameters}
+	return ToolDefinition{Name: toolCRD.Name, Description: description, Parameters: parameters, schemaTokens: schemaTokens}
 }
 
 func CreateHTTPTool(toolCRD *arkv1alpha1.Tool) ToolDefinition {
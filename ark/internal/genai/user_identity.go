@@ -0,0 +1,55 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openai/openai-go/option"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/annotations"
+)
+
+const userIdentityKey contextKey = "userIdentity"
+
+// UserIdentityHeader carries the originating user on outbound provider and
+// tool requests
+const UserIdentityHeader = "X-Ark-User"
+
+// ResolveUserIdentity returns the originating user of a query: the user
+// annotation set by the gateway when present, otherwise the impersonated
+// service account, otherwise empty
+func ResolveUserIdentity(query *arkv1alpha1.Query) string {
+	if user := query.Annotations[annotations.User]; user != "" {
+		return user
+	}
+	if query.Spec.ServiceAccount != "" {
+		return fmt.Sprintf("system:serviceaccount:%s:%s", query.Namespace, query.Spec.ServiceAccount)
+	}
+	return ""
+}
+
+// WithUserIdentity installs the originating user on the context for
+// propagation to providers, tools and audit metadata
+func WithUserIdentity(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, userIdentityKey, user)
+}
+
+// UserIdentityFromContext returns the originating user on the context, or
+// empty when identity propagation is not active
+func UserIdentityFromContext(ctx context.Context) string {
+	if user, ok := ctx.Value(userIdentityKey).(string); ok {
+		return user
+	}
+	return ""
+}
+
+// userIdentityHeaderOptions renders the originating user as a gateway request
+// header
+func userIdentityHeaderOptions(ctx context.Context) []option.RequestOption {
+	user := UserIdentityFromContext(ctx)
+	if user == "" {
+		return nil
+	}
+	return []option.RequestOption{option.WithHeader(UserIdentityHeader, user)}
+}
@@ -0,0 +1,59 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/annotations"
+)
+
+func TestResolveUserIdentityPrefersAnnotation(t *testing.T) {
+	query := &arkv1alpha1.Query{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Annotations: map[string]string{annotations.User: "alice@example.com"},
+		},
+		Spec: arkv1alpha1.QuerySpec{ServiceAccount: "runner"},
+	}
+	if user := ResolveUserIdentity(query); user != "alice@example.com" {
+		t.Errorf("expected annotation user, got %q", user)
+	}
+}
+
+func TestResolveUserIdentityFallsBackToServiceAccount(t *testing.T) {
+	query := &arkv1alpha1.Query{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+		Spec:       arkv1alpha1.QuerySpec{ServiceAccount: "runner"},
+	}
+	if user := ResolveUserIdentity(query); user != "system:serviceaccount:default:runner" {
+		t.Errorf("expected service account identity, got %q", user)
+	}
+
+	query.Spec.ServiceAccount = ""
+	if user := ResolveUserIdentity(query); user != "" {
+		t.Errorf("expected empty identity, got %q", user)
+	}
+}
+
+func TestUserIdentityContextRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	if UserIdentityFromContext(ctx) != "" {
+		t.Error("expected empty identity without propagation")
+	}
+	if len(userIdentityHeaderOptions(ctx)) != 0 {
+		t.Error("expected no header options without identity")
+	}
+
+	ctx = WithUserIdentity(ctx, "alice@example.com")
+	if user := UserIdentityFromContext(ctx); user != "alice@example.com" {
+		t.Errorf("expected identity round trip, got %q", user)
+	}
+	if len(userIdentityHeaderOptions(ctx)) != 1 {
+		t.Error("expected a header option carrying the identity")
+	}
+}
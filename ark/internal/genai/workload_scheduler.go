@@ -0,0 +1,135 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+)
+
+const (
+	defaultExecutionWorkers  = 32
+	defaultEvaluationWorkers = 4
+)
+
+// WorkloadScheduler splits the controller's goroutine budget between
+// interactive query execution and background evaluation traffic. Interactive
+// work always preempts background admission: while interactive queries are
+// waiting for a worker, no new evaluation starts, and evaluations are capped
+// well below the total so they can never crowd out live queries
+type WorkloadScheduler struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	maxWorkers    int
+	backgroundMax int
+
+	interactiveRunning int
+	interactiveWaiting int
+	backgroundRunning  int
+}
+
+var (
+	workloadSchedulerOnce sync.Once
+	workloadScheduler     *WorkloadScheduler
+)
+
+// DefaultWorkloadScheduler returns the process-wide scheduler, sized from
+// ARK_EXECUTION_WORKERS and ARK_EVALUATION_WORKERS. Zero execution workers
+// disables scheduling entirely
+func DefaultWorkloadScheduler() *WorkloadScheduler {
+	workloadSchedulerOnce.Do(func() {
+		workloadScheduler = NewWorkloadScheduler(
+			workerEnv("ARK_EXECUTION_WORKERS", defaultExecutionWorkers),
+			workerEnv("ARK_EVALUATION_WORKERS", defaultEvaluationWorkers))
+	})
+	return workloadScheduler
+}
+
+func workerEnv(name string, fallback int) int {
+	if value := os.Getenv(name); value != "" {
+		if workers, err := strconv.Atoi(value); err == nil && workers >= 0 {
+			return workers
+		}
+	}
+	return fallback
+}
+
+// NewWorkloadScheduler creates a scheduler with maxWorkers total slots, of
+// which background work may hold at most backgroundMax
+func NewWorkloadScheduler(maxWorkers, backgroundMax int) *WorkloadScheduler {
+	scheduler := &WorkloadScheduler{
+		maxWorkers:    maxWorkers,
+		backgroundMax: backgroundMax,
+	}
+	scheduler.cond = sync.NewCond(&scheduler.mu)
+	return scheduler
+}
+
+// AcquireInteractive blocks until a worker slot is free for query execution,
+// returning the release function the caller must invoke when done
+func (s *WorkloadScheduler) AcquireInteractive(ctx context.Context) (func(), error) {
+	if s.maxWorkers == 0 {
+		return func() {}, nil
+	}
+	return s.acquire(ctx, &s.interactiveWaiting, &s.interactiveRunning, s.canAdmitInteractive)
+}
+
+// AcquireBackground blocks until evaluation work may start: a slot is free,
+// the background cap is not reached, and no interactive query is waiting
+func (s *WorkloadScheduler) AcquireBackground(ctx context.Context) (func(), error) {
+	if s.maxWorkers == 0 {
+		return func() {}, nil
+	}
+	var backgroundWaiting int
+	return s.acquire(ctx, &backgroundWaiting, &s.backgroundRunning, s.canAdmitBackground)
+}
+
+func (s *WorkloadScheduler) acquire(ctx context.Context, waiting, running *int, admit func() bool) (func(), error) {
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.cond.Broadcast()
+		case <-stop:
+		}
+	}()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	*waiting++
+	for !admit() {
+		if ctx.Err() != nil {
+			*waiting--
+			return nil, ctx.Err()
+		}
+		s.cond.Wait()
+	}
+	*waiting--
+	*running++
+
+	return func() {
+		s.mu.Lock()
+		*running--
+		s.mu.Unlock()
+		s.cond.Broadcast()
+	}, nil
+}
+
+func (s *WorkloadScheduler) canAdmitInteractive() bool {
+	return s.interactiveRunning+s.backgroundRunning < s.maxWorkers
+}
+
+func (s *WorkloadScheduler) canAdmitBackground() bool {
+	if s.interactiveWaiting > 0 {
+		return false
+	}
+	if s.backgroundRunning >= s.backgroundMax {
+		return false
+	}
+	return s.interactiveRunning+s.backgroundRunning < s.maxWorkers
+}
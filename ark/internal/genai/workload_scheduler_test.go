@@ -0,0 +1,63 @@
+/* Copyright 2025. McKinsey & Company */
+
+package genai
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWorkloadSchedulerCapsBackgroundWork(t *testing.T) {
+	scheduler := NewWorkloadScheduler(4, 1)
+
+	release, err := scheduler.AcquireBackground(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := scheduler.AcquireBackground(ctx); err == nil {
+		t.Fatal("expected second background acquire to block at the cap")
+	}
+}
+
+func TestWorkloadSchedulerInteractivePreemptsBackground(t *testing.T) {
+	scheduler := NewWorkloadScheduler(4, 2)
+
+	scheduler.mu.Lock()
+	scheduler.interactiveWaiting = 1
+	backgroundBlocked := !scheduler.canAdmitBackground()
+	interactiveAdmitted := scheduler.canAdmitInteractive()
+	scheduler.interactiveWaiting = 0
+	backgroundAdmitted := scheduler.canAdmitBackground()
+	scheduler.mu.Unlock()
+
+	if !backgroundBlocked {
+		t.Fatal("expected background admission blocked while interactive work waits")
+	}
+	if !interactiveAdmitted {
+		t.Fatal("expected interactive admission with free capacity")
+	}
+	if !backgroundAdmitted {
+		t.Fatal("expected background admission once no interactive work waits")
+	}
+}
+
+func TestWorkloadSchedulerZeroWorkersDisablesScheduling(t *testing.T) {
+	scheduler := NewWorkloadScheduler(0, 0)
+	for range 10 {
+		release, err := scheduler.AcquireInteractive(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		release()
+		release, err = scheduler.AcquireBackground(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		release()
+	}
+}
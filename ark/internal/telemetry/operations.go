@@ -0,0 +1,84 @@
+package telemetry
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// QueryBacklogStats summarizes execution demand at a point in time, sampled
+// when metrics are collected
+type QueryBacklogStats struct {
+	PendingQueries     int64
+	QueuedTargets      int64
+	AverageWaitSeconds float64
+}
+
+// RegisterQueryBacklogGauges exposes execution backlog gauges so autoscalers
+// such as KEDA or an HPA can scale executor workloads on real demand. The
+// controller-runtime workqueue_depth metric covers reconcile pressure; these
+// gauges cover queries that are admitted but not yet executing
+func RegisterQueryBacklogGauges(snapshot func(context.Context) QueryBacklogStats) error {
+	meter := otel.Meter(MeterName)
+
+	pending, err := meter.Int64ObservableGauge("ark.queries.pending",
+		metric.WithDescription("Number of queries waiting to start executing"))
+	if err != nil {
+		return err
+	}
+	targets, err := meter.Int64ObservableGauge("ark.queries.pending.targets",
+		metric.WithDescription("Number of targets queued across pending queries"))
+	if err != nil {
+		return err
+	}
+	wait, err := meter.Float64ObservableGauge("ark.queries.pending.wait.seconds",
+		metric.WithDescription("Average time pending queries have been waiting"))
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		stats := snapshot(ctx)
+		observer.ObserveInt64(pending, stats.PendingQueries)
+		observer.ObserveInt64(targets, stats.QueuedTargets)
+		observer.ObserveFloat64(wait, stats.AverageWaitSeconds)
+		return nil
+	}, pending, targets, wait)
+	return err
+}
+
+var (
+	memoryQuotaOnce    sync.Once
+	memoryQuotaCounter metric.Int64Counter
+)
+
+// RecordMemoryQuotaEnforcement counts memory writes rejected or sessions
+// evicted by a namespace memory quota
+func RecordMemoryQuotaEnforcement(ctx context.Context, namespace, action string) {
+	memoryQuotaOnce.Do(func() {
+		memoryQuotaCounter, _ = otel.Meter(MeterName).Int64Counter("ark.memory.quota.enforcements",
+			metric.WithDescription("Memory quota enforcement actions by namespace and action"))
+	})
+	if memoryQuotaCounter == nil {
+		return
+	}
+	memoryQuotaCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("namespace", namespace),
+		attribute.String("action", action)))
+}
+
+// RegisterInFlightQueryGauge exposes the number of in-flight query
+// operations as a gauge, sampled via the provided counter on each collection
+func RegisterInFlightQueryGauge(count func() int64) error {
+	meter := otel.Meter(MeterName)
+	_, err := meter.Int64ObservableGauge("ark.queries.inflight",
+		metric.WithDescription("Number of query operations currently executing"),
+		metric.WithInt64Callback(func(_ context.Context, observer metric.Int64Observer) error {
+			observer.Observe(count())
+			return nil
+		}))
+	return err
+}
@@ -0,0 +1,111 @@
+package telemetry
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const MeterName = "ark/controller"
+
+var (
+	streamingMetricsOnce    sync.Once
+	streamTimeToFirstChunk  metric.Float64Histogram
+	streamInterChunkLatency metric.Float64Histogram
+	streamDuration          metric.Float64Histogram
+)
+
+func streamingInstruments() {
+	meter := otel.Meter(MeterName)
+	streamTimeToFirstChunk, _ = meter.Float64Histogram("ark.streaming.time_to_first_chunk",
+		metric.WithDescription("Time until the first chunk of a streamed completion arrived"),
+		metric.WithUnit("ms"))
+	streamInterChunkLatency, _ = meter.Float64Histogram("ark.streaming.inter_chunk_latency",
+		metric.WithDescription("Latency between consecutive chunks of a streamed completion"),
+		metric.WithUnit("ms"))
+	streamDuration, _ = meter.Float64Histogram("ark.streaming.duration",
+		metric.WithDescription("Total duration of a streamed completion"),
+		metric.WithUnit("ms"))
+}
+
+// StreamStats collects chunk arrival times for a single streamed completion
+type StreamStats struct {
+	mu         sync.Mutex
+	start      time.Time
+	firstChunk time.Time
+	lastChunk  time.Time
+	gaps       []time.Duration
+}
+
+func NewStreamStats() *StreamStats {
+	return &StreamStats{start: time.Now()}
+}
+
+// ObserveChunk records the arrival of one chunk
+func (s *StreamStats) ObserveChunk() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if s.firstChunk.IsZero() {
+		s.firstChunk = now
+	} else {
+		s.gaps = append(s.gaps, now.Sub(s.lastChunk))
+	}
+	s.lastChunk = now
+}
+
+// Finish records the collected latencies as metrics and span attributes,
+// keyed by model and agent
+func (s *StreamStats) Finish(ctx context.Context, span trace.Span, modelName, agentName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.firstChunk.IsZero() {
+		return
+	}
+	streamingMetricsOnce.Do(streamingInstruments)
+
+	timeToFirstChunk := s.firstChunk.Sub(s.start)
+	duration := s.lastChunk.Sub(s.start)
+
+	span.SetAttributes(
+		attribute.Float64("streaming.time_to_first_chunk_ms", durationMillis(timeToFirstChunk)),
+		attribute.Float64("streaming.duration_ms", durationMillis(duration)),
+		attribute.Int("streaming.chunks", len(s.gaps)+1),
+	)
+	if len(s.gaps) > 0 {
+		sorted := make([]time.Duration, len(s.gaps))
+		copy(sorted, s.gaps)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		span.SetAttributes(
+			attribute.Float64("streaming.inter_chunk_latency_p50_ms", durationMillis(percentile(sorted, 0.50))),
+			attribute.Float64("streaming.inter_chunk_latency_p95_ms", durationMillis(percentile(sorted, 0.95))),
+			attribute.Float64("streaming.inter_chunk_latency_p99_ms", durationMillis(percentile(sorted, 0.99))),
+		)
+	}
+
+	attrs := metric.WithAttributes(
+		attribute.String("model", modelName),
+		attribute.String("agent", agentName),
+	)
+	streamTimeToFirstChunk.Record(ctx, durationMillis(timeToFirstChunk), attrs)
+	streamDuration.Record(ctx, durationMillis(duration), attrs)
+	for _, gap := range s.gaps {
+		streamInterChunkLatency.Record(ctx, durationMillis(gap), attrs)
+	}
+}
+
+// percentile returns the value at the given rank from an ascending-sorted slice
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	index := int(float64(len(sorted)-1) * p)
+	return sorted[index]
+}
+
+func durationMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -79,6 +79,12 @@ func (tc *TraceContext) StartToolSpan(ctx context.Context, toolName, toolType st
 	)
 }
 
+func (tc *TraceContext) StartMemorySpan(ctx context.Context, operation, memoryName string) (context.Context, trace.Span) {
+	return tc.StartSpan(ctx, "memory."+operation,
+		attribute.String("memory.name", memoryName),
+	)
+}
+
 func (tc *TraceContext) StartEvaluationSpan(ctx context.Context, evaluatorName string) (context.Context, trace.Span) {
 	return tc.StartSpan(ctx, "evaluation.execute",
 		attribute.String("evaluator.name", evaluatorName),
@@ -96,6 +102,17 @@ func RecordSuccess(span trace.Span) {
 	span.SetStatus(codes.Ok, "success")
 }
 
+// AddPayloadStats records how many messages an operation moved and their
+// serialized size, so slow spans can be attributed to payload volume
+func AddPayloadStats(span trace.Span, messageCount int, sizeBytes int64) {
+	span.SetAttributes(
+		attribute.Int("payload.messages", messageCount),
+	)
+	if sizeBytes >= 0 {
+		span.SetAttributes(attribute.Int64("payload.bytes", sizeBytes))
+	}
+}
+
 func AddTokenUsage(span trace.Span, promptTokens, completionTokens, totalTokens int64) {
 	span.SetAttributes(
 		attribute.Int64("tokens.prompt", promptTokens),
@@ -296,6 +313,16 @@ func (tc *TraceContext) StartQuery(ctx context.Context, queryName, queryNamespac
 	return ctx, span
 }
 
+// TraceIDFromContext returns the trace ID of the current span, or an empty
+// string when the context carries no sampled span.
+func TraceIDFromContext(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
+
 // InjectOTELHeaders injects OTEL trace context and session info into HTTP headers
 func InjectOTELHeaders(ctx context.Context, headers map[string]string) {
 	// Inject standard W3C trace context headers
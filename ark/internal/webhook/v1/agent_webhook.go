@@ -8,10 +8,12 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/genai"
 )
 
 // SetupAgentWebhookWithManager registers the webhook for Agent in the manager.
@@ -66,6 +68,14 @@ func (v *AgentCustomValidator) validateAgent(ctx context.Context, agent *arkv1al
 		return warnings, err
 	}
 
+	if err := v.validateGenerationOptions(agent); err != nil {
+		return warnings, err
+	}
+
+	if err := v.validateMessageTransforms(agent); err != nil {
+		return warnings, err
+	}
+
 	for i, tool := range agent.Spec.Tools {
 		toolWarnings, err := v.validateTool(i, tool)
 		if err != nil {
@@ -74,9 +84,38 @@ func (v *AgentCustomValidator) validateAgent(ctx context.Context, agent *arkv1al
 		warnings = append(warnings, toolWarnings...)
 	}
 
+	warnings = append(warnings, v.capabilityWarnings(ctx, agent)...)
+
 	return warnings, nil
 }
 
+// capabilityWarnings warns when the referenced model declares capabilities
+// the agent relies on as unsupported. Warnings rather than errors, since
+// model validation follows the same eventual-consistency rules as
+// validateAgentModel
+func (v *AgentCustomValidator) capabilityWarnings(ctx context.Context, agent *arkv1alpha1.Agent) admission.Warnings {
+	modelName, namespace := genai.ResolveModelSpec(agent.Spec.ModelRef, agent.Namespace)
+
+	var model arkv1alpha1.Model
+	if err := v.Client.Get(ctx, client.ObjectKey{Name: modelName, Namespace: namespace}, &model); err != nil {
+		return nil
+	}
+
+	caps := model.Spec.Capabilities
+	if caps == nil {
+		return nil
+	}
+
+	var warnings admission.Warnings
+	if len(agent.Spec.Tools) > 0 && caps.Tools != nil && !*caps.Tools {
+		warnings = append(warnings, fmt.Sprintf("model %s declares tools unsupported; tool calls will fail with CapabilityMismatch", modelName))
+	}
+	if agent.Spec.OutputSchema != nil && caps.JSONMode != nil && !*caps.JSONMode {
+		warnings = append(warnings, fmt.Sprintf("model %s declares json_mode unsupported; structured output will fail with CapabilityMismatch", modelName))
+	}
+	return warnings
+}
+
 const (
 	ExecutionEngineA2A = "a2a"
 )
@@ -88,6 +127,29 @@ func (v *AgentCustomValidator) validateAgentModel(ctx context.Context, agent *ar
 	return nil
 }
 
+func (v *AgentCustomValidator) validateGenerationOptions(agent *arkv1alpha1.Agent) error {
+	for i, sequence := range agent.Spec.Stop {
+		if sequence == "" {
+			return fmt.Errorf("stop[%d]: stop sequences must not be empty", i)
+		}
+	}
+	for token, bias := range agent.Spec.LogitBias {
+		if bias < -100 || bias > 100 {
+			return fmt.Errorf("logitBias[%s]: bias value %d must be between -100 and 100", token, bias)
+		}
+	}
+	return nil
+}
+
+func (v *AgentCustomValidator) validateMessageTransforms(agent *arkv1alpha1.Agent) error {
+	for i, transform := range agent.Spec.MessageTransforms {
+		if !genai.KnownMessageTransform(transform.Name) {
+			return fmt.Errorf("messageTransforms[%d]: unknown transform '%s'", i, transform.Name)
+		}
+	}
+	return nil
+}
+
 func (v *AgentCustomValidator) validateBuiltInTool(tool arkv1alpha1.AgentTool, hasName bool, index int) error {
 	if !hasName {
 		return fmt.Errorf("tool[%d]: built-in tools must specify a name", index)
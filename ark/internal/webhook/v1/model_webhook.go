@@ -84,6 +84,16 @@ func (v *ModelValidator) ValidateCreate(ctx context.Context, obj runtime.Object)
 		return nil, err
 	}
 
+	if model.Spec.RateLimits != nil && model.Spec.RateLimits.RequestsPerMinute == nil && model.Spec.RateLimits.TokensPerMinute == nil {
+		return nil, fmt.Errorf("rateLimits requires requestsPerMinute or tokensPerMinute")
+	}
+
+	for i := range model.Spec.Endpoints {
+		if err := v.validateValueSource(ctx, &model.Spec.Endpoints[i].BaseURL, model.GetNamespace(), fmt.Sprintf("spec.endpoints[%d].baseUrl", i)); err != nil {
+			return nil, err
+		}
+	}
+
 	modellog.Info("Model validation complete", "name", model.GetName())
 
 	return nil, nil
@@ -97,6 +107,10 @@ func (v *ModelValidator) validateProviderConfig(ctx context.Context, model *arkv
 		return v.validateOpenAIConfig(ctx, model)
 	case genai.ModelTypeBedrock:
 		return v.validateBedrockConfig(ctx, model)
+	case genai.ModelTypeAnthropic:
+		return v.validateAnthropicConfig(ctx, model)
+	case genai.ModelTypeOllama:
+		return v.validateOllamaConfig(ctx, model)
 	default:
 		return fmt.Errorf("unsupported model type: %s", model.Spec.Type)
 	}
@@ -119,13 +133,13 @@ func (v *ModelValidator) validateAzureConfig(ctx context.Context, model *arkv1al
 		}
 	}
 
-	_, err := v.Resolver.ResolveValueSource(ctx, model.Spec.Config.Azure.BaseURL, model.GetNamespace())
+	baseURL, err := v.Resolver.ResolveValueSource(ctx, model.Spec.Config.Azure.BaseURL, model.GetNamespace())
 	if err != nil {
 		modellog.Error(err, "Failed to resolve Azure BaseURL", "model", model.GetName())
 		return fmt.Errorf("failed to resolve Azure BaseURL: %w", err)
 	}
 
-	return nil
+	return genai.CheckModelEgress(ctx, v.Client, model.GetNamespace(), baseURL)
 }
 
 func (v *ModelValidator) validateOpenAIConfig(ctx context.Context, model *arkv1alpha1.Model) error {
@@ -140,13 +154,59 @@ func (v *ModelValidator) validateOpenAIConfig(ctx context.Context, model *arkv1a
 		return err
 	}
 
-	_, err := v.Resolver.ResolveValueSource(ctx, model.Spec.Config.OpenAI.BaseURL, model.GetNamespace())
+	baseURL, err := v.Resolver.ResolveValueSource(ctx, model.Spec.Config.OpenAI.BaseURL, model.GetNamespace())
 	if err != nil {
 		modellog.Error(err, "Failed to resolve OpenAI BaseURL", "model", model.GetName())
 		return fmt.Errorf("failed to resolve OpenAI BaseURL: %w", err)
 	}
 
-	return nil
+	return genai.CheckModelEgress(ctx, v.Client, model.GetNamespace(), baseURL)
+}
+
+func (v *ModelValidator) validateAnthropicConfig(ctx context.Context, model *arkv1alpha1.Model) error {
+	if model.Spec.Config.Anthropic == nil {
+		return fmt.Errorf("anthropic configuration is required for anthropic model type")
+	}
+
+	if err := v.validateValueSource(ctx, &model.Spec.Config.Anthropic.APIKey, model.GetNamespace(), "spec.config.anthropic.apiKey"); err != nil {
+		return err
+	}
+	if model.Spec.Config.Anthropic.APIVersion != nil {
+		if err := v.validateValueSource(ctx, model.Spec.Config.Anthropic.APIVersion, model.GetNamespace(), "spec.config.anthropic.apiVersion"); err != nil {
+			return err
+		}
+	}
+	if model.Spec.Config.Anthropic.BaseURL == nil {
+		return nil
+	}
+
+	if err := v.validateValueSource(ctx, model.Spec.Config.Anthropic.BaseURL, model.GetNamespace(), "spec.config.anthropic.baseUrl"); err != nil {
+		return err
+	}
+	baseURL, err := v.Resolver.ResolveValueSource(ctx, *model.Spec.Config.Anthropic.BaseURL, model.GetNamespace())
+	if err != nil {
+		modellog.Error(err, "Failed to resolve Anthropic BaseURL", "model", model.GetName())
+		return fmt.Errorf("failed to resolve Anthropic BaseURL: %w", err)
+	}
+
+	return genai.CheckModelEgress(ctx, v.Client, model.GetNamespace(), baseURL)
+}
+
+func (v *ModelValidator) validateOllamaConfig(ctx context.Context, model *arkv1alpha1.Model) error {
+	if model.Spec.Config.Ollama == nil {
+		return fmt.Errorf("ollama configuration is required for ollama model type")
+	}
+
+	if err := v.validateValueSource(ctx, &model.Spec.Config.Ollama.BaseURL, model.GetNamespace(), "spec.config.ollama.baseUrl"); err != nil {
+		return err
+	}
+	baseURL, err := v.Resolver.ResolveValueSource(ctx, model.Spec.Config.Ollama.BaseURL, model.GetNamespace())
+	if err != nil {
+		modellog.Error(err, "Failed to resolve Ollama BaseURL", "model", model.GetName())
+		return fmt.Errorf("failed to resolve Ollama BaseURL: %w", err)
+	}
+
+	return genai.CheckModelEgress(ctx, v.Client, model.GetNamespace(), baseURL)
 }
 
 func (v *ModelValidator) validateBedrockConfig(ctx context.Context, model *arkv1alpha1.Model) error {
@@ -179,6 +239,17 @@ func (v *ModelValidator) validateBedrockConfig(ctx context.Context, model *arkv1
 			return err
 		}
 	}
+	if model.Spec.Config.Bedrock.BaseURL != nil {
+		if err := v.validateValueSource(ctx, model.Spec.Config.Bedrock.BaseURL, model.GetNamespace(), "spec.config.bedrock.baseUrl"); err != nil {
+			return err
+		}
+		baseURL, err := v.Resolver.ResolveValueSource(ctx, *model.Spec.Config.Bedrock.BaseURL, model.GetNamespace())
+		if err != nil {
+			modellog.Error(err, "Failed to resolve Bedrock BaseURL", "model", model.GetName())
+			return fmt.Errorf("failed to resolve Bedrock BaseURL: %w", err)
+		}
+		return genai.CheckModelEgress(ctx, v.Client, model.GetNamespace(), baseURL)
+	}
 
 	return nil
 }
@@ -5,20 +5,24 @@ package v1
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	"mckinsey.com/ark/internal/genai"
 )
 
 const (
-	TargetTypeAgent = "agent"
-	TargetTypeTeam  = "team"
-	TargetTypeModel = "model"
-	TargetTypeTool  = "tool"
+	TargetTypeAgent  = "agent"
+	TargetTypeTeam   = "team"
+	TargetTypeModel  = "model"
+	TargetTypeTool   = "tool"
+	TargetTypeRouter = "router"
 )
 
 // SetupQueryWebhookWithManager registers the webhook for Query in the manager.
@@ -48,6 +52,10 @@ func (v *QueryCustomValidator) ValidateCreate(ctx context.Context, obj runtime.O
 	}
 	log.V(3).Info("Validate create", "query", query.ObjectMeta)
 
+	if err := v.validateIdempotencyKey(ctx, query); err != nil {
+		return nil, err
+	}
+
 	return v.validateQuery(ctx, query)
 }
 
@@ -76,6 +84,10 @@ func (v *QueryCustomValidator) ValidateDelete(ctx context.Context, obj runtime.O
 func (v *QueryCustomValidator) validateQuery(ctx context.Context, query *arkv1alpha1.Query) (admission.Warnings, error) {
 	var warnings admission.Warnings
 
+	if err := v.validateQueryInput(query); err != nil {
+		return warnings, err
+	}
+
 	if err := v.validateQueryTargets(ctx, query); err != nil {
 		return warnings, err
 	}
@@ -88,40 +100,156 @@ func (v *QueryCustomValidator) validateQuery(ctx context.Context, query *arkv1al
 		return warnings, err
 	}
 
+	if err := v.validateToolOverrides(query); err != nil {
+		return warnings, err
+	}
+
+	if err := v.validateDeliveryChannels(ctx, query); err != nil {
+		return warnings, err
+	}
+
+	if _, err := genai.ParseQueryOverrides(query.Annotations); err != nil {
+		return warnings, err
+	}
+
 	return warnings, nil
 }
 
+func (v *QueryCustomValidator) validateDeliveryChannels(ctx context.Context, query *arkv1alpha1.Query) error {
+	for i, ref := range query.Spec.DeliverTo {
+		if err := v.ValidateLoadDeliveryChannel(ctx, ref.Name, query.Namespace); err != nil {
+			return fmt.Errorf("deliverTo[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (v *QueryCustomValidator) validateToolOverrides(query *arkv1alpha1.Query) error {
+	seen := make(map[string]bool, len(query.Spec.ToolOverrides))
+	for i, override := range query.Spec.ToolOverrides {
+		hasResponse := override.Response != ""
+		hasURL := override.URL != ""
+		if hasResponse == hasURL {
+			return fmt.Errorf("toolOverrides[%d]: exactly one of response or url must be specified", i)
+		}
+		if seen[override.Name] {
+			return fmt.Errorf("toolOverrides[%d]: duplicate override for tool %q", i, override.Name)
+		}
+		seen[override.Name] = true
+	}
+	return nil
+}
+
+func (v *QueryCustomValidator) validateQueryInput(query *arkv1alpha1.Query) error {
+	hasInput := query.Spec.Input != ""
+	hasMessages := len(query.Spec.Messages) > 0
+
+	if !hasInput && !hasMessages {
+		return fmt.Errorf("either input or messages must be specified")
+	}
+	if hasInput && hasMessages {
+		return fmt.Errorf("cannot specify both input and messages")
+	}
+	if hasMessages {
+		if _, err := genai.ParseQueryMessages(query.Spec.Messages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (v *QueryCustomValidator) validateQueryTargets(ctx context.Context, query *arkv1alpha1.Query) error {
+	if query.Spec.Hedging != nil {
+		if len(query.Spec.Targets) > 0 || query.Spec.Selector != nil {
+			return fmt.Errorf("cannot specify both hedging and targets or selector")
+		}
+		return v.validateTargetList(ctx, query.Namespace, query.Spec.Hedging.Targets, "hedging.targets")
+	}
+
 	if len(query.Spec.Targets) == 0 && query.Spec.Selector == nil {
-		return fmt.Errorf("at least one target or selector must be specified")
+		if !v.hasDefaultTarget(ctx, query.Namespace) {
+			return fmt.Errorf("at least one target or selector must be specified, or a default agent or model configured in the namespace ArkConfig")
+		}
 	}
 
-	for i, target := range query.Spec.Targets {
+	return v.validateTargetList(ctx, query.Namespace, query.Spec.Targets, "target")
+}
+
+func (v *QueryCustomValidator) validateTargetList(ctx context.Context, namespace string, targets []arkv1alpha1.QueryTarget, field string) error {
+	for i, target := range targets {
 		switch target.Type {
 		case TargetTypeAgent:
-			if err := v.ValidateLoadAgent(ctx, target.Name, query.Namespace); err != nil {
-				return fmt.Errorf("target[%d] references %v", i, err)
+			if err := v.ValidateLoadAgent(ctx, target.Name, namespace); err != nil {
+				return fmt.Errorf("%s[%d] references %v", field, i, err)
 			}
 		case TargetTypeTeam:
-			if err := v.ValidateLoadTeam(ctx, target.Name, query.Namespace); err != nil {
-				return fmt.Errorf("target[%d] references %v", i, err)
+			if err := v.ValidateLoadTeam(ctx, target.Name, namespace); err != nil {
+				return fmt.Errorf("%s[%d] references %v", field, i, err)
 			}
 		case TargetTypeModel:
-			if err := v.ValidateLoadModel(ctx, target.Name, query.Namespace); err != nil {
-				return fmt.Errorf("target[%d] references %v", i, err)
+			if err := v.ValidateLoadModel(ctx, target.Name, namespace); err != nil {
+				return fmt.Errorf("%s[%d] references %v", field, i, err)
 			}
 		case TargetTypeTool:
-			if err := v.ValidateLoadTool(ctx, target.Name, query.Namespace); err != nil {
-				return fmt.Errorf("target[%d] references %v", i, err)
+			if err := v.ValidateLoadTool(ctx, target.Name, namespace); err != nil {
+				return fmt.Errorf("%s[%d] references %v", field, i, err)
+			}
+		case TargetTypeRouter:
+			if err := v.ValidateLoadRouter(ctx, target.Name, namespace); err != nil {
+				return fmt.Errorf("%s[%d] references %v", field, i, err)
 			}
 		default:
-			return fmt.Errorf("target[%d]: unsupported type '%s': supported types are: %s, %s, %s, %s", i, target.Type, TargetTypeAgent, TargetTypeTeam, TargetTypeModel, TargetTypeTool)
+			return fmt.Errorf("%s[%d]: unsupported type '%s': supported types are: %s, %s, %s, %s, %s", field, i, target.Type, TargetTypeAgent, TargetTypeTeam, TargetTypeModel, TargetTypeTool, TargetTypeRouter)
 		}
 	}
 
 	return nil
 }
 
+// validateIdempotencyKey rejects a query whose idempotencyKey matches an
+// existing query in the namespace created within the idempotency window. The
+// error names the existing query so retrying clients can link to it.
+func (v *QueryCustomValidator) validateIdempotencyKey(ctx context.Context, query *arkv1alpha1.Query) error {
+	if query.Spec.IdempotencyKey == "" {
+		return nil
+	}
+
+	window := time.Hour
+	var config arkv1alpha1.ArkConfig
+	if err := v.Client.Get(ctx, client.ObjectKey{Name: "default", Namespace: query.Namespace}, &config); err == nil {
+		if config.Spec.IdempotencyWindow != nil {
+			window = config.Spec.IdempotencyWindow.Duration
+		}
+	}
+
+	var queries arkv1alpha1.QueryList
+	if err := v.Client.List(ctx, &queries, client.InNamespace(query.Namespace)); err != nil {
+		return fmt.Errorf("failed to list queries for idempotency check: %w", err)
+	}
+
+	for _, existing := range queries.Items {
+		if existing.Name == query.Name || existing.Spec.IdempotencyKey != query.Spec.IdempotencyKey {
+			continue
+		}
+		if time.Since(existing.CreationTimestamp.Time) > window {
+			continue
+		}
+		return fmt.Errorf("query with idempotencyKey %q already exists: %s", query.Spec.IdempotencyKey, existing.Name)
+	}
+
+	return nil
+}
+
+// hasDefaultTarget reports whether the namespace ArkConfig provides a default
+// agent or model that queries without targets fall back to.
+func (v *QueryCustomValidator) hasDefaultTarget(ctx context.Context, namespace string) bool {
+	var config arkv1alpha1.ArkConfig
+	if err := v.Client.Get(ctx, client.ObjectKey{Name: "default", Namespace: namespace}, &config); err != nil {
+		return false
+	}
+	return config.Spec.DefaultAgent != "" || config.Spec.DefaultModel != ""
+}
+
 func (v *QueryCustomValidator) validateEvaluators(ctx context.Context, query *arkv1alpha1.Query) error {
 	hasEvaluators := len(query.Spec.Evaluators) > 0
 	hasEvaluatorSelector := query.Spec.EvaluatorSelector != nil
@@ -131,6 +259,11 @@ func (v *QueryCustomValidator) validateEvaluators(ctx context.Context, query *ar
 	}
 
 	for _, evaluatorRef := range query.Spec.Evaluators {
+		// Pass validation for the reserved built-in groundedness evaluator
+		if evaluatorRef.Name == genai.GroundednessEvaluatorName {
+			continue
+		}
+
 		evaluatorNamespace := evaluatorRef.Namespace
 		if evaluatorNamespace == "" {
 			evaluatorNamespace = query.Namespace
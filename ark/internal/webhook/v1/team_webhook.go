@@ -137,11 +137,41 @@ func (v *TeamCustomValidator) validateStrategy(ctx context.Context, team *arkv1a
 		return v.validateSelectorModel(ctx, team)
 	case "graph":
 		return v.validateGraphStrategy(team)
+	case "parallel":
+		return v.validateParallelStrategy(team)
 	default:
-		return fmt.Errorf("unsupported strategy '%s': must be 'sequential', 'round-robin', 'selector', or 'graph'", team.Spec.Strategy)
+		return fmt.Errorf("unsupported strategy '%s': must be 'sequential', 'round-robin', 'selector', 'graph', or 'parallel'", team.Spec.Strategy)
 	}
 }
 
+func (v *TeamCustomValidator) validateParallelStrategy(team *arkv1alpha1.Team) error {
+	if team.Spec.Parallel == nil {
+		return nil
+	}
+
+	if team.Spec.Parallel.MaxConcurrency != nil && *team.Spec.Parallel.MaxConcurrency < 1 {
+		return fmt.Errorf("parallel maxConcurrency must be at least 1")
+	}
+
+	if coordinator := team.Spec.Parallel.Coordinator; coordinator != "" {
+		found := false
+		for _, member := range team.Spec.Members {
+			if member.Name == coordinator {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("parallel coordinator '%s' not found in team members", coordinator)
+		}
+		if len(team.Spec.Members) < 2 {
+			return fmt.Errorf("parallel coordinator requires at least one other member to fan out to")
+		}
+	}
+
+	return nil
+}
+
 func (v *TeamCustomValidator) validateSelectorModel(ctx context.Context, team *arkv1alpha1.Team) error {
 	// Resolve selector model name with default fallback
 	modelName, namespace := genai.ResolveModelSpec(team.Spec.Selector, team.Namespace)
@@ -72,6 +72,10 @@ func (v *ToolCustomValidator) validateTool(_ context.Context, tool *arkv1alpha1.
 		}
 	}
 
+	if tool.Spec.Compensation != nil && tool.Spec.Compensation.ToolName == tool.Name {
+		return warnings, fmt.Errorf("compensation cannot reference the tool itself")
+	}
+
 	switch tool.Spec.Type {
 	case genai.ToolTypeHTTP:
 		return v.validateHTTP(tool.Spec.HTTP)
@@ -79,11 +83,28 @@ func (v *ToolCustomValidator) validateTool(_ context.Context, tool *arkv1alpha1.
 		return v.validateMCPTool(tool.Spec.MCP)
 	case genai.ToolTypeAgent:
 		return v.validateAgentTool(tool.Spec.Agent.Name)
+	case genai.ToolTypeJob:
+		return v.validateJobTool(tool.Spec.Job)
 	default:
-		return warnings, fmt.Errorf("unsupported tool type '%s': supported types are: http, mcp", tool.Spec.Type)
+		return warnings, fmt.Errorf("unsupported tool type '%s': supported types are: http, mcp, agent, job", tool.Spec.Type)
 	}
 }
 
+// validateJobTool validates Job-specific configuration
+func (v *ToolCustomValidator) validateJobTool(job *arkv1alpha1.JobToolSpec) (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	if job == nil {
+		return warnings, fmt.Errorf("job spec is required for job type")
+	}
+
+	if job.Image == "" {
+		return warnings, fmt.Errorf("image is required for job tool")
+	}
+
+	return warnings, nil
+}
+
 // validateHTTP validates HTTP-specific configuration
 func (v *ToolCustomValidator) validateHTTP(httpSpec *arkv1alpha1.HTTPSpec) (admission.Warnings, error) {
 	var warnings admission.Warnings
@@ -96,6 +96,42 @@ func (v *ResourceValidator) ValidateLoadTool(ctx context.Context, name, namespac
 	return nil
 }
 
+func (v *ResourceValidator) ValidateLoadRouter(ctx context.Context, name, namespace string) error {
+	if name == "" {
+		return nil
+	}
+
+	router := &arkv1alpha1.Router{}
+	key := types.NamespacedName{Name: name, Namespace: namespace}
+
+	if err := v.Client.Get(ctx, key, router); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to get router '%s' in namespace '%s': %v", name, namespace, err)
+		}
+		return fmt.Errorf("router '%s' does not exist in namespace '%s'", name, namespace)
+	}
+
+	return nil
+}
+
+func (v *ResourceValidator) ValidateLoadDeliveryChannel(ctx context.Context, name, namespace string) error {
+	if name == "" {
+		return nil
+	}
+
+	channel := &arkv1alpha1.DeliveryChannel{}
+	key := types.NamespacedName{Name: name, Namespace: namespace}
+
+	if err := v.Client.Get(ctx, key, channel); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to get delivery channel '%s' in namespace '%s': %v", name, namespace, err)
+		}
+		return fmt.Errorf("delivery channel '%s' does not exist in namespace '%s'", name, namespace)
+	}
+
+	return nil
+}
+
 func (v *ResourceValidator) ValidateLoadConfigMap(ctx context.Context, name, namespace string) error {
 	if name == "" {
 		return nil
@@ -0,0 +1,102 @@
+/* Copyright 2025. McKinsey & Company */
+
+// Package client provides a typed client for ark resources so integrating Go
+// services do not need to hand-roll dynamic clients and watch loops against
+// the CRDs. It offers per-resource typed accessors, informers, and high-level
+// query execution helpers built on controller-runtime.
+package client
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+	arkv1prealpha1 "mckinsey.com/ark/api/v1prealpha1"
+)
+
+// Scheme returns a runtime scheme with the core Kubernetes and ark API groups
+// registered
+func Scheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("failed to register client-go scheme: %w", err)
+	}
+	if err := arkv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("failed to register ark v1alpha1 scheme: %w", err)
+	}
+	if err := arkv1prealpha1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("failed to register ark v1prealpha1 scheme: %w", err)
+	}
+	return scheme, nil
+}
+
+// Client wraps a watch-capable controller-runtime client with ark types
+// registered
+type Client struct {
+	kube client.WithWatch
+}
+
+// NewForConfig builds a Client from a rest config
+func NewForConfig(cfg *rest.Config) (*Client, error) {
+	scheme, err := Scheme()
+	if err != nil {
+		return nil, err
+	}
+	kube, err := client.NewWithWatch(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	return &Client{kube: kube}, nil
+}
+
+// Kube exposes the underlying controller-runtime client for operations the
+// typed accessors do not cover
+func (c *Client) Kube() client.WithWatch {
+	return c.kube
+}
+
+// Queries returns a typed client for Query resources in the namespace
+func (c *Client) Queries(namespace string) *ResourceClient[*arkv1alpha1.Query, *arkv1alpha1.QueryList] {
+	return newResourceClient(c.kube, namespace,
+		func() *arkv1alpha1.Query { return &arkv1alpha1.Query{} },
+		func() *arkv1alpha1.QueryList { return &arkv1alpha1.QueryList{} })
+}
+
+// Agents returns a typed client for Agent resources in the namespace
+func (c *Client) Agents(namespace string) *ResourceClient[*arkv1alpha1.Agent, *arkv1alpha1.AgentList] {
+	return newResourceClient(c.kube, namespace,
+		func() *arkv1alpha1.Agent { return &arkv1alpha1.Agent{} },
+		func() *arkv1alpha1.AgentList { return &arkv1alpha1.AgentList{} })
+}
+
+// Teams returns a typed client for Team resources in the namespace
+func (c *Client) Teams(namespace string) *ResourceClient[*arkv1alpha1.Team, *arkv1alpha1.TeamList] {
+	return newResourceClient(c.kube, namespace,
+		func() *arkv1alpha1.Team { return &arkv1alpha1.Team{} },
+		func() *arkv1alpha1.TeamList { return &arkv1alpha1.TeamList{} })
+}
+
+// Models returns a typed client for Model resources in the namespace
+func (c *Client) Models(namespace string) *ResourceClient[*arkv1alpha1.Model, *arkv1alpha1.ModelList] {
+	return newResourceClient(c.kube, namespace,
+		func() *arkv1alpha1.Model { return &arkv1alpha1.Model{} },
+		func() *arkv1alpha1.ModelList { return &arkv1alpha1.ModelList{} })
+}
+
+// Memories returns a typed client for Memory resources in the namespace
+func (c *Client) Memories(namespace string) *ResourceClient[*arkv1alpha1.Memory, *arkv1alpha1.MemoryList] {
+	return newResourceClient(c.kube, namespace,
+		func() *arkv1alpha1.Memory { return &arkv1alpha1.Memory{} },
+		func() *arkv1alpha1.MemoryList { return &arkv1alpha1.MemoryList{} })
+}
+
+// Tools returns a typed client for Tool resources in the namespace
+func (c *Client) Tools(namespace string) *ResourceClient[*arkv1alpha1.Tool, *arkv1alpha1.ToolList] {
+	return newResourceClient(c.kube, namespace,
+		func() *arkv1alpha1.Tool { return &arkv1alpha1.Tool{} },
+		func() *arkv1alpha1.ToolList { return &arkv1alpha1.ToolList{} })
+}
@@ -0,0 +1,65 @@
+/* Copyright 2025. McKinsey & Company */
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Informers maintains a shared cache of ark resources for services that need
+// event-driven views rather than one-shot reads
+type Informers struct {
+	cache cache.Cache
+}
+
+// NewInformers builds an informer cache over the ark API groups. Call Start
+// in a goroutine and WaitForCacheSync before reading
+func NewInformers(cfg *rest.Config, opts ...func(*cache.Options)) (*Informers, error) {
+	scheme, err := Scheme()
+	if err != nil {
+		return nil, err
+	}
+	options := cache.Options{Scheme: scheme}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	informerCache, err := cache.New(cfg, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create informer cache: %w", err)
+	}
+	return &Informers{cache: informerCache}, nil
+}
+
+// WithNamespace restricts the informer cache to a single namespace
+func WithNamespace(namespace string) func(*cache.Options) {
+	return func(options *cache.Options) {
+		options.DefaultNamespaces = map[string]cache.Config{namespace: {}}
+	}
+}
+
+// Start runs the informers until the context is canceled
+func (i *Informers) Start(ctx context.Context) error {
+	return i.cache.Start(ctx)
+}
+
+// WaitForCacheSync blocks until the informers have completed their initial
+// list, reporting whether the sync succeeded
+func (i *Informers) WaitForCacheSync(ctx context.Context) bool {
+	return i.cache.WaitForCacheSync(ctx)
+}
+
+// Informer returns the shared informer for the given resource kind, creating
+// it on first use
+func (i *Informers) Informer(ctx context.Context, obj client.Object) (cache.Informer, error) {
+	return i.cache.GetInformer(ctx, obj)
+}
+
+// Reader exposes the cache as a read-only client backed by informer state
+func (i *Informers) Reader() client.Reader {
+	return i.cache
+}
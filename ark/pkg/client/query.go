@@ -0,0 +1,113 @@
+/* Copyright 2025. McKinsey & Company */
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	arkv1alpha1 "mckinsey.com/ark/api/v1alpha1"
+)
+
+// Terminal query phases as maintained by the query controller
+const (
+	queryPhaseDone     = "done"
+	queryPhaseError    = "error"
+	queryPhaseCanceled = "canceled"
+)
+
+// ExecuteQueryAndWait creates the query and blocks until it reaches a
+// terminal phase, returning the query in its final state. Queries ending in
+// the error or canceled phase are returned alongside an error. Bound the wait
+// with a context deadline
+func (c *Client) ExecuteQueryAndWait(ctx context.Context, query *arkv1alpha1.Query) (*arkv1alpha1.Query, error) {
+	return c.executeAndObserve(ctx, query, nil)
+}
+
+// StreamQuery creates the query and invokes observe for every status update
+// until the query reaches a terminal phase, returning the final state. Use it
+// to surface intermediate phases and partial responses to callers
+func (c *Client) StreamQuery(ctx context.Context, query *arkv1alpha1.Query, observe func(*arkv1alpha1.Query)) (*arkv1alpha1.Query, error) {
+	return c.executeAndObserve(ctx, query, observe)
+}
+
+// ResumeConversation executes a follow-up query in an existing session so the
+// targets see the prior conversation from memory
+func (c *Client) ResumeConversation(ctx context.Context, namespace, sessionID, input string, targets ...arkv1alpha1.QueryTarget) (*arkv1alpha1.Query, error) {
+	if sessionID == "" {
+		return nil, fmt.Errorf("sessionID is required to resume a conversation")
+	}
+	query := &arkv1alpha1.Query{}
+	query.Namespace = namespace
+	query.Spec.Input = input
+	query.Spec.SessionId = sessionID
+	query.Spec.Targets = targets
+	return c.ExecuteQueryAndWait(ctx, query)
+}
+
+func (c *Client) executeAndObserve(ctx context.Context, query *arkv1alpha1.Query, observe func(*arkv1alpha1.Query)) (*arkv1alpha1.Query, error) {
+	if query.Name == "" && query.GenerateName == "" {
+		query.GenerateName = "query-"
+	}
+	if query.Namespace == "" {
+		query.Namespace = "default"
+	}
+	if err := c.kube.Create(ctx, query); err != nil {
+		return nil, fmt.Errorf("failed to create query: %w", err)
+	}
+	return c.waitForQuery(ctx, query.Namespace, query.Name, observe)
+}
+
+// waitForQuery watches the named query until it reaches a terminal phase,
+// re-establishing the watch if the server closes it early
+func (c *Client) waitForQuery(ctx context.Context, namespace, name string, observe func(*arkv1alpha1.Query)) (*arkv1alpha1.Query, error) {
+	for {
+		watcher, err := c.kube.Watch(ctx, &arkv1alpha1.QueryList{},
+			client.InNamespace(namespace),
+			client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector("metadata.name", name)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to watch query %s/%s: %w", namespace, name, err)
+		}
+
+		final, err := c.observeQueryEvents(ctx, watcher, observe)
+		watcher.Stop()
+		if err != nil || final != nil {
+			return final, err
+		}
+	}
+}
+
+// observeQueryEvents drains watch events until a terminal phase or the watch
+// channel closes; a nil query with nil error signals the caller to re-watch
+func (c *Client) observeQueryEvents(ctx context.Context, watcher watch.Interface, observe func(*arkv1alpha1.Query)) (*arkv1alpha1.Query, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil, nil
+			}
+			query, ok := event.Object.(*arkv1alpha1.Query)
+			if !ok {
+				continue
+			}
+			if event.Type == watch.Deleted {
+				return nil, fmt.Errorf("query %s/%s was deleted before completing", query.Namespace, query.Name)
+			}
+			if observe != nil {
+				observe(query)
+			}
+			switch query.Status.Phase {
+			case queryPhaseDone:
+				return query, nil
+			case queryPhaseError, queryPhaseCanceled:
+				return query, fmt.Errorf("query %s/%s finished in phase %s", query.Namespace, query.Name, query.Status.Phase)
+			}
+		}
+	}
+}
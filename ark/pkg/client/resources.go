@@ -0,0 +1,78 @@
+/* Copyright 2025. McKinsey & Company */
+
+package client
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResourceClient provides namespace-scoped typed operations for a single ark
+// resource kind
+type ResourceClient[T client.Object, L client.ObjectList] struct {
+	kube      client.WithWatch
+	namespace string
+	newObject func() T
+	newList   func() L
+}
+
+func newResourceClient[T client.Object, L client.ObjectList](kube client.WithWatch, namespace string, newObject func() T, newList func() L) *ResourceClient[T, L] {
+	return &ResourceClient[T, L]{
+		kube:      kube,
+		namespace: namespace,
+		newObject: newObject,
+		newList:   newList,
+	}
+}
+
+// Get fetches the named resource
+func (r *ResourceClient[T, L]) Get(ctx context.Context, name string) (T, error) {
+	obj := r.newObject()
+	if err := r.kube.Get(ctx, client.ObjectKey{Namespace: r.namespace, Name: name}, obj); err != nil {
+		var zero T
+		return zero, err
+	}
+	return obj, nil
+}
+
+// List fetches resources in the namespace, optionally filtered by the given
+// options
+func (r *ResourceClient[T, L]) List(ctx context.Context, opts ...client.ListOption) (L, error) {
+	list := r.newList()
+	opts = append([]client.ListOption{client.InNamespace(r.namespace)}, opts...)
+	if err := r.kube.List(ctx, list, opts...); err != nil {
+		var zero L
+		return zero, err
+	}
+	return list, nil
+}
+
+// Create submits the resource, defaulting its namespace to the client's
+func (r *ResourceClient[T, L]) Create(ctx context.Context, obj T) error {
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(r.namespace)
+	}
+	return r.kube.Create(ctx, obj)
+}
+
+// Update persists changes to the resource spec
+func (r *ResourceClient[T, L]) Update(ctx context.Context, obj T) error {
+	return r.kube.Update(ctx, obj)
+}
+
+// Delete removes the named resource
+func (r *ResourceClient[T, L]) Delete(ctx context.Context, name string) error {
+	obj := r.newObject()
+	obj.SetNamespace(r.namespace)
+	obj.SetName(name)
+	return r.kube.Delete(ctx, obj)
+}
+
+// Watch opens a watch over resources in the namespace
+func (r *ResourceClient[T, L]) Watch(ctx context.Context, opts ...client.ListOption) (watch.Interface, error) {
+	list := r.newList()
+	opts = append([]client.ListOption{client.InNamespace(r.namespace)}, opts...)
+	return r.kube.Watch(ctx, list, opts...)
+}